@@ -124,6 +124,7 @@ import (
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
 	perpkeeperv2 "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perp "github.com/NibiruChain/nibiru/x/perp/module/v1"
+	perpv2 "github.com/NibiruChain/nibiru/x/perp/module/v2"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	perptypesv2 "github.com/NibiruChain/nibiru/x/perp/types/v2"
 
@@ -196,6 +197,7 @@ var (
 		epochs.AppModuleBasic{},
 		stablecoin.AppModuleBasic{},
 		perp.AppModuleBasic{},
+		perpv2.AppModuleBasic{},
 		perpamm.AppModuleBasic{},
 		inflation.AppModuleBasic{},
 		sudo.AppModuleBasic{},
@@ -205,26 +207,28 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:            nil,
-		distrtypes.ModuleName:                 nil,
-		inflationtypes.ModuleName:             {authtypes.Minter},
-		stakingtypes.BondedPoolName:           {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:        {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:                   {authtypes.Burner},
-		spottypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
-		oracletypes.ModuleName:                {},
-		ibctransfertypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
-		ibcfeetypes.ModuleName:                nil,
-		stablecointypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
-		perptypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
-		perptypes.VaultModuleAccount:          {},
-		perptypes.PerpEFModuleAccount:         {},
-		perptypes.FeePoolModuleAccount:        {},
-		epochstypes.ModuleName:                {},
-		stablecointypes.StableEFModuleAccount: {authtypes.Burner},
-		sudo.ModuleName:                       {},
-		common.TreasuryPoolModuleAccount:      {},
-		wasm.ModuleName:                       {},
+		authtypes.FeeCollectorName:             nil,
+		distrtypes.ModuleName:                  nil,
+		inflationtypes.ModuleName:              {authtypes.Minter},
+		stakingtypes.BondedPoolName:            {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:         {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                    {authtypes.Burner},
+		spottypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+		oracletypes.ModuleName:                 {},
+		ibctransfertypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:                 nil,
+		stablecointypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
+		perptypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+		perptypes.VaultModuleAccount:           {},
+		perptypes.PerpEFModuleAccount:          {},
+		perptypes.FeePoolModuleAccount:         {},
+		perptypesv2.InsuranceFundModuleAccount: {authtypes.Minter, authtypes.Burner},
+		perptypesv2.ReferralPoolModuleAccount:  {},
+		epochstypes.ModuleName:                 {},
+		stablecointypes.StableEFModuleAccount:  {authtypes.Burner},
+		sudo.ModuleName:                        {},
+		common.TreasuryPoolModuleAccount:       {},
+		wasm.ModuleName:                        {},
 	}
 )
 
@@ -400,6 +404,26 @@ func NewNibiruApp(
 		return fromVM, nil
 	})
 
+	app.upgradeKeeper.SetUpgradeHandler("v0.21.0", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		vm, err := app.mm.RunMigrations(ctx, app.configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		// move the perp v1 and perp amm state into perp v2
+		if err := perpkeeperv2.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx); err != nil {
+			return nil, err
+		}
+
+		return vm, nil
+	})
+
+	// The x/perp (v1) and x/perp/amm stores are emptied by the v0.21.0
+	// migration but stay mounted: their keepers, modules and EndBlockers are
+	// still wired, so deleting the stores with a store upgrade would have them
+	// re-created empty on restart. They can only be deleted along with the v1
+	// modules.
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
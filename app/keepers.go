@@ -103,6 +103,7 @@ import (
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
 	v2perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perp "github.com/NibiruChain/nibiru/x/perp/module/v1"
+	v2perp "github.com/NibiruChain/nibiru/x/perp/module/v2"
 
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	v2perptypes "github.com/NibiruChain/nibiru/x/perp/types/v2"
@@ -269,7 +270,7 @@ func (app *NibiruApp) InitKeepers(
 		app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.PerpAmmKeeper, app.EpochsKeeper,
 	)
 
-	app.PerpKeeperV2 = v2perpkeeper.NewKeeper(appCodec, keys[v2perptypes.StoreKey], app.GetSubspace(v2perptypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.EpochsKeeper)
+	app.PerpKeeperV2 = v2perpkeeper.NewKeeper(appCodec, keys[v2perptypes.StoreKey], app.GetSubspace(v2perptypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.EpochsKeeper, app.SpotKeeper)
 
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		appCodec, keys[inflationtypes.StoreKey], app.GetSubspace(inflationtypes.ModuleName),
@@ -355,7 +356,9 @@ func (app *NibiruApp) InitKeepers(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
-		AddRoute(perpammtypes.RouterKey, perpamm.NewMarketProposalHandler(app.PerpAmmKeeper))
+		AddRoute(perpammtypes.RouterKey, perpamm.NewMarketProposalHandler(app.PerpAmmKeeper)).
+		AddRoute(v2perptypes.RouterKey, v2perp.NewMarketProposalHandler(app.PerpKeeperV2)).
+		AddRoute(oracletypes.RouterKey, oracle.NewDerivedPairProposalHandler(app.OracleKeeper))
 
 	// Create evidence keeper.
 	// This keeper automatically includes an evidence router.
@@ -448,6 +451,10 @@ func (app *NibiruApp) AppModules(
 		appCodec, app.PerpKeeper, app.AccountKeeper, app.BankKeeper,
 		app.OracleKeeper,
 	)
+	perpModuleV2 := v2perp.NewAppModule(
+		appCodec, app.PerpKeeperV2, app.AccountKeeper, app.BankKeeper,
+		app.OracleKeeper,
+	)
 	perpAmmModule := perpamm.NewAppModule(
 		appCodec, app.PerpAmmKeeper, app.OracleKeeper,
 	)
@@ -482,6 +489,7 @@ func (app *NibiruApp) AppModules(
 		epochsModule,
 		perpAmmModule,
 		perpModule,
+		perpModuleV2,
 		inflationModule,
 		sudoModule,
 
@@ -602,6 +610,7 @@ func (app *NibiruApp) InitSimulationManager(
 		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		// native x/
 		epochsModule,
+		v2perp.NewAppModule(appCodec, app.PerpKeeperV2, app.AccountKeeper, app.BankKeeper, app.OracleKeeper),
 		// ibc
 		capability.NewAppModule(appCodec, *app.capabilityKeeper),
 		evidence.NewAppModule(app.evidenceKeeper),
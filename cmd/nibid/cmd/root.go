@@ -33,6 +33,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/oracle/client/pricefeeder"
 	perpammcli "github.com/NibiruChain/nibiru/x/perp/amm/cli"
 )
 
@@ -161,6 +162,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		queryCommand(),
 		txCommand(),
 		keys.Commands(app.DefaultNodeHome),
+		pricefeeder.PriceFeederCmd(),
 	)
 
 	// add rosetta
@@ -23,6 +23,10 @@ func init() {
 	sdkSimapp.GetSimulatorFlags()
 }
 
+// simInvCheckPeriod is the period in blocks of the invariant checks of the
+// full app simulation, unless set with the Period flag.
+const simInvCheckPeriod uint = 1
+
 func TestFullAppSimulation(tb *testing.T) {
 	config, db, dir, _, skip, err := sdkSimapp.SetupSimulation("goleveldb-app-sim", "Simulation")
 	if skip {
@@ -38,8 +42,13 @@ func TestFullAppSimulation(tb *testing.T) {
 		}
 	}()
 
+	invCheckPeriod := simInvCheckPeriod
+	if sdkSimapp.FlagPeriodValue != 0 {
+		invCheckPeriod = sdkSimapp.FlagPeriodValue
+	}
+
 	encoding := app.MakeTestEncodingConfig()
-	app := testapp.NewNibiruTestApp(app.NewDefaultGenesisState(encoding.Marshaler))
+	app := testapp.NewNibiruTestAppWithInvCheckPeriod(app.NewDefaultGenesisState(encoding.Marshaler), invCheckPeriod)
 
 	// Run randomized simulation:
 	_, simParams, simErr := simulation.SimulateFromSeed(
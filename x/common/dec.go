@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -154,3 +155,24 @@ func ChopPrecisionAndRound(d *big.Int) *big.Int {
 		return quo.Add(quo, oneInt)
 	}
 }
+
+// DecKeyEncoder encodes non-negative decimals as collection keys that sort in
+// numerical order: the length of the underlying big.Int followed by its
+// big-endian bytes. It panics on negative decimals.
+var DecKeyEncoder collections.KeyEncoder[sdk.Dec] = decKeyEncoder{}
+
+type decKeyEncoder struct{}
+
+func (decKeyEncoder) Stringify(d sdk.Dec) string { return d.String() }
+func (decKeyEncoder) Encode(d sdk.Dec) []byte {
+	if d.IsNegative() {
+		panic(fmt.Errorf("cannot encode negative decimal %s as a key", d))
+	}
+	b := d.BigInt().Bytes()
+	return append([]byte{uint8(len(b))}, b...)
+}
+func (decKeyEncoder) Decode(b []byte) (int, sdk.Dec) {
+	n := int(b[0])
+	i := new(big.Int).SetBytes(b[1 : 1+n])
+	return 1 + n, sdk.NewDecFromBigIntWithPrec(i, sdk.Precision)
+}
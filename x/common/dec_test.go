@@ -1,6 +1,7 @@
 package common_test
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"testing"
@@ -79,3 +80,28 @@ func TestSqrtDec(t *testing.T) {
 		})
 	}
 }
+
+func TestDecKeyEncoder(t *testing.T) {
+	decs := []sdk.Dec{
+		sdk.ZeroDec(),
+		sdk.MustNewDecFromStr("0.000000000000000001"),
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.OneDec(),
+		sdk.MustNewDecFromStr("255.255"),
+		sdk.NewDec(1e12),
+	}
+
+	for i, dec := range decs {
+		encoded := common.DecKeyEncoder.Encode(dec)
+		n, decoded := common.DecKeyEncoder.Decode(encoded)
+		assert.Equal(t, len(encoded), n)
+		assert.Equal(t, dec.String(), decoded.String())
+
+		if i > 0 {
+			assert.Equal(t, -1, bytes.Compare(common.DecKeyEncoder.Encode(decs[i-1]), encoded),
+				"%s should sort before %s", decs[i-1], dec)
+		}
+	}
+
+	assert.Panics(t, func() { common.DecKeyEncoder.Encode(sdk.NewDec(-1)) })
+}
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// PruneSnapshots deletes the snapshots of the pair taken before cutoff, oldest
+// first, up to limit of them. The latest snapshot taken before cutoff is kept:
+// it holds the price at the start of any TWAP window beginning after cutoff.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - snapshots: the snapshots, keyed by pair and snapshot time
+//   - pair: the pair to prune
+//   - cutoff: the time before which snapshots are pruned
+//   - limit: the maximum number of snapshots to delete
+//
+// returns:
+//   - pruned: the number of snapshots deleted
+func PruneSnapshots[V any](
+	ctx sdk.Context,
+	snapshots collections.Map[collections.Pair[asset.Pair, time.Time], V],
+	pair asset.Pair,
+	cutoff time.Time,
+	limit uint64,
+) (pruned uint64) {
+	if limit == 0 {
+		return 0
+	}
+
+	// the limit+1-th key, if any, is a later snapshot before cutoff, so the
+	// first limit ones can all go
+	var keys []collections.Pair[asset.Pair, time.Time]
+	iter := snapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair).EndExclusive(cutoff))
+	for ; iter.Valid() && uint64(len(keys)) <= limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	if len(keys) == 0 {
+		return 0
+	}
+	for _, key := range keys[:len(keys)-1] {
+		_ = snapshots.Delete(ctx, key)
+		pruned++
+	}
+	return pruned
+}
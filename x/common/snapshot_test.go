@@ -0,0 +1,58 @@
+package common_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+)
+
+func TestPruneSnapshots(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("snapshots")
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	snapshots := collections.NewMap(
+		storeKey, 0,
+		collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder),
+		collections.Uint64ValueEncoder,
+	)
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	start := time.UnixMilli(1_700_000_000_000)
+	for i := 0; i < 10; i++ {
+		snapshots.Insert(ctx, collections.Join(btc, start.Add(time.Duration(i)*time.Second)), uint64(i))
+		snapshots.Insert(ctx, collections.Join(eth, start.Add(time.Duration(i)*time.Second)), uint64(i))
+	}
+	remaining := func(pair asset.Pair) []uint64 {
+		return snapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Values()
+	}
+
+	t.Log("nothing is pruned without a limit")
+	require.Zero(t, common.PruneSnapshots(ctx, snapshots, btc, start.Add(5*time.Second), 0))
+
+	t.Log("the oldest snapshots are pruned first, up to the limit")
+	require.EqualValues(t, 2, common.PruneSnapshots(ctx, snapshots, btc, start.Add(5*time.Second), 2))
+	require.Equal(t, []uint64{2, 3, 4, 5, 6, 7, 8, 9}, remaining(btc))
+
+	t.Log("the latest snapshot before the cutoff is kept")
+	require.EqualValues(t, 2, common.PruneSnapshots(ctx, snapshots, btc, start.Add(5*time.Second), 10))
+	require.Equal(t, []uint64{4, 5, 6, 7, 8, 9}, remaining(btc))
+	require.Zero(t, common.PruneSnapshots(ctx, snapshots, btc, start.Add(5*time.Second), 10))
+
+	t.Log("other pairs are left alone")
+	require.Len(t, remaining(eth), 10)
+}
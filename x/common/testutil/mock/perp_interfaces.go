@@ -104,6 +104,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BurnCoins mocks base method.
+func (m *MockBankKeeper) BurnCoins(arg0 types1.Context, arg1 string, arg2 types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BurnCoins", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BurnCoins indicates an expected call of BurnCoins.
+func (mr *MockBankKeeperMockRecorder) BurnCoins(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BurnCoins", reflect.TypeOf((*MockBankKeeper)(nil).BurnCoins), arg0, arg1, arg2)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(arg0 types1.Context, arg1 types1.AccAddress) types1.Coins {
 	m.ctrl.T.Helper()
@@ -132,6 +146,20 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(arg0, arg1, arg2 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), arg0, arg1, arg2)
 }
 
+// GetSupply mocks base method.
+func (m *MockBankKeeper) GetSupply(arg0 types1.Context, arg1 string) types1.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", arg0, arg1)
+	ret0, _ := ret[0].(types1.Coin)
+	return ret0
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockBankKeeperMockRecorder) GetSupply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockBankKeeper)(nil).GetSupply), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(arg0 types1.Context, arg1 string, arg2 types1.Coins) error {
 	m.ctrl.T.Helper()
@@ -482,6 +510,20 @@ func (mr *MockPerpAmmKeeperMockRecorder) IsOverSpreadLimit(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOverSpreadLimit", reflect.TypeOf((*MockPerpAmmKeeper)(nil).IsOverSpreadLimit), arg0, arg1)
 }
 
+// PruneReserveSnapshots mocks base method.
+func (m *MockPerpAmmKeeper) PruneReserveSnapshots(arg0 types1.Context, arg1 time.Duration, arg2 uint64) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneReserveSnapshots", arg0, arg1, arg2)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// PruneReserveSnapshots indicates an expected call of PruneReserveSnapshots.
+func (mr *MockPerpAmmKeeperMockRecorder) PruneReserveSnapshots(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneReserveSnapshots", reflect.TypeOf((*MockPerpAmmKeeper)(nil).PruneReserveSnapshots), arg0, arg1, arg2)
+}
+
 // SwapBaseForQuote mocks base method.
 func (m *MockPerpAmmKeeper) SwapBaseForQuote(arg0 types1.Context, arg1 types0.Market, arg2 types0.Direction, arg3, arg4 types1.Dec, arg5 bool) (types0.Market, types1.Dec, error) {
 	m.ctrl.T.Helper()
@@ -26,5 +26,14 @@ func TestMarket() *v2types.Market {
 		FundingRateEpochId:              "30 min",
 		TwapLookbackWindow:              time.Minute * 30,
 		PrepaidBadDebt:                  sdk.NewInt64Coin(denoms.NUSD, 0),
+		SettlementPrice:                 sdk.ZeroDec(),
+		RepegDivergenceThreshold:        sdk.ZeroDec(),
+		RepegBudgetPerEpoch:             sdk.ZeroInt(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxTraderNotional:               sdk.ZeroDec(),
+		MaxBias:                         sdk.ZeroDec(),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		FundingInterestRate:             sdk.ZeroDec(),
+		FundingDampener:                 sdk.ZeroDec(),
 	}
 }
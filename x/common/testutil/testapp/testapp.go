@@ -43,6 +43,13 @@ func NewNibiruTestAppAndContext(shouldUseDefaultGenesis bool) (*app.NibiruApp, s
 // creates an application instance ('app.NibiruApp'). This app uses an
 // in-memory database ('tmdb.MemDB') and has logging disabled.
 func NewNibiruTestApp(gen app.GenesisState) *app.NibiruApp {
+	return NewNibiruTestAppWithInvCheckPeriod(gen, 0)
+}
+
+// NewNibiruTestAppWithInvCheckPeriod is NewNibiruTestApp with the crisis
+// module asserting the invariants every invCheckPeriod blocks, zero disabling
+// the checks.
+func NewNibiruTestAppWithInvCheckPeriod(gen app.GenesisState, invCheckPeriod uint) *app.NibiruApp {
 	userHomeDir := os.TempDir()
 	nodeHome := filepath.Join(userHomeDir, ".nibid")
 	db := tmdb.NewMemDB()
@@ -57,7 +64,7 @@ func NewNibiruTestApp(gen app.GenesisState) *app.NibiruApp {
 		/*loadLatest=*/ true,
 		/*skipUpgradeHeights=*/ map[int64]bool{},
 		/*homePath=*/ nodeHome,
-		/*invCheckPeriod=*/ 0,
+		/*invCheckPeriod=*/ invCheckPeriod,
 		/*encodingConfig=*/ encoding,
 		/*appOpts=*/ simapp.EmptyAppOptions{},
 	)
@@ -0,0 +1,26 @@
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TimeKeyEncoder encodes times as fixed-length sortable collection keys, so
+// unlike collections.TimeKeyEncoder it can be the first key of a pair.
+var TimeKeyEncoder collections.KeyEncoder[time.Time] = timeKeyEncoder{}
+
+type timeKeyEncoder struct{}
+
+func (timeKeyEncoder) Stringify(t time.Time) string { return t.String() }
+func (timeKeyEncoder) Encode(t time.Time) []byte    { return sdk.FormatTimeBytes(t) }
+func (timeKeyEncoder) Decode(b []byte) (int, time.Time) {
+	n := len(sdk.SortableTimeFormat)
+	t, err := sdk.ParseTimeBytes(b[:n])
+	if err != nil {
+		panic(fmt.Errorf("cannot decode time key: %w", err))
+	}
+	return n, t
+}
@@ -0,0 +1,42 @@
+package common_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+)
+
+func TestTimeKeyEncoder(t *testing.T) {
+	times := []time.Time{
+		time.Unix(0, 0).UTC(),
+		time.Unix(1_700_000_000, 1).UTC(),
+		time.Unix(1_700_000_001, 0).UTC(),
+	}
+
+	for i, tm := range times {
+		encoded := common.TimeKeyEncoder.Encode(tm)
+		n, decoded := common.TimeKeyEncoder.Decode(encoded)
+		assert.Equal(t, len(encoded), n)
+		assert.Equal(t, tm, decoded)
+
+		if i > 0 {
+			assert.Equal(t, -1, bytes.Compare(common.TimeKeyEncoder.Encode(times[i-1]), encoded),
+				"%s should sort before %s", times[i-1], tm)
+		}
+	}
+
+	// the time can lead a pair key
+	addr := testutil.AccAddress()
+	pairEncoder := collections.PairKeyEncoder(common.TimeKeyEncoder, collections.AccAddressKeyEncoder)
+	key := collections.Join(times[1], addr)
+	_, decoded := pairEncoder.Decode(pairEncoder.Encode(key))
+	assert.Equal(t, times[1], decoded.K1())
+	assert.Equal(t, sdk.AccAddress(addr), decoded.K2())
+}
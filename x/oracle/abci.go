@@ -26,5 +26,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	// reset miss counters of all validators at the last block of slash window
 	if types.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
+		k.PrunePerformanceStats(ctx)
 	}
+
+	k.PruneSnapshots(ctx)
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -26,10 +28,15 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryExchangeRateEma(),
+		GetCmdQueryExchangeRateMedian(),
 		GetCmdQueryActives(),
+		GetCmdQueryDerivedPairs(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
+		GetCmdQueryValidatorPerformance(),
+		GetCmdQueryPerformanceLeaderboard(),
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
@@ -91,6 +98,86 @@ $ nibid query oracle exchange-rates nibi:usd
 	return cmd
 }
 
+// GetCmdQueryExchangeRateEma implements the query rate ema command.
+func GetCmdQueryExchangeRateEma() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-ema [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exponential moving average exchange rate of a pair",
+		Long: strings.TrimSpace(`
+Query the exponential moving average (EMA) exchange rate of a pair, over the
+number of price updates set by the ema_span parameter.
+
+$ nibid query oracle exchange-rate-ema nibi:usd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateEma(
+				context.Background(),
+				&types.QueryExchangeRateRequest{Pair: assetPair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateMedian implements the query rate median command.
+func GetCmdQueryExchangeRateMedian() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-median [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the median exchange rate of a pair over its latest prices",
+		Long: strings.TrimSpace(`
+Query the median exchange rate of a pair over its latest price snapshots, as
+many as set by the median_window parameter.
+
+$ nibid query oracle exchange-rate-median nibi:usd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateMedian(
+				context.Background(),
+				&types.QueryExchangeRateRequest{Pair: assetPair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -122,6 +209,38 @@ $ nibid query oracle actives
 	return cmd
 }
 
+// GetCmdQueryDerivedPairs implements the query derived pairs command.
+func GetCmdQueryDerivedPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derived-pairs",
+		Args:  cobra.NoArgs,
+		Short: "Query the pairs whose prices are derived from voted pairs",
+		Long: strings.TrimSpace(`
+Query the pairs whose prices are derived from the prices of voted pairs,
+either as their inverse or as a cross rate through an intermediate denom.
+
+$ nibid query oracle derived-pairs
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedPairs(context.Background(), &types.QueryDerivedPairsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -229,6 +348,122 @@ $ nibid query oracle miss nibivaloper...
 	return cmd
 }
 
+// GetCmdQueryValidatorPerformance implements the query performance of the validator command
+func GetCmdQueryValidatorPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the oracle performance history of a validator",
+		Long: strings.TrimSpace(`
+Query the oracle performance statistics of a validator over the kept slash
+windows: win and miss counts, reward weight, average deviation from the
+tallied exchange rates and rewards earned.
+
+$ nibid query oracle performance nibivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ValidatorPerformance(
+				context.Background(),
+				&types.QueryValidatorPerformanceRequest{
+					ValidatorAddr: validator.String(),
+					Pagination:    pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "performance")
+	return cmd
+}
+
+// GetCmdQueryPerformanceLeaderboard implements the query performance leaderboard command
+func GetCmdQueryPerformanceLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance-leaderboard [slash-window]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the oracle performance of all validators over a slash window",
+		Long: strings.TrimSpace(`
+Query the oracle performance statistics of all validators over a slash window,
+by decreasing reward weight, then increasing average deviation. The slash
+window defaults to the current one.
+
+$ nibid query oracle performance-leaderboard
+
+$ nibid query oracle performance-leaderboard 12 --limit 10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var slashWindow uint64
+			if len(args) == 1 {
+				slashWindow, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			} else {
+				res, err := queryClient.PerformanceLeaderboard(
+					context.Background(),
+					&types.QueryPerformanceLeaderboardRequest{Pagination: &query.PageRequest{Limit: 1}},
+				)
+				if err != nil {
+					return err
+				}
+				slashWindow = res.CurrentSlashWindow
+			}
+
+			res, err := queryClient.PerformanceLeaderboard(
+				context.Background(),
+				&types.QueryPerformanceLeaderboardRequest{
+					SlashWindow: slashWindow,
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "performance leaderboard")
+	return cmd
+}
+
 // GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func GetCmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
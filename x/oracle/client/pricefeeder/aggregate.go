@@ -0,0 +1,45 @@
+package pricefeeder
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// AggregatePrices returns the median price of each pair across the prices of
+// the sources, for the pairs at least minSources sources have a price for.
+func AggregatePrices(sourcePrices []map[asset.Pair]sdk.Dec, minSources int) map[asset.Pair]sdk.Dec {
+	pricesByPair := make(map[asset.Pair][]sdk.Dec)
+	for _, prices := range sourcePrices {
+		for pair, price := range prices {
+			pricesByPair[pair] = append(pricesByPair[pair], price)
+		}
+	}
+
+	aggregated := make(map[asset.Pair]sdk.Dec)
+	for pair, prices := range pricesByPair {
+		if len(prices) < minSources {
+			continue
+		}
+		aggregated[pair] = median(prices)
+	}
+	return aggregated
+}
+
+// median returns the median of the non-empty prices, the mean of the two
+// middle prices for an even count.
+func median(prices []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+	return sorted[mid-1].Add(sorted[mid]).QuoInt64(2)
+}
@@ -0,0 +1,93 @@
+package pricefeeder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Chain is the chain the price feeder votes on.
+type Chain interface {
+	// LatestHeight returns the height of the latest block.
+	LatestHeight(ctx context.Context) (int64, error)
+	// Params returns the oracle params.
+	Params(ctx context.Context) (types.Params, error)
+	// Broadcast signs the msgs with the feeder key and broadcasts them in a
+	// tx, erroring if the tx fails its checks.
+	Broadcast(ctx context.Context, msgs ...sdk.Msg) error
+}
+
+// clientChain is the Chain a client context connects to.
+type clientChain struct {
+	clientCtx   client.Context
+	txFactory   tx.Factory
+	queryClient types.QueryClient
+}
+
+var _ Chain = clientChain{}
+
+// NewClientChain returns the Chain of the client context, which signs with
+// its from key.
+func NewClientChain(clientCtx client.Context, txFactory tx.Factory) Chain {
+	return clientChain{
+		clientCtx:   clientCtx,
+		txFactory:   txFactory,
+		queryClient: types.NewQueryClient(clientCtx),
+	}
+}
+
+func (c clientChain) LatestHeight(_ context.Context) (int64, error) {
+	return rpc.GetChainHeight(c.clientCtx)
+}
+
+func (c clientChain) Params(ctx context.Context) (types.Params, error) {
+	resp, err := c.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, err
+	}
+	return resp.Params, nil
+}
+
+func (c clientChain) Broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	// the account sequence is queried anew for every tx
+	txf, err := c.txFactory.Prepare(c.clientCtx)
+	if err != nil {
+		return err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(c.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	unsignedTx, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+	unsignedTx.SetFeeGranter(c.clientCtx.GetFeeGranterAddress())
+	if err := tx.Sign(txf, c.clientCtx.GetFromName(), unsignedTx, true); err != nil {
+		return err
+	}
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := c.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package pricefeeder
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+const (
+	FlagConfig         = "config"
+	FlagValidator      = "validator"
+	FlagMetricsAddress = "metrics-address"
+	FlagPollInterval   = "poll-interval"
+)
+
+// PriceFeederCmd returns the command running the price feeder daemon.
+func PriceFeederCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-feeder",
+		Args:  cobra.NoArgs,
+		Short: "Run the oracle price feeder of a validator",
+		Long: strings.TrimSpace(`
+Run the oracle price feeder of a validator. Every vote period, the feeder
+fetches the prices of the whitelisted pairs from its sources, aggregates them
+into their median, and submits in a single tx the aggregate vote revealing its
+previous prevote along with a new aggregate prevote.
+
+The feeder signs with the --from key, which the validator must have delegated
+its votes to:
+$ nibid tx oracle set-feeder nibi1... --from validator
+
+The sources are read from a JSON config, by default at
+<home>/config/price-feeder.json:
+{
+  "min_sources": 1,
+  "sources": [
+    {"name": "local", "type": "file", "path": "prices.json"},
+    {"name": "api", "type": "http", "url": "https://api.example/price?symbol={symbol}",
+     "price_path": "data.price", "symbols": {"ubtc:unusd": "BTCUSD"}},
+    {"name": "stream", "type": "websocket", "url": "wss://stream.example/ws",
+     "subscribe": "{\"op\":\"subscribe\"}", "symbol_path": "s", "price_path": "p",
+     "symbols": {"ubtc:unusd": "BTCUSD"}}
+  ]
+}
+
+$ nibid price-feeder --from feeder --validator nibivaloper1... --chain-id nibiru-1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+			txFactory := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			configPath, _ := cmd.Flags().GetString(FlagConfig)
+			if configPath == "" {
+				configPath = filepath.Join(clientCtx.HomeDir, "config", "price-feeder.json")
+			}
+			config, err := ReadConfig(configPath)
+			if err != nil {
+				return err
+			}
+
+			feeder := clientCtx.GetFromAddress()
+			if feeder.Empty() {
+				return fmt.Errorf("no feeder key, set --%s", flags.FlagFrom)
+			}
+			validator := sdk.ValAddress(feeder)
+			if validatorStr, _ := cmd.Flags().GetString(FlagValidator); validatorStr != "" {
+				if validator, err = sdk.ValAddressFromBech32(validatorStr); err != nil {
+					return err
+				}
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := checkFeederDelegation(ctx, clientCtx, feeder, validator); err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "price-feeder")
+			sources, err := config.BuildSources(logger)
+			if err != nil {
+				return err
+			}
+			for _, source := range sources {
+				if websocketSource, ok := source.(*WebsocketSource); ok {
+					websocketSource.Start(ctx)
+				}
+			}
+
+			metrics := NewMetrics()
+			if metricsAddress, _ := cmd.Flags().GetString(FlagMetricsAddress); metricsAddress != "" {
+				go func() {
+					if err := metrics.Serve(metricsAddress); err != nil {
+						logger.Error("metrics server failed", "error", err)
+					}
+				}()
+			}
+
+			pollInterval, _ := cmd.Flags().GetDuration(FlagPollInterval)
+			logger.Info("starting price feeder", "feeder", feeder, "validator", validator, "sources", len(sources))
+			NewFeeder(NewClientChain(clientCtx, txFactory), sources, config.MinSources, feeder, validator, metrics, logger).
+				Run(ctx, pollInterval)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagConfig, "", "Path to the price feeder config (default <home>/config/price-feeder.json)")
+	cmd.Flags().String(FlagValidator, "", "Validator to vote for (default the validator of the feeder key)")
+	cmd.Flags().String(FlagMetricsAddress, ":9464", "Address to serve the Prometheus metrics on, none if empty")
+	cmd.Flags().Duration(FlagPollInterval, time.Second, "Interval between two checks of the latest block")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// checkFeederDelegation checks that the validator delegated its votes to the
+// feeder, unless the feeder is the validator itself.
+func checkFeederDelegation(ctx context.Context, clientCtx client.Context, feeder sdk.AccAddress, validator sdk.ValAddress) error {
+	if feeder.Equals(validator) {
+		return nil
+	}
+
+	resp, err := types.NewQueryClient(clientCtx).FeederDelegation(ctx, &types.QueryFeederDelegationRequest{
+		ValidatorAddr: validator.String(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.FeederAddr != feeder.String() {
+		return fmt.Errorf(
+			"validator %s delegated its votes to %s, not %s: delegate them with `nibid tx oracle set-feeder %s`",
+			validator, resp.FeederAddr, feeder, feeder)
+	}
+	return nil
+}
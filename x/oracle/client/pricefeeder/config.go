@@ -0,0 +1,156 @@
+package pricefeeder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+const (
+	SourceTypeFile      = "file"
+	SourceTypeHTTP      = "http"
+	SourceTypeWebsocket = "websocket"
+
+	defaultTimeout = 5 * time.Second
+	defaultMaxAge  = time.Minute
+)
+
+// Config is the price feeder config, read from a JSON file.
+type Config struct {
+	Sources []SourceConfig `json:"sources"`
+	// MinSources is the number of sources a pair needs a price from to be
+	// voted on. Defaults to 1.
+	MinSources int `json:"min_sources,omitempty"`
+}
+
+// SourceConfig configures a price source. Which fields apply depends on the
+// type of the source.
+type SourceConfig struct {
+	Name string `json:"name"`
+	// Type is one of "file", "http" and "websocket".
+	Type string `json:"type"`
+	// Path is the price file of a file source.
+	Path string `json:"path,omitempty"`
+	// URL is the endpoint of an http or websocket source. The "{symbol}"
+	// placeholder of an http URL is replaced with the symbol of the pair.
+	URL string `json:"url,omitempty"`
+	// Symbols maps the pairs to their symbols at an http or websocket source.
+	Symbols map[string]string `json:"symbols,omitempty"`
+	// PricePath is the dot separated path to the price in the responses of
+	// an http source or the messages of a websocket source.
+	PricePath string `json:"price_path,omitempty"`
+	// SymbolPath is the dot separated path to the symbol in the messages of a
+	// websocket source.
+	SymbolPath string `json:"symbol_path,omitempty"`
+	// Subscribe is the message a websocket source sends once connected.
+	Subscribe string `json:"subscribe,omitempty"`
+	// Timeout is the request timeout of an http source. Defaults to 5s.
+	Timeout string `json:"timeout,omitempty"`
+	// MaxAge is the age past which a websocket price is stale. Defaults to 1m.
+	MaxAge string `json:"max_age,omitempty"`
+}
+
+// ReadConfig reads and validates the config at path.
+func ReadConfig(path string) (Config, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(bz, &config); err != nil {
+		return Config{}, fmt.Errorf("invalid price feeder config %s: %w", path, err)
+	}
+	if config.MinSources == 0 {
+		config.MinSources = 1
+	}
+	return config, config.Validate()
+}
+
+// Validate checks the config, without building its sources.
+func (c Config) Validate() error {
+	if len(c.Sources) == 0 {
+		return fmt.Errorf("no price source configured")
+	}
+	if c.MinSources < 1 || c.MinSources > len(c.Sources) {
+		return fmt.Errorf("min sources must be in [1, %d], is %d", len(c.Sources), c.MinSources)
+	}
+
+	names := make(map[string]struct{}, len(c.Sources))
+	for _, source := range c.Sources {
+		if source.Name == "" {
+			return fmt.Errorf("price source with no name")
+		}
+		if _, found := names[source.Name]; found {
+			return fmt.Errorf("duplicate price source %s", source.Name)
+		}
+		names[source.Name] = struct{}{}
+
+		switch source.Type {
+		case SourceTypeFile:
+			if source.Path == "" {
+				return fmt.Errorf("file source %s has no path", source.Name)
+			}
+		case SourceTypeHTTP, SourceTypeWebsocket:
+			if source.URL == "" {
+				return fmt.Errorf("%s source %s has no url", source.Type, source.Name)
+			}
+			if len(source.Symbols) == 0 {
+				return fmt.Errorf("%s source %s has no symbols", source.Type, source.Name)
+			}
+		default:
+			return fmt.Errorf("price source %s has unknown type %q", source.Name, source.Type)
+		}
+	}
+	return nil
+}
+
+// BuildSources builds the sources of the config. Websocket sources still need
+// to be started.
+func (c Config) BuildSources(logger log.Logger) ([]Source, error) {
+	sources := make([]Source, len(c.Sources))
+	for i, sourceConfig := range c.Sources {
+		symbols := make(map[asset.Pair]string, len(sourceConfig.Symbols))
+		for pairStr, symbol := range sourceConfig.Symbols {
+			pair, err := asset.TryNewPair(pairStr)
+			if err != nil {
+				return nil, fmt.Errorf("price source %s: %w", sourceConfig.Name, err)
+			}
+			symbols[pair] = symbol
+		}
+		timeout, err := parseDurationOr(sourceConfig.Timeout, defaultTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("price source %s: invalid timeout: %w", sourceConfig.Name, err)
+		}
+		maxAge, err := parseDurationOr(sourceConfig.MaxAge, defaultMaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("price source %s: invalid max age: %w", sourceConfig.Name, err)
+		}
+
+		switch sourceConfig.Type {
+		case SourceTypeFile:
+			sources[i] = NewFileSource(sourceConfig.Name, sourceConfig.Path)
+		case SourceTypeHTTP:
+			sources[i] = NewHTTPSource(sourceConfig.Name, sourceConfig.URL, sourceConfig.PricePath, symbols, timeout)
+		case SourceTypeWebsocket:
+			sources[i] = NewWebsocketSource(
+				sourceConfig.Name, sourceConfig.URL, sourceConfig.Subscribe, sourceConfig.SymbolPath, sourceConfig.PricePath,
+				symbols, maxAge, logger)
+		default:
+			return nil, fmt.Errorf("price source %s has unknown type %q", sourceConfig.Name, sourceConfig.Type)
+		}
+	}
+	return sources, nil
+}
+
+func parseDurationOr(s string, fallback time.Duration) (time.Duration, error) {
+	if s == "" {
+		return fallback, nil
+	}
+	return time.ParseDuration(s)
+}
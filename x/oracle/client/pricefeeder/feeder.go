@@ -0,0 +1,190 @@
+package pricefeeder
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Feeder runs the prevote/vote cycle of a validator: once per vote period it
+// reveals the prices it prevoted in the previous vote period and prevotes the
+// prices it fetches from its sources, in a single tx.
+type Feeder struct {
+	chain      Chain
+	sources    []Source
+	minSources int
+	feeder     sdk.AccAddress
+	validator  sdk.ValAddress
+	metrics    *Metrics
+	logger     log.Logger
+
+	// lastVotePeriod is the latest vote period the feeder submitted for, if
+	// started
+	lastVotePeriod uint64
+	started        bool
+	// prevote is the latest prevote of the feeder, revealed in the next vote
+	// period
+	prevote *prevote
+}
+
+// prevote is an aggregate prevote the feeder still has to reveal.
+type prevote struct {
+	salt          string
+	exchangeRates string
+	votePeriod    uint64
+}
+
+// NewFeeder returns a Feeder voting for the validator with the feeder
+// account, which the validator must have delegated its votes to.
+func NewFeeder(
+	chain Chain, sources []Source, minSources int, feeder sdk.AccAddress, validator sdk.ValAddress,
+	metrics *Metrics, logger log.Logger,
+) *Feeder {
+	return &Feeder{
+		chain:      chain,
+		sources:    sources,
+		minSources: minSources,
+		feeder:     feeder,
+		validator:  validator,
+		metrics:    metrics,
+		logger:     logger,
+	}
+}
+
+// Run ticks every poll interval until the context is done.
+func (f *Feeder) Run(ctx context.Context, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f.Tick(ctx); err != nil {
+			f.logger.Error("price feeder tick failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick submits the vote and prevote of the current vote period, unless
+// already submitted. It waits for the next vote period when the next block,
+// in which the tx would land, is in the next vote period.
+func (f *Feeder) Tick(ctx context.Context) error {
+	height, err := f.chain.LatestHeight(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := f.chain.Params(ctx)
+	if err != nil {
+		return err
+	}
+
+	nextHeight := uint64(height) + 1
+	votePeriod := nextHeight / params.VotePeriod
+	if params.VotePeriod > 1 && (nextHeight+1)/params.VotePeriod != votePeriod {
+		return nil
+	}
+	if f.started && votePeriod <= f.lastVotePeriod {
+		return nil
+	}
+
+	var msgs []sdk.Msg
+	revealed := f.prevote != nil && f.prevote.votePeriod+1 == votePeriod
+	if revealed {
+		msgs = append(msgs, types.NewMsgAggregateExchangeRateVote(f.prevote.salt, f.prevote.exchangeRates, f.feeder, f.validator))
+	}
+
+	newPrevote, err := f.newPrevote(ctx, params.Whitelist, votePeriod)
+	if err != nil {
+		return err
+	}
+	if newPrevote != nil {
+		hash := types.GetAggregateVoteHash(newPrevote.salt, newPrevote.exchangeRates, f.validator)
+		msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(hash, f.feeder, f.validator))
+	}
+
+	if len(msgs) > 0 {
+		if err := f.chain.Broadcast(ctx, msgs...); err != nil {
+			f.metrics.broadcastErrors.Inc()
+			return err
+		}
+		if revealed {
+			f.metrics.votes.Inc()
+		}
+		if newPrevote != nil {
+			f.metrics.prevotes.Inc()
+		}
+		f.logger.Info("submitted oracle votes", "vote_period", votePeriod, "vote", revealed, "prevote", newPrevote != nil)
+		f.metrics.votePeriod.Set(float64(votePeriod))
+	}
+
+	f.lastVotePeriod = votePeriod
+	f.started = true
+	f.prevote = newPrevote
+	return nil
+}
+
+// newPrevote fetches the prices of the pairs from the sources and returns a
+// prevote for them with a fresh salt, or nil if no pair has enough prices.
+func (f *Feeder) newPrevote(ctx context.Context, pairs []asset.Pair, votePeriod uint64) (*prevote, error) {
+	var sourcePrices []map[asset.Pair]sdk.Dec
+	for _, source := range f.sources {
+		prices, err := source.Prices(ctx, pairs)
+		if err != nil {
+			f.logger.Error("failed to fetch prices", "source", source.Name(), "error", err)
+			f.metrics.sourceErrors.WithLabelValues(source.Name()).Inc()
+			continue
+		}
+		f.metrics.observeSourcePrices(source.Name(), prices)
+		sourcePrices = append(sourcePrices, prices)
+	}
+
+	prices := AggregatePrices(sourcePrices, f.minSources)
+	if len(prices) == 0 {
+		f.logger.Error("no price to vote on", "vote_period", votePeriod)
+		return nil, nil
+	}
+	f.metrics.observePrices(prices)
+
+	tuples := make(types.ExchangeRateTuples, 0, len(prices))
+	for pair, price := range prices {
+		tuples = append(tuples, types.NewExchangeRateTuple(pair, price))
+	}
+	sort.Slice(tuples, func(i, j int) bool {
+		return tuples[i].Pair.String() < tuples[j].Pair.String()
+	})
+	exchangeRates, err := tuples.ToString()
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := newSalt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &prevote{
+		salt:          salt,
+		exchangeRates: exchangeRates,
+		votePeriod:    votePeriod,
+	}, nil
+}
+
+// newSalt returns a random salt of 4 hex characters, the longest the oracle
+// accepts.
+func newSalt() (string, error) {
+	bz := make([]byte, 2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bz), nil
+}
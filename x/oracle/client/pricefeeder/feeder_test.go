@@ -0,0 +1,104 @@
+package pricefeeder_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/oracle/client/pricefeeder"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// mockChain is a chain at a settable height recording the msgs broadcast.
+type mockChain struct {
+	height int64
+	params types.Params
+	txs    [][]sdk.Msg
+}
+
+func (c *mockChain) LatestHeight(context.Context) (int64, error) { return c.height, nil }
+
+func (c *mockChain) Params(context.Context) (types.Params, error) { return c.params, nil }
+
+func (c *mockChain) Broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	c.txs = append(c.txs, msgs)
+	return nil
+}
+
+func writePrices(t *testing.T, path string, prices string) {
+	require.NoError(t, os.WriteFile(path, []byte(prices), 0o600))
+}
+
+func TestFeederTick(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	feederAddr := testutil.AccAddress()
+	validator := sdk.ValAddress(testutil.AccAddress())
+
+	pricesPath := filepath.Join(t.TempDir(), "prices.json")
+	writePrices(t, pricesPath, `{"ubtc:unusd": "40000", "ueth:unusd": 2000}`)
+	otherPricesPath := filepath.Join(t.TempDir(), "prices.json")
+	writePrices(t, otherPricesPath, `{"ubtc:unusd": "40010"}`)
+
+	params := types.DefaultParams()
+	params.VotePeriod = 10
+	params.Whitelist = []asset.Pair{btc, eth}
+	chain := &mockChain{height: 9, params: params}
+	feeder := pricefeeder.NewFeeder(chain, []pricefeeder.Source{
+		pricefeeder.NewFileSource("a", pricesPath),
+		pricefeeder.NewFileSource("b", otherPricesPath),
+	}, 1, feederAddr, validator, pricefeeder.NewMetrics(), log.NewNopLogger())
+
+	t.Log("the first tick only prevotes")
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 1)
+	require.Len(t, chain.txs[0], 1)
+	prevote := chain.txs[0][0].(*types.MsgAggregateExchangeRatePrevote)
+	require.Equal(t, feederAddr.String(), prevote.Feeder)
+	require.Equal(t, validator.String(), prevote.Validator)
+
+	t.Log("nothing more is submitted in the same vote period")
+	chain.height = 15
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 1)
+
+	t.Log("nothing is submitted when the tx would land in the last block of a vote period")
+	chain.height = 18
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 1)
+
+	t.Log("the next vote period reveals the prevote, with the median prices, and prevotes again")
+	chain.height = 19
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 2)
+	require.Len(t, chain.txs[1], 2)
+	vote := chain.txs[1][0].(*types.MsgAggregateExchangeRateVote)
+	require.Equal(t, "(ubtc:unusd,40005.000000000000000000)|(ueth:unusd,2000.000000000000000000)", vote.ExchangeRates)
+	require.Equal(t, prevote.Hash, types.GetAggregateVoteHash(vote.Salt, vote.ExchangeRates, validator).String())
+	_, ok := chain.txs[1][1].(*types.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	t.Log("a prevote older than the previous vote period isn't revealed")
+	chain.height = 39
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 3)
+	require.Len(t, chain.txs[2], 1)
+	_, ok = chain.txs[2][0].(*types.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	t.Log("without enough sources for any pair, nothing is submitted")
+	feeder = pricefeeder.NewFeeder(chain, []pricefeeder.Source{
+		pricefeeder.NewFileSource("a", pricesPath),
+	}, 2, feederAddr, validator, pricefeeder.NewMetrics(), log.NewNopLogger())
+	chain.height = 49
+	require.NoError(t, feeder.Tick(context.Background()))
+	require.Len(t, chain.txs, 3)
+}
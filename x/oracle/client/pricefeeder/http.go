@@ -0,0 +1,86 @@
+package pricefeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// HTTPSource fetches the price of each pair from a JSON HTTP API, one request
+// per pair. The "{symbol}" placeholder of the URL is replaced with the
+// symbol of the pair at the source, and the price is read at the price path
+// of the response.
+type HTTPSource struct {
+	name      string
+	url       string
+	pricePath string
+	// symbols maps the pairs to their symbols at the source
+	symbols map[asset.Pair]string
+	client  *http.Client
+}
+
+var _ Source = (*HTTPSource)(nil)
+
+// NewHTTPSource returns an HTTPSource querying the url for the pairs of the
+// symbols.
+func NewHTTPSource(name string, url string, pricePath string, symbols map[asset.Pair]string, timeout time.Duration) *HTTPSource {
+	return &HTTPSource{
+		name:      name,
+		url:       url,
+		pricePath: pricePath,
+		symbols:   symbols,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *HTTPSource) Name() string { return s.name }
+
+func (s *HTTPSource) Prices(ctx context.Context, pairs []asset.Pair) (map[asset.Pair]sdk.Dec, error) {
+	prices := make(map[asset.Pair]sdk.Dec)
+	for _, pair := range pairs {
+		symbol, found := s.symbols[pair]
+		if !found {
+			continue
+		}
+
+		price, err := s.fetch(ctx, symbol)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pair, err)
+		}
+		prices[pair] = price
+	}
+	return prices, nil
+}
+
+func (s *HTTPSource) fetch(ctx context.Context, symbol string) (sdk.Dec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(s.url, "{symbol}", symbol), nil)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return sdk.Dec{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var body interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return sdk.Dec{}, err
+	}
+	value, err := jsonPathValue(body, s.pricePath)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return parsePrice(value)
+}
@@ -0,0 +1,103 @@
+package pricefeeder
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+const metricsNamespace = "nibiru_price_feeder"
+
+// Metrics are the Prometheus metrics of the price feeder.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	sourcePrice     *prometheus.GaugeVec
+	sourceErrors    *prometheus.CounterVec
+	price           *prometheus.GaugeVec
+	votePeriod      prometheus.Gauge
+	prevotes        prometheus.Counter
+	votes           prometheus.Counter
+	broadcastErrors prometheus.Counter
+}
+
+// NewMetrics returns the metrics of the price feeder, in a registry of their
+// own.
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		sourcePrice: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "source_price",
+			Help:      "Latest price of a pair at a source.",
+		}, []string{"source", "pair"}),
+		sourceErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "source_errors_total",
+			Help:      "Number of failed price fetches of a source.",
+		}, []string{"source"}),
+		price: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "price",
+			Help:      "Latest aggregated price of a pair.",
+		}, []string{"pair"}),
+		votePeriod: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "vote_period",
+			Help:      "Latest vote period the feeder submitted for.",
+		}),
+		prevotes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "prevotes_total",
+			Help:      "Number of aggregate prevotes submitted.",
+		}),
+		votes: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "votes_total",
+			Help:      "Number of aggregate votes submitted.",
+		}),
+		broadcastErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "broadcast_errors_total",
+			Help:      "Number of vote txs that failed to broadcast.",
+		}),
+	}
+	m.registry.MustRegister(
+		m.sourcePrice, m.sourceErrors, m.price, m.votePeriod, m.prevotes, m.votes, m.broadcastErrors,
+	)
+	return m
+}
+
+// Serve serves the metrics at /metrics on the address until the server
+// fails.
+func (m *Metrics) Serve(address string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Addr:              address,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (m *Metrics) observeSourcePrices(source string, prices map[asset.Pair]sdk.Dec) {
+	for pair, price := range prices {
+		m.sourcePrice.WithLabelValues(source, pair.String()).Set(price.MustFloat64())
+	}
+}
+
+func (m *Metrics) observePrices(prices map[asset.Pair]sdk.Dec) {
+	for pair, price := range prices {
+		m.price.WithLabelValues(pair.String()).Set(price.MustFloat64())
+	}
+}
@@ -0,0 +1,120 @@
+package pricefeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// Source is a price source of the price feeder.
+type Source interface {
+	// Name identifies the source in the logs and metrics.
+	Name() string
+	// Prices returns the prices of the pairs the source knows of. Pairs it
+	// has no price for are left out.
+	Prices(ctx context.Context, pairs []asset.Pair) (map[asset.Pair]sdk.Dec, error)
+}
+
+// FileSource reads the prices from a JSON file mapping pairs to prices, e.g.
+// {"ubtc:unusd": "40000.5"}. The file is read on every fetch, so it can be
+// edited while the feeder runs. Meant for local testing.
+type FileSource struct {
+	name string
+	path string
+}
+
+var _ Source = (*FileSource)(nil)
+
+// NewFileSource returns a FileSource reading the file at path.
+func NewFileSource(name string, path string) *FileSource {
+	return &FileSource{name: name, path: path}
+}
+
+func (s *FileSource) Name() string { return s.name }
+
+func (s *FileSource) Prices(_ context.Context, pairs []asset.Pair) (map[asset.Pair]sdk.Dec, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawPrices map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &rawPrices); err != nil {
+		return nil, fmt.Errorf("invalid price file %s: %w", s.path, err)
+	}
+
+	prices := make(map[asset.Pair]sdk.Dec)
+	for _, pair := range pairs {
+		raw, found := rawPrices[pair.String()]
+		if !found {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+		price, err := parsePrice(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for %s: %w", pair, err)
+		}
+		prices[pair] = price
+	}
+	return prices, nil
+}
+
+// jsonPathValue returns the value at the dot separated path of a decoded JSON
+// value, e.g. "data.0.price". Path elements index arrays when the value at
+// that point is an array.
+func jsonPathValue(value interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return value, nil
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, found := v[key]
+			if !found {
+				return nil, fmt.Errorf("no %q in %q", key, path)
+			}
+			value = next
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("no index %q in %q", key, path)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("no %q in %q", key, path)
+		}
+	}
+	return value, nil
+}
+
+// parsePrice parses a positive price out of a decoded JSON string or number.
+func parsePrice(value interface{}) (sdk.Dec, error) {
+	var str string
+	switch v := value.(type) {
+	case string:
+		str = v
+	case float64:
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return sdk.Dec{}, fmt.Errorf("%v is neither a string nor a number", value)
+	}
+
+	price, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !price.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("price %s is not positive", price)
+	}
+	return price, nil
+}
@@ -0,0 +1,134 @@
+package pricefeeder_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/client/pricefeeder"
+)
+
+func TestFileSource(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	path := filepath.Join(t.TempDir(), "prices.json")
+	source := pricefeeder.NewFileSource("file", path)
+
+	writePrices(t, path, `{"ubtc:unusd": "40000.5", "ueth:unusd": 2000, "unibi:unusd": "1"}`)
+	prices, err := source.Prices(context.Background(), []asset.Pair{btc, eth})
+	require.NoError(t, err)
+	require.Equal(t, map[asset.Pair]sdk.Dec{
+		btc: sdk.MustNewDecFromStr("40000.5"),
+		eth: sdk.NewDec(2000),
+	}, prices)
+
+	t.Log("the file is read anew on every fetch")
+	writePrices(t, path, `{"ubtc:unusd": "41000"}`)
+	prices, err = source.Prices(context.Background(), []asset.Pair{btc, eth})
+	require.NoError(t, err)
+	require.Equal(t, map[asset.Pair]sdk.Dec{btc: sdk.NewDec(41000)}, prices)
+
+	writePrices(t, path, `{"ubtc:unusd": "-1"}`)
+	_, err = source.Prices(context.Background(), []asset.Pair{btc})
+	require.ErrorContains(t, err, "not positive")
+}
+
+func TestHTTPSource(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("symbol") {
+		case "BTCUSD":
+			_, _ = w.Write([]byte(`{"data": [{"price": "40000.5"}]}`))
+		case "ETHUSD":
+			_, _ = w.Write([]byte(`{"data": [{"price": 2000}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source := pricefeeder.NewHTTPSource("http", server.URL+"?symbol={symbol}", "data.0.price", map[asset.Pair]string{
+		btc: "BTCUSD",
+		eth: "ETHUSD",
+	}, time.Second)
+	prices, err := source.Prices(context.Background(), []asset.Pair{btc, eth, asset.NewPair(denoms.NIBI, denoms.NUSD)})
+	require.NoError(t, err)
+	require.Equal(t, map[asset.Pair]sdk.Dec{
+		btc: sdk.MustNewDecFromStr("40000.5"),
+		eth: sdk.NewDec(2000),
+	}, prices)
+
+	source = pricefeeder.NewHTTPSource("http", server.URL+"?symbol={symbol}", "data.0.price", map[asset.Pair]string{
+		btc: "UNKNOWN",
+	}, time.Second)
+	_, err = source.Prices(context.Background(), []asset.Pair{btc})
+	require.ErrorContains(t, err, "404")
+}
+
+func TestWebsocketSource(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, subscribe, err := conn.ReadMessage()
+		require.NoError(t, err)
+		require.Equal(t, `{"op":"subscribe"}`, string(subscribe))
+		for _, msg := range []string{
+			`{"event": "subscribed"}`,
+			`{"s": "BTCUSD", "p": "40000.5"}`,
+			`{"s": "DOGEUSD", "p": "0.1"}`,
+		} {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		}
+		// hold the connection until the client closes it
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	source := pricefeeder.NewWebsocketSource(
+		"websocket", "ws"+strings.TrimPrefix(server.URL, "http"), `{"op":"subscribe"}`, "s", "p",
+		map[asset.Pair]string{btc: "BTCUSD", eth: "ETHUSD"}, time.Minute, log.NewNopLogger())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source.Start(ctx)
+
+	require.Eventually(t, func() bool {
+		prices, err := source.Prices(ctx, []asset.Pair{btc, eth})
+		require.NoError(t, err)
+		return prices[btc].Equal(sdk.MustNewDecFromStr("40000.5")) && len(prices) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestAggregatePrices(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+
+	sourcePrices := []map[asset.Pair]sdk.Dec{
+		{btc: sdk.NewDec(100), eth: sdk.NewDec(10)},
+		{btc: sdk.NewDec(300)},
+		{btc: sdk.NewDec(110), eth: sdk.NewDec(12)},
+	}
+	require.Equal(t, map[asset.Pair]sdk.Dec{
+		btc: sdk.NewDec(110),
+		eth: sdk.NewDec(11),
+	}, pricefeeder.AggregatePrices(sourcePrices, 2))
+	require.Equal(t, map[asset.Pair]sdk.Dec{
+		btc: sdk.NewDec(110),
+	}, pricefeeder.AggregatePrices(sourcePrices, 3))
+}
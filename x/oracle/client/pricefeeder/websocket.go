@@ -0,0 +1,171 @@
+package pricefeeder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/websocket"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// WebsocketSource streams prices from a websocket API. It sends the
+// subscribe message once connected and reads the symbol and the price of
+// each message it receives at the symbol and price paths. Messages missing
+// either are ignored. Prices older than the max age are considered stale and
+// left out.
+type WebsocketSource struct {
+	name       string
+	url        string
+	subscribe  string
+	symbolPath string
+	pricePath  string
+	// pairs maps the symbols at the source to their pairs
+	pairs  map[string]asset.Pair
+	maxAge time.Duration
+	logger log.Logger
+
+	mu     sync.RWMutex
+	prices map[asset.Pair]timestampedPrice
+}
+
+type timestampedPrice struct {
+	price sdk.Dec
+	time  time.Time
+}
+
+var _ Source = (*WebsocketSource)(nil)
+
+// NewWebsocketSource returns a WebsocketSource streaming the prices of the
+// pairs of the symbols from the url. It only connects once started.
+func NewWebsocketSource(
+	name string, url string, subscribe string, symbolPath string, pricePath string,
+	symbols map[asset.Pair]string, maxAge time.Duration, logger log.Logger,
+) *WebsocketSource {
+	pairs := make(map[string]asset.Pair, len(symbols))
+	for pair, symbol := range symbols {
+		pairs[symbol] = pair
+	}
+	return &WebsocketSource{
+		name:       name,
+		url:        url,
+		subscribe:  subscribe,
+		symbolPath: symbolPath,
+		pricePath:  pricePath,
+		pairs:      pairs,
+		maxAge:     maxAge,
+		logger:     logger.With("source", name),
+		prices:     make(map[asset.Pair]timestampedPrice),
+	}
+}
+
+func (s *WebsocketSource) Name() string { return s.name }
+
+func (s *WebsocketSource) Prices(_ context.Context, pairs []asset.Pair) (map[asset.Pair]sdk.Dec, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	prices := make(map[asset.Pair]sdk.Dec)
+	for _, pair := range pairs {
+		price, found := s.prices[pair]
+		if !found || time.Since(price.time) > s.maxAge {
+			continue
+		}
+		prices[pair] = price.price
+	}
+	return prices, nil
+}
+
+// Start streams the prices until the context is done, reconnecting after
+// a second whenever the connection fails.
+func (s *WebsocketSource) Start(ctx context.Context) {
+	go func() {
+		for {
+			if err := s.stream(ctx); err != nil {
+				s.logger.Error("websocket stream failed", "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+		}
+	}()
+}
+
+func (s *WebsocketSource) stream(ctx context.Context) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, s.url, nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// unblock ReadMessage once the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	if s.subscribe != "" {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(s.subscribe)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		_, bz, err := conn.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err := s.handleMessage(bz); err != nil {
+			s.logger.Debug("ignored websocket message", "error", err)
+		}
+	}
+}
+
+func (s *WebsocketSource) handleMessage(bz []byte) error {
+	var msg interface{}
+	if err := json.Unmarshal(bz, &msg); err != nil {
+		return err
+	}
+
+	symbolValue, err := jsonPathValue(msg, s.symbolPath)
+	if err != nil {
+		return err
+	}
+	symbol, ok := symbolValue.(string)
+	if !ok {
+		return fmt.Errorf("symbol %v is not a string", symbolValue)
+	}
+	pair, found := s.pairs[symbol]
+	if !found {
+		return fmt.Errorf("unknown symbol %s", symbol)
+	}
+
+	priceValue, err := jsonPathValue(msg, s.pricePath)
+	if err != nil {
+		return err
+	}
+	price, err := parsePrice(priceValue)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prices[pair] = timestampedPrice{price: price, time: time.Now()}
+	return nil
+}
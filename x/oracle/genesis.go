@@ -70,10 +70,23 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		}
 	}
 
+	for _, derivedPair := range data.DerivedPairs {
+		keeper.DerivedPairs.Insert(ctx, derivedPair.Pair, derivedPair)
+	}
+
 	for _, pr := range data.Rewards {
 		keeper.Rewards.Insert(ctx, pr.Id, pr)
 	}
 
+	for _, stats := range data.PerformanceStats {
+		valAddr, err := sdk.ValAddressFromBech32(stats.Validator)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetPerformanceStats(ctx, valAddr, stats)
+	}
+
 	// set last ID based on the last pair reward
 	if len(data.Rewards) != 0 {
 		keeper.RewardsID.Set(ctx, data.Rewards[len(data.Rewards)-1].Id)
@@ -117,7 +130,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	var pairs []asset.Pair
 	pairs = append(pairs, keeper.WhitelistedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()...)
 
-	return types.NewGenesisState(params,
+	genesis := types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
@@ -126,4 +139,7 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
 	)
+	genesis.DerivedPairs = keeper.DerivedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	genesis.PerformanceStats = keeper.PerformanceStats.Iterate(ctx, collections.Range[collections.Pair[uint64, sdk.ValAddress]]{}).Values()
+	return genesis
 }
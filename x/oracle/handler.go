@@ -0,0 +1,31 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/keeper"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// NewDerivedPairProposalHandler returns a govtypes.Handler for the derived
+// pair proposals of "x/oracle".
+func NewDerivedPairProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+
+		switch proposal := content.(type) {
+		case *types.AddDerivedPairProposal:
+			return k.AddDerivedPair(ctx, proposal.DerivedPair)
+		case *types.RemoveDerivedPairProposal:
+			return k.RemoveDerivedPair(ctx, proposal.Pair)
+		default:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, proposal)
+		}
+	}
+}
@@ -91,7 +91,7 @@ func isPassingVoteThreshold(ballots types.ExchangeRateBallots, thresholdVotingPo
 
 // removeInvalidBallots removes the ballots which have not reached the vote threshold
 // or which are not part of the whitelisted pairs anymore: example when params change during a vote period
-// but some votes were already made.
+// but some votes were already made. A halt event is emitted for the whitelisted pairs whose ballot failed.
 //
 // ALERT: This function mutates pairBallotMap slice, it removes the ballot for the pair which is not passing the threshold
 // or which is not whitelisted anymore.
@@ -116,6 +116,7 @@ func (k Keeper) removeInvalidBallots(
 		// If the ballot is not passed, remove it from the whitelistedPairs set
 		// to prevent slashing validators who did valid vote.
 		if !isPassingVoteThreshold(ballots, thresholdVotingPower, minVoters) {
+			emitPriceHalt(ctx, pair, types.HaltReasonInsufficientVoters, sdk.ZeroDec(), sdk.ZeroDec(), ballots)
 			delete(whitelistedPairs, pair)
 			delete(pairBallotsMap, pair)
 			continue
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// AddDerivedPair registers a pair whose price is derived from the prices of
+// voted pairs every vote period. The pair must not be voted on itself, and
+// its source pairs must be whitelisted.
+func (k Keeper) AddDerivedPair(ctx sdk.Context, derivedPair types.DerivedPair) error {
+	if err := derivedPair.Validate(); err != nil {
+		return types.ErrInvalidDerivedPair.Wrap(err.Error())
+	}
+	if k.IsDerivedPair(ctx, derivedPair.Pair) {
+		return types.ErrInvalidDerivedPair.Wrapf("%s is already derived", derivedPair.Pair)
+	}
+
+	whitelist := set.New(k.Whitelist(ctx)...)
+	if whitelist.Has(derivedPair.Pair) {
+		return types.ErrInvalidDerivedPair.Wrapf("%s is voted on", derivedPair.Pair)
+	}
+	for _, sourcePair := range derivedPair.SourcePairs() {
+		if !whitelist.Has(sourcePair) {
+			return types.ErrInvalidDerivedPair.Wrapf("source pair %s of %s is not voted on", sourcePair, derivedPair.Pair)
+		}
+	}
+
+	k.DerivedPairs.Insert(ctx, derivedPair.Pair, derivedPair)
+	return nil
+}
+
+// IsDerivedPair returns whether the price of the pair is derived from the
+// prices of voted pairs.
+func (k Keeper) IsDerivedPair(ctx sdk.Context, pair asset.Pair) bool {
+	_, err := k.DerivedPairs.Get(ctx, pair)
+	return err == nil
+}
+
+// RemoveDerivedPair stops deriving the price of a pair, and deletes its
+// current price and EMA.
+func (k Keeper) RemoveDerivedPair(ctx sdk.Context, pair asset.Pair) error {
+	if err := k.DerivedPairs.Delete(ctx, pair); err != nil {
+		return types.ErrUnknownPair.Wrapf("%s is not derived", pair)
+	}
+	_ = k.ExchangeRates.Delete(ctx, pair)
+	_ = k.ExchangeRateEmas.Delete(ctx, pair)
+	return nil
+}
+
+// updateDerivedExchangeRates sets the prices of the derived pairs from the
+// prices of their source pairs and adds them to the EMA of the derived pairs.
+// Pairs with a source pair not published in this block keep their current
+// price, if any, until it expires.
+func (k Keeper) updateDerivedExchangeRates(ctx sdk.Context, params types.Params) {
+	for _, derivedPair := range k.DerivedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		sourcePairs := derivedPair.SourcePairs()
+		sourcePrices := make([]sdk.Dec, 0, len(sourcePairs))
+		for _, sourcePair := range sourcePairs {
+			price, age, err := k.GetExchangeRateWithAge(ctx, sourcePair)
+			if err != nil || age != 0 || !price.IsPositive() {
+				break
+			}
+			sourcePrices = append(sourcePrices, price)
+		}
+		if len(sourcePrices) != len(sourcePairs) {
+			continue
+		}
+
+		exchangeRate := derivedPair.Price(sourcePrices)
+		k.SetPrice(ctx, derivedPair.Pair, exchangeRate)
+		k.updateExchangeRateEma(ctx, params.EmaSpan, derivedPair.Pair, exchangeRate)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeExchangeRateUpdate,
+				sdk.NewAttribute(types.AttributeKeyPair, derivedPair.Pair.String()),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			),
+		)
+	}
+}
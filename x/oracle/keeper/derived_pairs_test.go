@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestDerivedPairs(t *testing.T) {
+	input, h := Setup(t)
+	btcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	ethBtc := asset.NewPair(denoms.ETH, denoms.BTC)
+	nusdBtc := btcNusd.Inverse()
+
+	t.Log("derived pairs must derive from voted pairs")
+	require.ErrorIs(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewInverseDerivedPair(ethNusd)), types.ErrInvalidDerivedPair)
+	require.ErrorIs(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewCrossDerivedPair(ethBtc, "uxyz")), types.ErrInvalidDerivedPair)
+	require.ErrorIs(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewCrossDerivedPair(ethBtc, denoms.ETH)), types.ErrInvalidDerivedPair)
+
+	require.NoError(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewCrossDerivedPair(ethBtc, denoms.NUSD)))
+	require.NoError(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewInverseDerivedPair(nusdBtc)))
+	require.ErrorIs(t, input.OracleKeeper.AddDerivedPair(input.Ctx, types.NewInverseDerivedPair(nusdBtc)), types.ErrInvalidDerivedPair)
+
+	for valIdx := 0; valIdx < 4; valIdx++ {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+			{Pair: btcNusd, ExchangeRate: sdk.NewDec(40_000)},
+			{Pair: ethNusd, ExchangeRate: sdk.NewDec(2_000)},
+		}, valIdx)
+	}
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+
+	t.Log("the derived pairs are priced from the voted pairs")
+	price, err := input.OracleKeeper.GetExchangeRate(input.Ctx, ethBtc)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), price)
+	price, err = input.OracleKeeper.GetExchangeRate(input.Ctx, nusdBtc)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.000025"), price)
+
+	t.Log("queries mark the derived pairs")
+	querier := NewQuerier(input.OracleKeeper)
+	rateResp, err := querier.ExchangeRate(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRateRequest{Pair: ethBtc})
+	require.NoError(t, err)
+	require.True(t, rateResp.Derived)
+	rateResp, err = querier.ExchangeRate(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRateRequest{Pair: ethNusd})
+	require.NoError(t, err)
+	require.False(t, rateResp.Derived)
+	ratesResp, err := querier.ExchangeRates(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, ratesResp.ExchangeRates, 4)
+	require.ElementsMatch(t, []asset.Pair{ethBtc, nusdBtc}, ratesResp.DerivedPairs)
+	derivedResp, err := querier.DerivedPairs(sdk.WrapSDKContext(input.Ctx), &types.QueryDerivedPairsRequest{})
+	require.NoError(t, err)
+	require.Len(t, derivedResp.DerivedPairs, 2)
+
+	t.Log("removing a derived pair deletes its price")
+	require.NoError(t, input.OracleKeeper.RemoveDerivedPair(input.Ctx, ethBtc))
+	require.ErrorIs(t, input.OracleKeeper.RemoveDerivedPair(input.Ctx, ethBtc), types.ErrUnknownPair)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, ethBtc)
+	require.Error(t, err)
+
+	t.Log("a derived pair without source price is left without price")
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, nusdBtc)
+	require.Error(t, err)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -44,8 +45,24 @@ type Keeper struct {
 	WhitelistedPairs collections.KeySet[asset.Pair]
 	Rewards          collections.Map[uint64, types.Rewards]
 	RewardsID        collections.Sequence
+	// DerivedPairs maps the pairs whose prices are derived from voted pairs to
+	// their derivation.
+	DerivedPairs collections.Map[asset.Pair, types.DerivedPair]
+	// ExchangeRateEmas maps pairs to the exponential moving average of their
+	// exchange rates, updated with every exchange rate published by the EndBlocker.
+	ExchangeRateEmas collections.Map[asset.Pair, sdk.Dec]
+	// PerformanceStats maps the slash window and the validator to the oracle
+	// performance statistics of the validator over the slash window.
+	PerformanceStats collections.Map[collections.Pair[uint64, sdk.ValAddress], types.ValidatorPerformanceStats]
+	// ValidatorPerformanceWindows indexes PerformanceStats by validator, then
+	// slash window.
+	ValidatorPerformanceWindows collections.KeySet[collections.Pair[sdk.ValAddress, uint64]]
 }
 
+// validatorPerformanceWindowsNamespace is the store prefix of
+// Keeper.ValidatorPerformanceWindows.
+const validatorPerformanceWindowsNamespace collections.Namespace = 15
+
 // NewKeeper constructs a new keeper for oracle
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 	accountKeeper types.AccountKeeper,
@@ -75,7 +92,16 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 		Rewards: collections.NewMap(
 			storeKey, 7,
 			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
-		RewardsID: collections.NewSequence(storeKey, 9),
+		RewardsID:        collections.NewSequence(storeKey, 9),
+		DerivedPairs:     collections.NewMap(storeKey, 12, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DerivedPair](cdc)),
+		ExchangeRateEmas: collections.NewMap(storeKey, 13, asset.PairKeyEncoder, collections.DecValueEncoder),
+		PerformanceStats: collections.NewMap(
+			storeKey, 14,
+			collections.PairKeyEncoder(collections.Uint64KeyEncoder, collections.ValAddressKeyEncoder),
+			collections.ProtoValueEncoder[types.ValidatorPerformanceStats](cdc)),
+		ValidatorPerformanceWindows: collections.NewKeySet(
+			storeKey, validatorPerformanceWindowsNamespace,
+			collections.PairKeyEncoder(collections.ValAddressKeyEncoder, collections.Uint64KeyEncoder)),
 	}
 }
 
@@ -186,6 +212,32 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec
 	return k.ExchangeRates.Get(ctx, pair)
 }
 
+// GetExchangeRateWithAge returns the exchange rate of a pair and the time
+// elapsed since it was published.
+func (k Keeper) GetExchangeRateWithAge(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, age time.Duration, err error) {
+	price, err = k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return
+	}
+
+	publishedAt, err := k.lastPriceSnapshotTime(ctx, pair)
+	if err != nil {
+		return
+	}
+	return price, ctx.BlockTime().Sub(publishedAt), nil
+}
+
+// lastPriceSnapshotTime returns the time of the latest price snapshot of a pair.
+func (k Keeper) lastPriceSnapshotTime(ctx sdk.Context, pair asset.Pair) (time.Time, error) {
+	iter := k.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair).Descending())
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return time.Time{}, fmt.Errorf("no price snapshot for %s: %w", pair, collections.ErrNotFound)
+	}
+	return iter.Key().K2(), nil
+}
+
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 	k.ExchangeRates.Insert(ctx, pair, price)
@@ -205,3 +257,43 @@ func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 		ctx.Logger().Error("failed to emit OraclePriceUpdate", "pair", pair, "error", err)
 	}
 }
+
+// PruneSnapshots deletes the price snapshots older than SnapshotRetentionLookbacks
+// twap lookback windows, except the latest one of each pair, up to
+// MaxSnapshotsPrunedPerBlock snapshots across all pairs. Every pair with
+// snapshots is pruned, including the ones whose exchange rate expired or was
+// delisted. Does nothing if SnapshotRetentionLookbacks is zero. Called in the
+// EndBlocker.
+func (k Keeper) PruneSnapshots(ctx sdk.Context) (pruned uint64) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.SnapshotRetentionLookbacks == 0 {
+		return 0
+	}
+
+	cutoff := ctx.BlockTime().Add(-time.Duration(params.SnapshotRetentionLookbacks) * params.TwapLookbackWindow)
+	for pair, ok := k.nextSnapshotPair(ctx, nil); ok && pruned < params.MaxSnapshotsPrunedPerBlock; pair, ok = k.nextSnapshotPair(ctx, &pair) {
+		pruned += common.PruneSnapshots(ctx, k.PriceSnapshots, pair, cutoff, params.MaxSnapshotsPrunedPerBlock-pruned)
+	}
+	return pruned
+}
+
+// nextSnapshotPair returns the first pair with price snapshots after the given
+// one, or the first pair with snapshots if after is nil. It seeks past the
+// latest snapshot of after instead of walking its snapshots.
+func (k Keeper) nextSnapshotPair(ctx sdk.Context, after *asset.Pair) (pair asset.Pair, ok bool) {
+	rng := collections.Range[collections.Pair[asset.Pair, time.Time]]{}
+	if after != nil {
+		latest := k.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(*after).Descending())
+		if latest.Valid() {
+			rng = rng.StartExclusive(latest.Key())
+		}
+		latest.Close()
+	}
+
+	iter := k.PriceSnapshots.Iterate(ctx, rng)
+	defer iter.Close()
+	if !iter.Valid() {
+		return pair, false
+	}
+	return iter.Key().K1(), true
+}
@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 )
 
 func TestValidateFeeder(t *testing.T) {
@@ -51,3 +56,51 @@ func TestValidateFeeder(t *testing.T) {
 	input.StakingKeeper.SetValidator(input.Ctx, validator)
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), addr))
 }
+
+func TestPruneSnapshots(t *testing.T) {
+	input := CreateTestFixture(t)
+	btc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	eth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.TwapLookbackWindow = time.Minute
+	params.SnapshotRetentionLookbacks = 2
+	params.MaxSnapshotsPrunedPerBlock = 5
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// a snapshot of each pair every 30s for 5 minutes
+	start := input.Ctx.BlockTime()
+	for i := 0; i <= 10; i++ {
+		ctx := input.Ctx.WithBlockTime(start.Add(time.Duration(i) * 30 * time.Second))
+		input.OracleKeeper.SetPrice(ctx, btc, sdk.NewDec(int64(i)))
+		input.OracleKeeper.SetPrice(ctx, eth, sdk.NewDec(int64(i)))
+	}
+	// the eth rate expired, its snapshots are pruned all the same
+	require.NoError(t, input.OracleKeeper.ExchangeRates.Delete(input.Ctx, eth))
+	snapshotPrices := func(pair asset.Pair) (prices []int64) {
+		for _, snapshot := range input.OracleKeeper.PriceSnapshots.Iterate(input.Ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Values() {
+			prices = append(prices, snapshot.Price.TruncateInt64())
+		}
+		return prices
+	}
+
+	t.Log("nothing is pruned while the pruning is disabled")
+	ctx := input.Ctx.WithBlockTime(start.Add(5 * time.Minute))
+	params.SnapshotRetentionLookbacks = 0
+	input.OracleKeeper.Params.Set(ctx, params)
+	require.Zero(t, input.OracleKeeper.PruneSnapshots(ctx))
+
+	t.Log("the snapshots older than 2 minutes are pruned, 5 at most per block")
+	params.SnapshotRetentionLookbacks = 2
+	input.OracleKeeper.Params.Set(ctx, params)
+	require.EqualValues(t, 5, input.OracleKeeper.PruneSnapshots(ctx))
+	require.Equal(t, []int64{5, 6, 7, 8, 9, 10}, snapshotPrices(btc))
+	require.Len(t, snapshotPrices(eth), 11)
+	require.EqualValues(t, 5, input.OracleKeeper.PruneSnapshots(ctx))
+	require.Zero(t, input.OracleKeeper.PruneSnapshots(ctx))
+
+	t.Log("the latest snapshot before the cutoff is kept for the TWAP")
+	require.Equal(t, []int64{5, 6, 7, 8, 9, 10}, snapshotPrices(btc))
+	require.Equal(t, []int64{5, 6, 7, 8, 9, 10}, snapshotPrices(eth))
+}
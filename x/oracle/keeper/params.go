@@ -72,3 +72,13 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	params, _ := k.Params.Get(ctx)
 	return params.MinValidPerWindow
 }
+
+// MaxPriceDeviation returns the maximum relative deviation of a tallied
+// exchange rate from the prior TWAP of its pair. Zero disables the check.
+func (k Keeper) MaxPriceDeviation(ctx sdk.Context) (res sdk.Dec) {
+	params, _ := k.Params.Get(ctx)
+	if params.MaxPriceDeviation.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return params.MaxPriceDeviation
+}
@@ -29,6 +29,7 @@ func TestParams(t *testing.T) {
 	slashWindow := uint64(1000)
 	minValidPerWindow := sdk.NewDecWithPrec(1, 4)
 	minFeeRatio := sdk.NewDecWithPrec(1, 2)
+	maxPriceDeviation := sdk.NewDecWithPrec(1, 1)
 	whitelist := []asset.Pair{
 		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
@@ -45,6 +46,7 @@ func TestParams(t *testing.T) {
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
 		ValidatorFeeRatio: minFeeRatio,
+		MaxPriceDeviation: maxPriceDeviation,
 	}
 	input.OracleKeeper.Params.Set(input.Ctx, newParams)
 
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// CurrentSlashWindow returns the index of the current slash window, i.e. the
+// block height divided by the slash window parameter.
+func (k Keeper) CurrentSlashWindow(ctx sdk.Context) uint64 {
+	slashWindow := k.SlashWindow(ctx)
+	if slashWindow == 0 {
+		return 0
+	}
+	return uint64(ctx.BlockHeight()) / slashWindow
+}
+
+// recordPerformanceStats adds the performances of the validators in the vote
+// period to their statistics over the current slash window. Does nothing if
+// the statistics are disabled.
+func (k Keeper) recordPerformanceStats(
+	ctx sdk.Context,
+	params types.Params,
+	pairBallotsMap map[asset.Pair]types.ExchangeRateBallots,
+	whitelistedPairs set.Set[asset.Pair],
+	validatorPerformances types.ValidatorPerformances,
+	rewards map[string]sdk.Coins,
+) {
+	if params.PerformanceHistoryWindows == 0 || params.SlashWindow == 0 {
+		return
+	}
+
+	slashWindow := uint64(ctx.BlockHeight()) / params.SlashWindow
+	deviations := voteDeviations(pairBallotsMap)
+
+	for valAddrStr, validatorPerformance := range validatorPerformances {
+		stats := k.PerformanceStats.GetOr(ctx, collections.Join(slashWindow, validatorPerformance.ValAddress), types.ValidatorPerformanceStats{
+			Validator:        valAddrStr,
+			SlashWindow:      slashWindow,
+			AverageDeviation: sdk.ZeroDec(),
+		})
+
+		stats.WinCount += uint64(validatorPerformance.WinCount)
+		if int(validatorPerformance.WinCount) != len(whitelistedPairs) {
+			stats.MissCount++
+		}
+		stats.RewardWeight += validatorPerformance.RewardWeight
+		for _, deviation := range deviations[valAddrStr] {
+			stats.VoteCount++
+			stats.AverageDeviation = stats.AverageDeviation.Add(
+				deviation.Sub(stats.AverageDeviation).QuoInt64(int64(stats.VoteCount)))
+		}
+		stats.Rewards = stats.Rewards.Add(rewards[valAddrStr]...)
+
+		k.SetPerformanceStats(ctx, validatorPerformance.ValAddress, stats)
+	}
+}
+
+// SetPerformanceStats stores the performance statistics of the validator over
+// their slash window and indexes them by validator.
+func (k Keeper) SetPerformanceStats(ctx sdk.Context, valAddr sdk.ValAddress, stats types.ValidatorPerformanceStats) {
+	k.PerformanceStats.Insert(ctx, collections.Join(stats.SlashWindow, valAddr), stats)
+	k.ValidatorPerformanceWindows.Insert(ctx, collections.Join(valAddr, stats.SlashWindow))
+}
+
+// voteDeviations returns the relative deviations of the non-abstaining votes
+// of the validators from the tallied exchange rates of the ballots. The
+// deviations are ordered by pair, so that their running average is
+// deterministic.
+func voteDeviations(pairBallotsMap map[asset.Pair]types.ExchangeRateBallots) map[string][]sdk.Dec {
+	pairs := make([]asset.Pair, 0, len(pairBallotsMap))
+	for pair := range pairBallotsMap {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i] < pairs[j] })
+
+	deviations := make(map[string][]sdk.Dec)
+	for _, pair := range pairs {
+		ballots := pairBallotsMap[pair]
+		sort.Sort(ballots)
+		weightedMedian := ballots.WeightedMedianWithAssertion()
+		if !weightedMedian.IsPositive() {
+			continue
+		}
+
+		for _, ballot := range ballots {
+			if !ballot.ExchangeRate.IsPositive() {
+				continue
+			}
+			voterAddr := ballot.Voter.String()
+			deviations[voterAddr] = append(deviations[voterAddr],
+				ballot.ExchangeRate.Sub(weightedMedian).Abs().Quo(weightedMedian))
+		}
+	}
+	return deviations
+}
+
+// PrunePerformanceStats deletes the performance statistics of the validators
+// except the ones of the PerformanceHistoryWindows - 1 latest slash windows
+// and of the next one. Called at the end of every slash window.
+func (k Keeper) PrunePerformanceStats(ctx sdk.Context) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.SlashWindow == 0 {
+		return
+	}
+
+	nextSlashWindow := uint64(ctx.BlockHeight())/params.SlashWindow + 1
+	if nextSlashWindow < params.PerformanceHistoryWindows {
+		return
+	}
+	cutoff := nextSlashWindow + 1 - params.PerformanceHistoryWindows
+
+	rng := collections.Range[collections.Pair[uint64, sdk.ValAddress]]{}.
+		EndExclusive(collections.PairPrefix[uint64, sdk.ValAddress](cutoff))
+	for _, key := range k.PerformanceStats.Iterate(ctx, rng).Keys() {
+		if err := k.PerformanceStats.Delete(ctx, key); err != nil {
+			panic(err)
+		}
+		k.ValidatorPerformanceWindows.Delete(ctx, collections.Join(key.K2(), key.K1()))
+	}
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"math"
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestPerformanceStats(t *testing.T) {
+	input, h := Setup(t)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	AllocateRewards(t, input, sdk.NewCoins(sdk.NewInt64Coin("reward", 1*common.TO_MICRO)), 1)
+	for valIdx, rate := range []int64{100, 100, 101, 99} {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+			{Pair: pair, ExchangeRate: sdk.NewDec(rate)},
+		}, valIdx)
+	}
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+
+	t.Log("the statistics of the vote period are recorded for every validator")
+	power := input.StakingKeeper.Validator(input.Ctx, ValAddrs[0]).GetConsensusPower(sdk.DefaultPowerReduction)
+	stats, err := input.OracleKeeper.PerformanceStats.Get(input.Ctx, collections.Join(uint64(0), ValAddrs[2]))
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorPerformanceStats{
+		Validator:        ValAddrs[2].String(),
+		SlashWindow:      0,
+		WinCount:         1,
+		MissCount:        1, // did not vote on the other whitelisted pairs
+		RewardWeight:     power,
+		VoteCount:        1,
+		AverageDeviation: sdk.NewDecWithPrec(1, 2),
+		Rewards:          sdk.NewCoins(sdk.NewInt64Coin("reward", 250_000)),
+	}, stats)
+
+	stats, err = input.OracleKeeper.PerformanceStats.Get(input.Ctx, collections.Join(uint64(0), ValAddrs[4]))
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorPerformanceStats{
+		Validator:        ValAddrs[4].String(),
+		MissCount:        1,
+		AverageDeviation: sdk.ZeroDec(),
+	}, stats)
+
+	t.Log("the statistics of the slash window accumulate over the vote periods")
+	for valIdx, rate := range []int64{100, 100, 100, 100} {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+			{Pair: pair, ExchangeRate: sdk.NewDec(rate)},
+		}, valIdx)
+	}
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	stats, err = input.OracleKeeper.PerformanceStats.Get(input.Ctx, collections.Join(uint64(0), ValAddrs[2]))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, stats.WinCount)
+	require.EqualValues(t, 2, stats.MissCount)
+	require.Equal(t, 2*power, stats.RewardWeight)
+	require.EqualValues(t, 2, stats.VoteCount)
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), stats.AverageDeviation)
+
+	t.Log("query the history of a validator")
+	querier := NewQuerier(input.OracleKeeper)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+	historyResp, err := querier.ValidatorPerformance(goCtx, &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[2].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorPerformanceStats{stats}, historyResp.Stats)
+	require.EqualValues(t, 0, historyResp.CurrentSlashWindow)
+
+	t.Log("query the leaderboard of a slash window")
+	leaderboardResp, err := querier.PerformanceLeaderboard(goCtx, &types.QueryPerformanceLeaderboardRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, leaderboardResp.Pagination.Total)
+	require.Len(t, leaderboardResp.Stats, 3)
+	require.True(t, leaderboardResp.Stats[0].AverageDeviation.IsZero())
+	require.False(t, leaderboardResp.Stats[1].AverageDeviation.IsZero())
+	require.False(t, leaderboardResp.Stats[2].AverageDeviation.IsZero())
+	leaderboardResp, err = querier.PerformanceLeaderboard(goCtx, &types.QueryPerformanceLeaderboardRequest{
+		Pagination: &query.PageRequest{Offset: 4},
+	})
+	require.NoError(t, err)
+	require.Len(t, leaderboardResp.Stats, 1)
+	require.Equal(t, ValAddrs[4].String(), leaderboardResp.Stats[0].Validator)
+	leaderboardResp, err = querier.PerformanceLeaderboard(goCtx, &types.QueryPerformanceLeaderboardRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: math.MaxUint64},
+	})
+	require.NoError(t, err)
+	require.Len(t, leaderboardResp.Stats, 4)
+	_, err = querier.PerformanceLeaderboard(goCtx, &types.QueryPerformanceLeaderboardRequest{
+		Pagination: &query.PageRequest{Key: []byte{1}},
+	})
+	require.Error(t, err)
+}
+
+func TestPrunePerformanceStats(t *testing.T) {
+	input, _ := Setup(t)
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.SlashWindow = 100
+	params.PerformanceHistoryWindows = 2
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	for slashWindow := uint64(0); slashWindow < 3; slashWindow++ {
+		for _, valAddr := range ValAddrs {
+			input.OracleKeeper.SetPerformanceStats(input.Ctx, valAddr, types.ValidatorPerformanceStats{
+				Validator:        valAddr.String(),
+				SlashWindow:      slashWindow,
+				AverageDeviation: sdk.ZeroDec(),
+			})
+		}
+	}
+
+	input.Ctx = input.Ctx.WithBlockHeight(299) // last block of the slash window 2
+	input.OracleKeeper.PrunePerformanceStats(input.Ctx)
+
+	var slashWindows []uint64
+	for _, stats := range input.OracleKeeper.PerformanceStats.Iterate(input.Ctx, collections.Range[collections.Pair[uint64, sdk.ValAddress]]{}).Values() {
+		slashWindows = append(slashWindows, stats.SlashWindow)
+	}
+	require.Len(t, slashWindows, len(ValAddrs))
+	for _, slashWindow := range slashWindows {
+		require.EqualValues(t, 2, slashWindow)
+	}
+
+	resp, err := NewQuerier(input.OracleKeeper).ValidatorPerformance(sdk.WrapSDKContext(input.Ctx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[0].String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Stats, 1, "the index is pruned with the statistics")
+	require.EqualValues(t, 2, resp.Stats[0].SlashWindow)
+}
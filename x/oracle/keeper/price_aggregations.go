@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/ewma"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// updateExchangeRateEma adds a price published by the EndBlocker to the
+// exponential moving average (EMA) of its pair, over emaSpan price updates.
+// Does nothing if the EMA is disabled, i.e. emaSpan is zero.
+func (k Keeper) updateExchangeRateEma(ctx sdk.Context, emaSpan uint64, pair asset.Pair, price sdk.Dec) {
+	if emaSpan == 0 {
+		return
+	}
+
+	ema := ewma.NewMovingAverage(sdk.NewDecFromInt(sdk.NewIntFromUint64(emaSpan)))
+	if value, err := k.ExchangeRateEmas.Get(ctx, pair); err == nil {
+		ema.Set(value)
+	}
+	ema.Add(price)
+	k.ExchangeRateEmas.Insert(ctx, pair, ema.Value())
+}
+
+// GetExchangeRateEma returns the exponential moving average (EMA) of the
+// exchange rate of a pair, over EmaSpan price updates.
+func (k Keeper) GetExchangeRateEma(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return
+	}
+	if params.EmaSpan == 0 {
+		return sdk.OneDec().Neg(), types.ErrNoValidEma.Wrap("the EMA is disabled")
+	}
+
+	price, err = k.ExchangeRateEmas.Get(ctx, pair)
+	if err != nil {
+		return sdk.OneDec().Neg(), types.ErrNoValidEma.Wrapf("no EMA for %s", pair)
+	}
+	return price, nil
+}
+
+// GetExchangeRateMedian returns the median of the exchange rate of a pair
+// over its MedianWindow latest price snapshots, or over all of them if there
+// are fewer.
+func (k Keeper) GetExchangeRateMedian(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return
+	}
+	if params.MedianWindow == 0 {
+		return sdk.OneDec().Neg(), types.ErrNoValidMedian.Wrap("the median is disabled")
+	}
+
+	iter := k.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair).Descending())
+	defer iter.Close()
+
+	var prices []sdk.Dec
+	for ; iter.Valid() && uint64(len(prices)) < params.MedianWindow; iter.Next() {
+		prices = append(prices, iter.Value().Price)
+	}
+	if len(prices) == 0 {
+		return sdk.OneDec().Neg(), types.ErrNoValidMedian.Wrapf("no price snapshot for %s", pair)
+	}
+
+	return median(prices), nil
+}
+
+// median returns the median of a non-empty slice of prices, i.e. the mean
+// of the two middle prices if there is an even number of them.
+func median(prices []sdk.Dec) sdk.Dec {
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LT(prices[j]) })
+
+	mid := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[mid]
+	}
+	return prices[mid-1].Add(prices[mid]).QuoInt64(2)
+}
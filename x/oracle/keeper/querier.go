@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -55,7 +58,10 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: exchangeRate,
+		Derived:      q.Keeper.IsDerivedPair(ctx, req.Pair),
+	}, nil
 }
 
 /*
@@ -76,7 +82,44 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	if err != nil {
 		return &types.QueryExchangeRateResponse{}, err
 	}
-	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: twap,
+		Derived:      q.Keeper.IsDerivedPair(ctx, req.Pair),
+	}, nil
+}
+
+// ExchangeRateEma queries the exponential moving average exchange rate of a pair
+func (q querier) ExchangeRateEma(c context.Context, req *types.QueryExchangeRateRequest) (response *types.QueryExchangeRateResponse, err error) {
+	if _, err = q.ExchangeRate(c, req); err != nil {
+		return
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ema, err := q.Keeper.GetExchangeRateEma(ctx, req.Pair)
+	if err != nil {
+		return &types.QueryExchangeRateResponse{}, err
+	}
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: ema,
+		Derived:      q.Keeper.IsDerivedPair(ctx, req.Pair),
+	}, nil
+}
+
+// ExchangeRateMedian queries the median exchange rate of a pair over its latest price snapshots
+func (q querier) ExchangeRateMedian(c context.Context, req *types.QueryExchangeRateRequest) (response *types.QueryExchangeRateResponse, err error) {
+	if _, err = q.ExchangeRate(c, req); err != nil {
+		return
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	median, err := q.Keeper.GetExchangeRateMedian(ctx, req.Pair)
+	if err != nil {
+		return &types.QueryExchangeRateResponse{}, err
+	}
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate: median,
+		Derived:      q.Keeper.IsDerivedPair(ctx, req.Pair),
+	}, nil
 }
 
 // ExchangeRates queries exchange rates of all pairs
@@ -84,14 +127,28 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 	ctx := sdk.UnwrapSDKContext(c)
 
 	var exchangeRates types.ExchangeRateTuples
+	var derivedPairs []asset.Pair
 	for _, er := range q.Keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
 		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{
 			Pair:         er.Key,
 			ExchangeRate: er.Value,
 		})
+		if q.Keeper.IsDerivedPair(ctx, er.Key) {
+			derivedPairs = append(derivedPairs, er.Key)
+		}
 	}
 
-	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates, DerivedPairs: derivedPairs}, nil
+}
+
+// DerivedPairs queries the pairs whose exchange rates are derived from voted
+// pairs
+func (q querier) DerivedPairs(c context.Context, _ *types.QueryDerivedPairsRequest) (*types.QueryDerivedPairsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDerivedPairsResponse{
+		DerivedPairs: q.Keeper.DerivedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
+	}, nil
 }
 
 // Actives queries all pairs for which exchange rates exist
@@ -139,6 +196,100 @@ func (q querier) MissCounter(c context.Context, req *types.QueryMissCounterReque
 	}, nil
 }
 
+// ValidatorPerformance queries the oracle performance statistics of a validator
+func (q querier) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the slash windows of the validator are read from the index, keyed by
+	// slash window once the validator prefix is stripped
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(
+		ctx.KVStore(q.storeKey),
+		append(validatorPerformanceWindowsNamespace.Prefix(), collections.ValAddressKeyEncoder.Encode(valAddr)...),
+	)
+
+	var stats []types.ValidatorPerformanceStats
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, _ []byte) error {
+			_, slashWindow := collections.Uint64KeyEncoder.Decode(key)
+			validatorStats, err := q.PerformanceStats.Get(ctx, collections.Join(slashWindow, valAddr))
+			if err != nil {
+				return err
+			}
+			stats = append(stats, validatorStats)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPerformanceResponse{
+		Stats:              stats,
+		CurrentSlashWindow: q.CurrentSlashWindow(ctx),
+		Pagination:         pageRes,
+	}, nil
+}
+
+// PerformanceLeaderboard queries the oracle performance statistics of all validators over a slash window
+func (q querier) PerformanceLeaderboard(c context.Context, req *types.QueryPerformanceLeaderboardRequest) (*types.QueryPerformanceLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Pagination.GetKey()) != 0 {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stats := q.PerformanceStats.Iterate(ctx, collections.PairRange[uint64, sdk.ValAddress]{}.Prefix(req.SlashWindow)).Values()
+	sort.SliceStable(stats, func(i, j int) bool {
+		if stats[i].RewardWeight != stats[j].RewardWeight {
+			return stats[i].RewardWeight > stats[j].RewardWeight
+		}
+		return stats[i].AverageDeviation.LT(stats[j].AverageDeviation)
+	})
+	if req.Pagination.GetReverse() {
+		for i, j := 0, len(stats)-1; i < j; i, j = i+1, j-1 {
+			stats[i], stats[j] = stats[j], stats[i]
+		}
+	}
+
+	total := uint64(len(stats))
+	limit := req.Pagination.GetLimit()
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	start := req.Pagination.GetOffset()
+	if start > total {
+		start = total
+	}
+	// clamped before the addition, which would overflow for huge limits
+	if limit > total-start {
+		limit = total - start
+	}
+	end := start + limit
+
+	pageRes := &query.PageResponse{}
+	if req.Pagination.GetCountTotal() {
+		pageRes.Total = total
+	}
+
+	return &types.QueryPerformanceLeaderboardResponse{
+		Stats:              stats[start:end],
+		CurrentSlashWindow: q.CurrentSlashWindow(ctx),
+		Pagination:         pageRes,
+	}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator
 func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
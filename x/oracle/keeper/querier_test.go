@@ -116,6 +116,58 @@ func TestQueryExchangeRateTwap(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("1700"), res.ExchangeRate)
 }
 
+func TestQueryExchangeRateEmaAndMedian(t *testing.T) {
+	input := CreateTestFixture(t)
+	querier := NewQuerier(input.OracleKeeper)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.EmaSpan = 3 // decay of 0.5
+	params.MedianWindow = 3
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	setPrices := func(prices ...int64) {
+		for _, price := range prices {
+			input.Ctx = input.Ctx.WithBlockTime(input.Ctx.BlockTime().Add(time.Second))
+			input.OracleKeeper.SetPrice(input.Ctx, pair, sdk.NewDec(price))
+			input.OracleKeeper.updateExchangeRateEma(input.Ctx, params.EmaSpan, pair, sdk.NewDec(price))
+		}
+	}
+	query := func() (ema, median sdk.Dec) {
+		ctx := sdk.WrapSDKContext(input.Ctx)
+		res, err := querier.ExchangeRateEma(ctx, &types.QueryExchangeRateRequest{Pair: pair})
+		require.NoError(t, err)
+		ema = res.ExchangeRate
+		res, err = querier.ExchangeRateMedian(ctx, &types.QueryExchangeRateRequest{Pair: pair})
+		require.NoError(t, err)
+		return ema, res.ExchangeRate
+	}
+
+	_, err = querier.ExchangeRateEma(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRateRequest{Pair: pair})
+	require.Error(t, err)
+	_, err = querier.ExchangeRateMedian(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRateRequest{Pair: pair})
+	require.Error(t, err)
+
+	setPrices(100, 200)
+	ema, median := query()
+	require.Equal(t, sdk.NewDec(150), ema)
+	require.Equal(t, sdk.NewDec(150), median)
+
+	setPrices(400, 300)
+	ema, median = query()
+	require.Equal(t, sdk.MustNewDecFromStr("287.5"), ema)
+	require.Equal(t, sdk.NewDec(300), median)
+
+	params.EmaSpan = 0
+	params.MedianWindow = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	_, err = input.OracleKeeper.GetExchangeRateEma(input.Ctx, pair)
+	require.ErrorIs(t, err, types.ErrNoValidEma)
+	_, err = input.OracleKeeper.GetExchangeRateMedian(input.Ctx, pair)
+	require.ErrorIs(t, err, types.ErrNoValidMedian)
+}
+
 func TestCalcTwap(t *testing.T) {
 	tests := []struct {
 		name               string
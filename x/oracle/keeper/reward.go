@@ -28,14 +28,16 @@ func (k Keeper) AllocateRewards(ctx sdk.Context, funderModule string, totalCoins
 }
 
 // rewardBallotWinners gives out a portion of spread fees collected in the
-// oracle reward pool to the oracle voters that voted faithfully.
+// oracle reward pool to the oracle voters that voted faithfully, and returns
+// the rewards given to each validator.
 func (k Keeper) rewardBallotWinners(
 	ctx sdk.Context,
 	validatorPerformances types.ValidatorPerformances,
-) {
+) (validatorRewards map[string]sdk.Coins) {
+	validatorRewards = make(map[string]sdk.Coins)
 	totalRewardWeight := validatorPerformances.GetTotalRewardWeight()
 	if totalRewardWeight == 0 {
-		return
+		return validatorRewards
 	}
 
 	var totalRewards sdk.DecCoins
@@ -52,6 +54,7 @@ func (k Keeper) rewardBallotWinners(
 		rewardPortion, _ := totalRewards.MulDec(sdk.NewDec(validatorPerformance.RewardWeight).QuoInt64(totalRewardWeight)).TruncateDecimal()
 		k.distrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewardPortion...))
 		distributedRewards = distributedRewards.Add(rewardPortion...)
+		validatorRewards[validatorPerformance.ValAddress.String()] = rewardPortion
 	}
 
 	// Move distributed reward to distribution module
@@ -59,6 +62,7 @@ func (k Keeper) rewardBallotWinners(
 	if err != nil {
 		panic(fmt.Sprintf("[oracle] Failed to send coins to distribution module %s", err.Error()))
 	}
+	return validatorRewards
 }
 
 // GatherRewardsForVotePeriod retrieves the pair rewards for the provided pair and current vote period.
@@ -13,16 +13,18 @@ import (
 // UpdateExchangeRates updates the ExchangeRates, this is supposed to be executed on EndBlock.
 func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 	k.Logger(ctx).Info("processing validator price votes")
-	k.resetExchangeRates(ctx)
+	params, _ := k.Params.Get(ctx)
+	k.expireExchangeRates(ctx, params)
 
 	validatorPerformances := k.newValidatorPerformances(ctx)
 	pairBallotsMap, whitelistedPairs := k.getPairBallotsMapAndWhitelistedPairs(ctx, validatorPerformances)
 
-	k.countVotesAndUpdateExchangeRates(ctx, pairBallotsMap, validatorPerformances)
+	k.countVotesAndUpdateExchangeRates(ctx, params, pairBallotsMap, validatorPerformances)
+	k.updateDerivedExchangeRates(ctx, params)
 	k.registerMissedVotes(ctx, whitelistedPairs, validatorPerformances)
-	k.rewardBallotWinners(ctx, validatorPerformances)
+	rewards := k.rewardBallotWinners(ctx, validatorPerformances)
+	k.recordPerformanceStats(ctx, params, pairBallotsMap, whitelistedPairs, validatorPerformances, rewards)
 
-	params, _ := k.Params.Get(ctx)
 	k.clearVotesAndPreVotes(ctx, params.VotePeriod)
 	k.updateWhitelist(ctx, params.Whitelist, whitelistedPairs)
 }
@@ -40,17 +42,27 @@ func (k Keeper) registerMissedVotes(ctx sdk.Context, whitelistedPairs set.Set[as
 }
 
 // countVotesAndUpdateExchangeRates processes the votes and updates the ExchangeRates based on the results.
+// The exchange rates tripping the circuit breaker are not published, but the
+// voters of their ballots are still rewarded. The published exchange rates are
+// added to the EMA of their pair.
 func (k Keeper) countVotesAndUpdateExchangeRates(
 	ctx sdk.Context,
+	params types.Params,
 	pairBallotsMap map[asset.Pair]types.ExchangeRateBallots,
 	validatorPerformances types.ValidatorPerformances,
 ) {
 	rewardBand := k.RewardBand(ctx)
+	maxPriceDeviation := k.MaxPriceDeviation(ctx)
 
 	for pair, ballots := range pairBallotsMap {
 		exchangeRate := Tally(ballots, rewardBand, validatorPerformances)
 
+		if k.isPriceDeviating(ctx, pair, exchangeRate, ballots, maxPriceDeviation) {
+			continue
+		}
+
 		k.SetPrice(ctx, pair, exchangeRate)
+		k.updateExchangeRateEma(ctx, params.EmaSpan, pair, exchangeRate)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.EventTypeExchangeRateUpdate,
@@ -61,6 +73,56 @@ func (k Keeper) countVotesAndUpdateExchangeRates(
 	}
 }
 
+// isPriceDeviating returns whether a tallied exchange rate deviates more than
+// maxPriceDeviation from the TWAP of the pair, and emits a halt event if so.
+// Pairs without TWAP, i.e. without price snapshot in the TWAP lookback window,
+// are not checked, so that a lasting price move is published once the
+// lookback window has passed.
+func (k Keeper) isPriceDeviating(
+	ctx sdk.Context,
+	pair asset.Pair,
+	exchangeRate sdk.Dec,
+	ballots types.ExchangeRateBallots,
+	maxPriceDeviation sdk.Dec,
+) bool {
+	if !maxPriceDeviation.IsPositive() {
+		return false
+	}
+
+	twap, err := k.GetExchangeRateTwap(ctx, pair)
+	if err != nil || !twap.IsPositive() {
+		return false
+	}
+
+	if exchangeRate.Sub(twap).Abs().Quo(twap).LTE(maxPriceDeviation) {
+		return false
+	}
+
+	k.Logger(ctx).Info("price halted", "pair", pair, "exchange_rate", exchangeRate, "twap", twap)
+	emitPriceHalt(ctx, pair, types.HaltReasonPriceDeviation, exchangeRate, twap, ballots)
+	return true
+}
+
+// emitPriceHalt emits an OraclePriceHalt event.
+func emitPriceHalt(
+	ctx sdk.Context,
+	pair asset.Pair,
+	reason string,
+	exchangeRate sdk.Dec,
+	twap sdk.Dec,
+	ballots types.ExchangeRateBallots,
+) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.OraclePriceHalt{
+		Pair:         pair.String(),
+		Reason:       reason,
+		ExchangeRate: exchangeRate,
+		Twap:         twap,
+		NumVoters:    uint64(len(ballots)),
+	}); err != nil {
+		ctx.Logger().Error("failed to emit OraclePriceHalt", "pair", pair, "error", err)
+	}
+}
+
 // getPairBallotsMapAndWhitelistedPairs returns a map of pairs and ballots excluding invalid Ballots
 // and a map with all whitelisted pairs.
 func (k Keeper) getPairBallotsMapAndWhitelistedPairs(
@@ -72,10 +134,19 @@ func (k Keeper) getPairBallotsMapAndWhitelistedPairs(
 	return k.removeInvalidBallots(ctx, pairBallotsMap)
 }
 
-// resetExchangeRates removes all exchange rates from the state
-func (k Keeper) resetExchangeRates(ctx sdk.Context) {
-	for _, key := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
-		err := k.ExchangeRates.Delete(ctx, key)
+// expireExchangeRates removes the exchange rates older than the max age of
+// their pair from the state.
+func (k Keeper) expireExchangeRates(ctx sdk.Context, params types.Params) {
+	for _, pair := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		maxAge := params.MaxPriceAgeOf(pair)
+		if maxAge > 0 {
+			publishedAt, err := k.lastPriceSnapshotTime(ctx, pair)
+			if err == nil && ctx.BlockTime().Sub(publishedAt) <= maxAge {
+				continue
+			}
+		}
+
+		err := k.ExchangeRates.Delete(ctx, pair)
 		if err != nil {
 			panic(err)
 		}
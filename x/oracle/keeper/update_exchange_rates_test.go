@@ -5,6 +5,7 @@ import (
 	"math"
 	"sort"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -421,3 +422,99 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 }
+
+func TestExpiryAndCircuitBreaker(t *testing.T) {
+	input, h := Setup(t)
+	btcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.MaxPriceAge = 30 * time.Second
+	params.PairMaxAges = []types.PairMaxAge{{Pair: ethNusd, MaxAge: 0}}
+	params.MaxPriceDeviation = sdk.NewDecWithPrec(10, 2)
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	vote := func(numVoters int, rates types.ExchangeRateTuples) {
+		for valIdx := 0; valIdx < numVoters; valIdx++ {
+			MakeAggregatePrevoteAndVote(t, input, h, 0, rates, valIdx)
+		}
+	}
+	priceHalts := func() (halts []types.OraclePriceHalt) {
+		for _, abciEvent := range input.Ctx.EventManager().ABCIEvents() {
+			event, err := sdk.ParseTypedEvent(abciEvent)
+			if err != nil {
+				continue
+			}
+			if halt, ok := event.(*types.OraclePriceHalt); ok {
+				halts = append(halts, *halt)
+			}
+		}
+		return halts
+	}
+	startTime := input.Ctx.BlockTime()
+
+	t.Log("exchange rates are published with age zero")
+	vote(4, types.ExchangeRateTuples{
+		{Pair: btcNusd, ExchangeRate: sdk.NewDec(40_000)},
+		{Pair: ethNusd, ExchangeRate: sdk.NewDec(2_000)},
+	})
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	price, age, err := input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, btcNusd)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40_000), price)
+	require.Zero(t, age)
+
+	t.Log("exchange rates are kept until their max age")
+	input.Ctx = input.Ctx.WithBlockTime(startTime.Add(20 * time.Second)).WithEventManager(sdk.NewEventManager())
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	price, age, err = input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, btcNusd)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40_000), price)
+	require.Equal(t, 20*time.Second, age)
+	_, _, err = input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, ethNusd)
+	require.Error(t, err)
+
+	t.Log("exchange rates deviating from the twap are halted")
+	vote(4, types.ExchangeRateTuples{{Pair: btcNusd, ExchangeRate: sdk.NewDec(50_000)}})
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	price, age, err = input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, btcNusd)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40_000), price)
+	require.Equal(t, 20*time.Second, age)
+	require.Equal(t, []types.OraclePriceHalt{{
+		Pair:         btcNusd.String(),
+		Reason:       types.HaltReasonPriceDeviation,
+		ExchangeRate: sdk.NewDec(50_000),
+		Twap:         sdk.NewDec(40_000),
+		NumVoters:    4,
+	}}, priceHalts())
+
+	t.Log("exchange rates with too few voters are halted")
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	vote(1, types.ExchangeRateTuples{{Pair: ethNusd, ExchangeRate: sdk.NewDec(2_000)}})
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, ethNusd)
+	require.Error(t, err)
+	require.Equal(t, []types.OraclePriceHalt{{
+		Pair:         ethNusd.String(),
+		Reason:       types.HaltReasonInsufficientVoters,
+		ExchangeRate: sdk.ZeroDec(),
+		Twap:         sdk.ZeroDec(),
+		NumVoters:    1,
+	}}, priceHalts())
+
+	t.Log("exchange rates within the max deviation are published")
+	vote(4, types.ExchangeRateTuples{{Pair: btcNusd, ExchangeRate: sdk.NewDec(42_000)}})
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	price, age, err = input.OracleKeeper.GetExchangeRateWithAge(input.Ctx, btcNusd)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(42_000), price)
+	require.Zero(t, age)
+
+	t.Log("exchange rates older than their max age expire")
+	input.Ctx = input.Ctx.WithBlockTime(startTime.Add(51 * time.Second))
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, btcNusd)
+	require.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/oracle interfaces and concrete types
@@ -24,6 +25,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAggregateExchangeRateVote{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddDerivedPairProposal{},
+		&RemoveDerivedPairProposal{},
+	)
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// NewInverseDerivedPair returns the DerivedPair of the inverse of a voted
+// pair, e.g. "uusd:ubtc" from "ubtc:uusd".
+func NewInverseDerivedPair(pair asset.Pair) DerivedPair {
+	return DerivedPair{Pair: pair}
+}
+
+// NewCrossDerivedPair returns the DerivedPair of the cross rate of the voted
+// pairs of the base and quote denoms of pair in the intermediate denom, e.g.
+// "ubtc:unibi" from "ubtc:uusd" and "unibi:uusd".
+func NewCrossDerivedPair(pair asset.Pair, intermediateDenom string) DerivedPair {
+	return DerivedPair{Pair: pair, IntermediateDenom: intermediateDenom}
+}
+
+// IsInverse returns whether the pair is the inverse of a voted pair.
+func (p DerivedPair) IsInverse() bool {
+	return p.IntermediateDenom == ""
+}
+
+// SourcePairs returns the voted pairs the price of the pair is derived from:
+// the inverse pair for an inverse, the pairs of the base and quote denoms in
+// the intermediate denom for a cross rate.
+func (p DerivedPair) SourcePairs() []asset.Pair {
+	if p.IsInverse() {
+		return []asset.Pair{p.Pair.Inverse()}
+	}
+	return []asset.Pair{
+		asset.NewPair(p.Pair.BaseDenom(), p.IntermediateDenom),
+		asset.NewPair(p.Pair.QuoteDenom(), p.IntermediateDenom),
+	}
+}
+
+// Price returns the price of the pair from the positive prices of its source
+// pairs, in the order of SourcePairs.
+func (p DerivedPair) Price(sourcePrices []sdk.Dec) sdk.Dec {
+	if p.IsInverse() {
+		return sdk.OneDec().Quo(sourcePrices[0])
+	}
+	return sourcePrices[0].Quo(sourcePrices[1])
+}
+
+func (p DerivedPair) Validate() error {
+	if err := p.Pair.Validate(); err != nil {
+		return err
+	}
+	if p.IsInverse() {
+		return nil
+	}
+	if err := sdk.ValidateDenom(p.IntermediateDenom); err != nil {
+		return fmt.Errorf("invalid intermediate denom: %w", err)
+	}
+	if p.IntermediateDenom == p.Pair.BaseDenom() || p.IntermediateDenom == p.Pair.QuoteDenom() {
+		return fmt.Errorf("intermediate denom %s is a denom of %s", p.IntermediateDenom, p.Pair)
+	}
+	return nil
+}
@@ -23,4 +23,7 @@ var (
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrUnknownPair           = sdkerrors.Register(ModuleName, 13, "unknown pair")
 	ErrNoValidTWAP           = sdkerrors.Register(ModuleName, 14, "TWA price not found")
+	ErrInvalidDerivedPair    = sdkerrors.Register(ModuleName, 15, "invalid derived pair")
+	ErrNoValidEma            = sdkerrors.Register(ModuleName, 16, "EMA price not found")
+	ErrNoValidMedian         = sdkerrors.Register(ModuleName, 17, "median price not found")
 )
@@ -79,32 +79,107 @@ func (m *OraclePriceUpdate) GetTimestampMs() int64 {
 	return 0
 }
 
+// Emitted when the circuit breaker refuses to publish the exchange rate of a
+// pair
+type OraclePriceHalt struct {
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Why the exchange rate was not published, either "price_deviation" or
+	// "insufficient_voters".
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The tallied exchange rate, zero if the ballot did not pass.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// The TWAP the exchange rate was checked against, zero if not checked.
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap"`
+	// The number of votes on the pair, abstaining votes included.
+	NumVoters uint64 `protobuf:"varint,5,opt,name=num_voters,json=numVoters,proto3" json:"num_voters,omitempty"`
+}
+
+func (m *OraclePriceHalt) Reset()         { *m = OraclePriceHalt{} }
+func (m *OraclePriceHalt) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceHalt) ProtoMessage()    {}
+func (*OraclePriceHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5aba28feaf0b3be, []int{1}
+}
+func (m *OraclePriceHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceHalt.Merge(m, src)
+}
+func (m *OraclePriceHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceHalt proto.InternalMessageInfo
+
+func (m *OraclePriceHalt) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *OraclePriceHalt) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OraclePriceHalt) GetNumVoters() uint64 {
+	if m != nil {
+		return m.NumVoters
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OraclePriceUpdate)(nil), "nibiru.oracle.v1.OraclePriceUpdate")
+	proto.RegisterType((*OraclePriceHalt)(nil), "nibiru.oracle.v1.OraclePriceHalt")
 }
 
 func init() { proto.RegisterFile("oracle/v1/event.proto", fileDescriptor_f5aba28feaf0b3be) }
 
 var fileDescriptor_f5aba28feaf0b3be = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x50, 0xcd, 0x4a, 0x03, 0x31,
-	0x18, 0xdc, 0x58, 0x15, 0x5c, 0x3d, 0xe8, 0xa2, 0x50, 0x8a, 0xa4, 0xd5, 0x83, 0xf4, 0xa0, 0xf9,
-	0x28, 0xbe, 0x41, 0x2d, 0xde, 0xfc, 0x61, 0xc1, 0x8b, 0x17, 0xc9, 0x6e, 0xc3, 0x36, 0xd8, 0xcd,
-	0x17, 0x92, 0x74, 0xd1, 0xb7, 0xe8, 0x63, 0xf5, 0xd8, 0xa3, 0x78, 0x28, 0xb2, 0xfb, 0x22, 0xb2,
-	0x49, 0x11, 0x4f, 0x19, 0x66, 0x26, 0xdf, 0x30, 0x13, 0x9f, 0xa1, 0xe1, 0xf9, 0x5c, 0x40, 0x35,
-	0x02, 0x51, 0x09, 0xe5, 0x98, 0x36, 0xe8, 0x30, 0x39, 0x56, 0x32, 0x93, 0x66, 0xc1, 0x82, 0xca,
-	0xaa, 0x51, 0xef, 0xb4, 0xc0, 0x02, 0xbd, 0x08, 0x2d, 0x0a, 0xbe, 0xde, 0x79, 0x81, 0x58, 0xcc,
-	0x05, 0x70, 0x2d, 0x81, 0x2b, 0x85, 0x8e, 0x3b, 0x89, 0xca, 0x06, 0xf5, 0x72, 0x49, 0xe2, 0x93,
-	0x27, 0x7f, 0xe1, 0xd9, 0xc8, 0x5c, 0xbc, 0xe8, 0x29, 0x77, 0x22, 0x49, 0xe2, 0x5d, 0xcd, 0xa5,
-	0xe9, 0x92, 0x01, 0x19, 0x1e, 0xa4, 0x1e, 0x27, 0x93, 0x78, 0x4f, 0xb7, 0x96, 0xee, 0x4e, 0x4b,
-	0x8e, 0xd9, 0x6a, 0xd3, 0x8f, 0xbe, 0x37, 0xfd, 0xab, 0x42, 0xba, 0xd9, 0x22, 0x63, 0x39, 0x96,
-	0x90, 0xa3, 0x2d, 0xd1, 0x6e, 0x9f, 0x1b, 0x3b, 0x7d, 0x07, 0xf7, 0xa9, 0x85, 0x65, 0x13, 0x91,
-	0xa7, 0xe1, 0x73, 0x72, 0x11, 0x1f, 0x39, 0x59, 0x0a, 0xeb, 0x78, 0xa9, 0xdf, 0x4a, 0xdb, 0xed,
-	0x0c, 0xc8, 0xb0, 0x93, 0x1e, 0xfe, 0x71, 0x0f, 0x76, 0x7c, 0xbf, 0xaa, 0x29, 0x59, 0xd7, 0x94,
-	0xfc, 0xd4, 0x94, 0x2c, 0x1b, 0x1a, 0xad, 0x1b, 0x1a, 0x7d, 0x35, 0x34, 0x7a, 0xbd, 0xfe, 0x97,
-	0xf5, 0xe8, 0xdb, 0xdf, 0xcd, 0xb8, 0x54, 0x10, 0x96, 0x80, 0x0f, 0xd8, 0x2e, 0xe5, 0x53, 0xb3,
-	0x7d, 0xdf, 0xf0, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xd1, 0x60, 0x3d, 0x40, 0x01, 0x00,
-	0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0xee, 0x5c, 0x0a, 0x09, 0x73, 0xb9, 0xb9, 0xda, 0xa8, 0x69, 0x88, 0x16, 0x64, 0x61, 0x58,
+	0x68, 0x27, 0xc4, 0x37, 0x40, 0x62, 0xdc, 0xf8, 0x93, 0x1a, 0x5d, 0xb8, 0x21, 0x43, 0x99, 0x94,
+	0x89, 0xcc, 0x4f, 0x66, 0xa6, 0x15, 0xdf, 0x82, 0xc7, 0x62, 0xc9, 0xd2, 0xb8, 0x20, 0x06, 0x9e,
+	0xc1, 0xbd, 0xe9, 0x14, 0x0d, 0x0b, 0x57, 0xac, 0xe6, 0xcc, 0xf7, 0x9d, 0xf3, 0x9d, 0x2f, 0xe7,
+	0x1c, 0xb8, 0x2f, 0x14, 0x8e, 0xc7, 0x04, 0x65, 0x1d, 0x44, 0x32, 0xc2, 0x4d, 0x28, 0x95, 0x30,
+	0xc2, 0xdb, 0xe1, 0x74, 0x40, 0x55, 0x1a, 0x16, 0x6c, 0x98, 0x75, 0xea, 0x7b, 0x89, 0x48, 0x84,
+	0x25, 0x51, 0x1e, 0x15, 0x79, 0xf5, 0xc3, 0x44, 0x88, 0x64, 0x4c, 0x10, 0x96, 0x14, 0x61, 0xce,
+	0x85, 0xc1, 0x86, 0x0a, 0xae, 0x0b, 0xb6, 0x35, 0x05, 0x70, 0xf7, 0xd6, 0x2a, 0xdc, 0x29, 0x1a,
+	0x93, 0x07, 0x39, 0xc4, 0x86, 0x78, 0x1e, 0x74, 0x25, 0xa6, 0xca, 0x07, 0x4d, 0xd0, 0xae, 0x46,
+	0x36, 0xf6, 0x7a, 0xb0, 0x2c, 0xf3, 0x14, 0xff, 0x4f, 0x0e, 0x76, 0xc3, 0xd9, 0xa2, 0xe1, 0xbc,
+	0x2f, 0x1a, 0x27, 0x09, 0x35, 0xa3, 0x74, 0x10, 0xc6, 0x82, 0xa1, 0x58, 0x68, 0x26, 0xf4, 0xfa,
+	0x39, 0xd3, 0xc3, 0x67, 0x64, 0x5e, 0x25, 0xd1, 0x61, 0x8f, 0xc4, 0x51, 0x51, 0xec, 0x1d, 0xc3,
+	0x9a, 0xa1, 0x8c, 0x68, 0x83, 0x99, 0xec, 0x33, 0xed, 0x97, 0x9a, 0xa0, 0x5d, 0x8a, 0xfe, 0xfe,
+	0x60, 0xd7, 0xba, 0xf5, 0x09, 0xe0, 0xff, 0x0d, 0x4b, 0x57, 0x78, 0x6c, 0x7e, 0x35, 0x74, 0x00,
+	0x2b, 0x8a, 0x60, 0x2d, 0x78, 0xe1, 0x28, 0x5a, 0xff, 0xbc, 0x7b, 0xf8, 0x8f, 0x4c, 0xe2, 0x11,
+	0xe6, 0x09, 0xe9, 0x2b, 0x6c, 0x88, 0x5f, 0xda, 0xca, 0x70, 0xed, 0x5b, 0x24, 0xca, 0x27, 0xd2,
+	0x85, 0xae, 0x79, 0xc1, 0xd2, 0x77, 0xb7, 0xd2, 0xb2, 0xb5, 0xde, 0x11, 0x84, 0x3c, 0x65, 0xfd,
+	0x4c, 0x18, 0xa2, 0xb4, 0x5f, 0x6e, 0x82, 0xb6, 0x1b, 0x55, 0x79, 0xca, 0x1e, 0x2d, 0xd0, 0xbd,
+	0x9c, 0x2d, 0x03, 0x30, 0x5f, 0x06, 0xe0, 0x63, 0x19, 0x80, 0xe9, 0x2a, 0x70, 0xe6, 0xab, 0xc0,
+	0x79, 0x5b, 0x05, 0xce, 0xd3, 0xe9, 0x46, 0x9b, 0x1b, 0xbb, 0xf5, 0x8b, 0x11, 0xa6, 0x1c, 0x15,
+	0x17, 0x80, 0x26, 0x68, 0x7d, 0x21, 0xb6, 0xe1, 0xa0, 0x62, 0x37, 0x7b, 0xfe, 0x35, 0x00, 0x63,
+	0x4c, 0x65, 0x7e, 0x38, 0x02, 0x00, 0x00,
 }
 
 func (m *OraclePriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -152,6 +227,68 @@ func (m *OraclePriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OraclePriceHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumVoters != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NumVoters))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -181,6 +318,30 @@ func (m *OraclePriceUpdate) Size() (n int) {
 	return n
 }
 
+func (m *OraclePriceHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NumVoters != 0 {
+		n += 1 + sovEvent(uint64(m.NumVoters))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -322,6 +483,207 @@ func (m *OraclePriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *OraclePriceHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePriceHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVoters", wireType)
+			}
+			m.NumVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18,3 +18,9 @@ const (
 
 	AttributeValueCategory = ModuleName
 )
+
+// Reasons for the circuit breaker to halt the exchange rate of a pair
+const (
+	HaltReasonPriceDeviation     = "price_deviation"
+	HaltReasonInsufficientVoters = "insufficient_voters"
+)
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 )
@@ -31,7 +33,7 @@ func NewGenesisState(
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(
+	genesis := NewGenesisState(
 		DefaultParams(),
 		[]ExchangeRateTuple{},
 		[]FeederDelegation{},
@@ -40,11 +42,40 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]Rewards{})
+	genesis.DerivedPairs = []DerivedPair{}
+	genesis.PerformanceStats = []ValidatorPerformanceStats{}
+	return genesis
 }
 
 // ValidateGenesis validates the oracle genesis state
 func ValidateGenesis(data *GenesisState) error {
-	return data.Params.Validate()
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	derivedPairs := make(map[asset.Pair]struct{}, len(data.DerivedPairs))
+	for _, derivedPair := range data.DerivedPairs {
+		if err := derivedPair.Validate(); err != nil {
+			return fmt.Errorf("invalid derived pair %s: %w", derivedPair.Pair, err)
+		}
+		if _, found := derivedPairs[derivedPair.Pair]; found {
+			return fmt.Errorf("duplicate derived pair %s", derivedPair.Pair)
+		}
+		derivedPairs[derivedPair.Pair] = struct{}{}
+	}
+
+	for _, stats := range data.PerformanceStats {
+		if _, err := sdk.ValAddressFromBech32(stats.Validator); err != nil {
+			return fmt.Errorf("invalid validator of performance stats: %w", err)
+		}
+		if stats.AverageDeviation.IsNil() || stats.AverageDeviation.IsNegative() {
+			return fmt.Errorf("invalid average deviation of performance stats of %s: %s", stats.Validator, stats.AverageDeviation)
+		}
+		if err := stats.Rewards.Validate(); err != nil {
+			return fmt.Errorf("invalid rewards of performance stats of %s: %w", stats.Validator, err)
+		}
+	}
+	return nil
 }
 
 // GetGenesisStateFromAppState returns x/oracle GenesisState given raw application
@@ -35,6 +35,8 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	DerivedPairs                  []DerivedPair                                       `protobuf:"bytes,9,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs"`
+	PerformanceStats              []ValidatorPerformanceStats                         `protobuf:"bytes,10,rep,name=performance_stats,json=performanceStats,proto3" json:"performance_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +121,20 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPerformanceStats() []ValidatorPerformanceStats {
+	if m != nil {
+		return m.PerformanceStats
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +253,45 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/genesis.proto", fileDescriptor_14b982a0a6345d1d) }
 
 var fileDescriptor_14b982a0a6345d1d = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x96, 0x74, 0x78, 0xd1, 0x35, 0x90, 0x4d, 0x8c, 0x08,
-	0x81, 0xca, 0x2e, 0xa9, 0x20, 0xf4, 0xb2, 0xa9, 0x56, 0x6f, 0xd4, 0xb0, 0x8a, 0x82, 0x20, 0x61,
-	0xb2, 0x7b, 0xb2, 0x19, 0xc8, 0xee, 0x2c, 0x73, 0x26, 0xb1, 0x5e, 0xf8, 0x1d, 0xfc, 0x1c, 0x7e,
-	0x92, 0x5e, 0x16, 0xaf, 0xc4, 0x8b, 0x2a, 0xc9, 0x17, 0x91, 0x9d, 0xd9, 0x36, 0x31, 0xdb, 0xaa,
-	0x77, 0xe1, 0x3c, 0xbf, 0xf3, 0x3c, 0xcf, 0x84, 0x93, 0x90, 0xdb, 0x42, 0xb2, 0x60, 0x04, 0xde,
-	0xa4, 0xed, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0, 0x95, 0x84, 0xf7, 0xb9, 0x1c,
-	0xbb, 0x46, 0x77, 0x27, 0xed, 0xea, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93, 0xe1, 0xaa,
-	0xb7, 0xe6, 0x06, 0x39, 0x6a, 0xe6, 0x4e, 0x20, 0x30, 0x16, 0xe8, 0xf5, 0x19, 0x66, 0x62, 0x1f,
-	0x14, 0x6b, 0x7b, 0x81, 0xe0, 0x89, 0xd1, 0x9b, 0x5f, 0xd7, 0xc9, 0xf6, 0x53, 0x93, 0xf8, 0x4a,
-	0x31, 0x05, 0xf4, 0x11, 0xd9, 0x48, 0x99, 0x64, 0x31, 0xda, 0x56, 0xc3, 0x6a, 0x95, 0x0f, 0x6c,
-	0x77, 0xb9, 0x81, 0xdb, 0xd5, 0x7a, 0x67, 0xed, 0xec, 0xa2, 0x5e, 0xf2, 0x73, 0x9a, 0xbe, 0x25,
-	0x74, 0x00, 0x10, 0x82, 0xec, 0x85, 0x30, 0x82, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0x57, 0x1a, 0xab,
-	0xad, 0xf2, 0x41, 0xb3, 0xe8, 0x71, 0xa2, 0xd9, 0xc7, 0x57, 0x68, 0xee, 0xb6, 0x37, 0x58, 0x9a,
-	0x23, 0x1d, 0x90, 0x5d, 0x38, 0x0d, 0x86, 0x2c, 0x89, 0xa0, 0x27, 0x99, 0x02, 0xb4, 0x57, 0xb5,
-	0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0xe3, 0x74, 0x04, 0x9d, 0x6a, 0xe6,
-	0xfa, 0xe5, 0x47, 0x9d, 0x16, 0x24, 0xf4, 0x77, 0x60, 0x61, 0x86, 0xf4, 0x19, 0xd9, 0x89, 0x39,
-	0x62, 0x2f, 0x10, 0xe3, 0x44, 0x81, 0x44, 0x7b, 0x4d, 0xc7, 0xd4, 0x8a, 0x31, 0xcf, 0x39, 0xe2,
-	0xb1, 0xa1, 0xf2, 0xda, 0xdb, 0xf1, 0x7c, 0x84, 0xf4, 0x13, 0x69, 0xb0, 0x28, 0x92, 0xd9, 0x0b,
-	0xa0, 0xf7, 0x5b, 0xf7, 0x5e, 0x2a, 0x61, 0x22, 0xb2, 0x37, 0xac, 0x6b, 0x73, 0xb7, 0x68, 0x7e,
-	0x74, 0xb9, 0xb9, 0xd8, 0xb8, 0x6b, 0xd6, 0xf2, 0xb4, 0x1a, 0xfb, 0x03, 0x83, 0x54, 0x91, 0xda,
-	0x4d, 0xf1, 0x26, 0x7b, 0x43, 0x67, 0xef, 0xff, 0x63, 0xf6, 0x9b, 0x79, 0x70, 0x95, 0xdd, 0x04,
-	0x20, 0x7d, 0x49, 0xd6, 0x53, 0xc6, 0x25, 0xda, 0x9b, 0x8d, 0xd5, 0xd6, 0x56, 0xe7, 0x30, 0x5b,
-	0xf8, 0x7e, 0x51, 0x6f, 0x47, 0x5c, 0x0d, 0xc7, 0x7d, 0x37, 0x10, 0xb1, 0xf7, 0x42, 0xe7, 0x1d,
-	0x0f, 0x19, 0x4f, 0x3c, 0x93, 0xed, 0x9d, 0x7a, 0x81, 0x88, 0x63, 0x91, 0x78, 0x0c, 0x11, 0x94,
-	0xdb, 0x65, 0x5c, 0xfa, 0xc6, 0x87, 0x1e, 0x92, 0x4d, 0x09, 0x1f, 0x98, 0x0c, 0xd1, 0xfe, 0x4f,
-	0x17, 0xbe, 0x53, 0x2c, 0xec, 0x1b, 0x20, 0xaf, 0x77, 0xc9, 0x37, 0x07, 0xa4, 0xb2, 0x7c, 0x5f,
-	0xf4, 0x3e, 0xd9, 0xcd, 0xef, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xf7, 0xbd, 0xe5, 0xef, 0x98, 0xe9,
-	0x91, 0x19, 0xd2, 0x7d, 0xb2, 0x37, 0x61, 0x23, 0x1e, 0x32, 0x25, 0xe6, 0xe4, 0x8a, 0x26, 0x2b,
-	0x57, 0x42, 0x0e, 0x37, 0xdf, 0x93, 0xf2, 0xc2, 0x2d, 0x5c, 0xbf, 0x6b, 0x5d, 0xbf, 0x4b, 0xef,
-	0x92, 0xed, 0xc5, 0x73, 0xd3, 0x19, 0x6b, 0x7e, 0x79, 0xe1, 0x90, 0x3a, 0x27, 0x67, 0x53, 0xc7,
-	0x3a, 0x9f, 0x3a, 0xd6, 0xcf, 0xa9, 0x63, 0x7d, 0x9e, 0x39, 0xa5, 0xf3, 0x99, 0x53, 0xfa, 0x36,
-	0x73, 0x4a, 0xef, 0x1e, 0xfc, 0xed, 0x5b, 0xcd, 0xff, 0x0d, 0xd4, 0xc7, 0x14, 0xb0, 0xbf, 0xa1,
-	0x7f, 0xea, 0x0f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x20, 0x48, 0xe8, 0x74, 0x65, 0x04, 0x00,
-	0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x4d, 0xfa, 0x93, 0x7e, 0x9d, 0xb4, 0x55, 0x3a, 0xfa, 0x00, 0x13, 0xa9, 0x6e, 0x08, 0x42,
+	0xaa, 0x54, 0x64, 0x2b, 0x45, 0x42, 0xea, 0xb2, 0x69, 0x29, 0x6c, 0x80, 0xc8, 0xa0, 0x22, 0x21,
+	0x81, 0x35, 0xb1, 0x6f, 0xdc, 0x91, 0x62, 0x8f, 0x35, 0x77, 0x12, 0xca, 0x82, 0x77, 0xe0, 0x39,
+	0x78, 0x92, 0x2e, 0xbb, 0x44, 0x2c, 0x0a, 0x6a, 0x5f, 0x83, 0x05, 0xf2, 0x8c, 0xf3, 0x43, 0xdc,
+	0x00, 0x3b, 0xeb, 0xdc, 0x73, 0xcf, 0x39, 0xe3, 0x3b, 0x77, 0xc8, 0x1d, 0x21, 0x59, 0xd0, 0x07,
+	0x77, 0xd8, 0x72, 0x23, 0x48, 0x00, 0x39, 0x3a, 0xa9, 0x14, 0x4a, 0xd0, 0x5a, 0xc2, 0xbb, 0x5c,
+	0x0e, 0x1c, 0x53, 0x77, 0x86, 0xad, 0xfa, 0xff, 0x91, 0x88, 0x84, 0x2e, 0xba, 0xd9, 0x97, 0xe1,
+	0xd5, 0x6f, 0x4f, 0x04, 0x72, 0xaa, 0xc1, 0x6f, 0x4d, 0x70, 0x54, 0x4c, 0x8d, 0x60, 0x3b, 0x10,
+	0x18, 0x0b, 0x74, 0xbb, 0x0c, 0xb3, 0x5a, 0x17, 0x14, 0x6b, 0xb9, 0x81, 0xe0, 0x89, 0xa9, 0x37,
+	0x7f, 0x56, 0xc8, 0xda, 0x53, 0x13, 0xe4, 0x55, 0xd6, 0x46, 0x1f, 0x93, 0x4a, 0xca, 0x24, 0x8b,
+	0xd1, 0x2a, 0x37, 0xca, 0x3b, 0xd5, 0x3d, 0xcb, 0x99, 0x0d, 0xe6, 0x74, 0x74, 0xbd, 0xbd, 0x74,
+	0x7e, 0xb9, 0x5d, 0xf2, 0x72, 0x36, 0x7d, 0x43, 0x68, 0x0f, 0x20, 0x04, 0xe9, 0x87, 0xd0, 0x87,
+	0x88, 0x29, 0x2e, 0x12, 0xb4, 0x16, 0x1a, 0x8b, 0x3b, 0xd5, 0xbd, 0x66, 0x51, 0xe3, 0x58, 0x73,
+	0x8f, 0xc6, 0xd4, 0x5c, 0x6d, 0xb3, 0x37, 0x83, 0x23, 0xed, 0x91, 0x0d, 0x38, 0x0b, 0x4e, 0x59,
+	0x12, 0x81, 0x2f, 0x99, 0x02, 0xb4, 0x16, 0xb5, 0xe8, 0xfd, 0xa2, 0xe8, 0x93, 0x9c, 0xe7, 0x31,
+	0x05, 0xaf, 0x07, 0x69, 0x1f, 0xda, 0xf5, 0x4c, 0xf5, 0xcb, 0xf7, 0x6d, 0x5a, 0x28, 0xa1, 0xb7,
+	0x0e, 0x53, 0x18, 0xd2, 0x67, 0x64, 0x3d, 0xe6, 0x88, 0x7e, 0x20, 0x06, 0x89, 0x02, 0x89, 0xd6,
+	0x92, 0xb6, 0xd9, 0x2a, 0xda, 0x3c, 0xe7, 0x88, 0x87, 0x86, 0x95, 0xc7, 0x5e, 0x8b, 0x27, 0x10,
+	0xd2, 0x4f, 0xa4, 0xc1, 0xa2, 0x48, 0x66, 0x27, 0x00, 0xff, 0xb7, 0xec, 0x7e, 0x2a, 0x61, 0x28,
+	0xb2, 0x33, 0x2c, 0x6b, 0x71, 0xa7, 0x28, 0x7e, 0x30, 0xea, 0x9c, 0x4e, 0xdc, 0x31, 0x6d, 0xb9,
+	0xdb, 0x16, 0xfb, 0x03, 0x07, 0xa9, 0x22, 0x5b, 0xf3, 0xec, 0x8d, 0x77, 0x45, 0x7b, 0xef, 0xfe,
+	0xa3, 0xf7, 0xc9, 0xc4, 0xb8, 0xce, 0xe6, 0x11, 0x90, 0xbe, 0x24, 0xcb, 0x29, 0xe3, 0x12, 0xad,
+	0x95, 0xc6, 0xe2, 0xce, 0x6a, 0x7b, 0x3f, 0x6b, 0xf8, 0x76, 0xb9, 0xdd, 0x8a, 0xb8, 0x3a, 0x1d,
+	0x74, 0x9d, 0x40, 0xc4, 0xee, 0x0b, 0xed, 0x77, 0x78, 0xca, 0x78, 0xe2, 0x1a, 0x6f, 0xf7, 0xcc,
+	0x0d, 0x44, 0x1c, 0x8b, 0xc4, 0x65, 0x88, 0xa0, 0x9c, 0x0e, 0xe3, 0xd2, 0x33, 0x3a, 0x74, 0x9f,
+	0xac, 0x48, 0xf8, 0xc0, 0x64, 0x88, 0xd6, 0x7f, 0x3a, 0xf0, 0xdd, 0x62, 0x60, 0xcf, 0x10, 0xf2,
+	0x78, 0x23, 0x7e, 0x36, 0xca, 0x10, 0x24, 0x1f, 0x42, 0xe8, 0x9b, 0x4c, 0xab, 0xf3, 0x46, 0x79,
+	0x64, 0x68, 0x99, 0xef, 0x68, 0x94, 0xe1, 0x04, 0x42, 0xfa, 0x9e, 0x6c, 0xa6, 0x20, 0x7b, 0x42,
+	0xc6, 0x2c, 0x09, 0xc0, 0xcf, 0x36, 0x0b, 0x2d, 0x32, 0xef, 0xff, 0x9d, 0xb0, 0x3e, 0x0f, 0x99,
+	0x12, 0xb2, 0x33, 0xe9, 0xc9, 0xb6, 0x6a, 0x14, 0xb0, 0x96, 0xce, 0xe0, 0xcd, 0x1e, 0xa9, 0xcd,
+	0x6e, 0x02, 0x7d, 0x40, 0x36, 0xf2, 0x4d, 0x62, 0x61, 0x28, 0x01, 0xcd, 0x26, 0xae, 0x7a, 0xeb,
+	0x06, 0x3d, 0x30, 0x20, 0xdd, 0x25, 0x9b, 0xc3, 0x91, 0xdf, 0x98, 0xb9, 0xa0, 0x99, 0xb5, 0x71,
+	0x21, 0x27, 0x37, 0xdf, 0x91, 0xea, 0xd4, 0xad, 0xbd, 0xb9, 0xb7, 0x7c, 0x73, 0x2f, 0xbd, 0x47,
+	0xd6, 0xa6, 0x17, 0x43, 0x7b, 0x2c, 0x79, 0xd5, 0xa9, 0x2b, 0xdf, 0x3e, 0x3e, 0xbf, 0xb2, 0xcb,
+	0x17, 0x57, 0x76, 0xf9, 0xc7, 0x95, 0x5d, 0xfe, 0x7c, 0x6d, 0x97, 0x2e, 0xae, 0xed, 0xd2, 0xd7,
+	0x6b, 0xbb, 0xf4, 0xf6, 0xe1, 0xdf, 0xe6, 0x9f, 0x3f, 0x5b, 0xea, 0x63, 0x0a, 0xd8, 0xad, 0xe8,
+	0x47, 0xe9, 0xd1, 0xaf, 0x01, 0x00, 0x73, 0x0b, 0xf4, 0xc1, 0x26, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +314,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PerformanceStats) > 0 {
+		for iNdEx := len(m.PerformanceStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerformanceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +586,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PerformanceStats) > 0 {
+		for _, e := range m.PerformanceStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +942,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerformanceStats = append(m.PerformanceStats, ValidatorPerformanceStats{})
+			if err := m.PerformanceStats[len(m.PerformanceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,56 @@
+package types
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddDerivedPair    = "AddDerivedPair"
+	ProposalTypeRemoveDerivedPair = "RemoveDerivedPair"
+)
+
+var _ govtypes.Content = &AddDerivedPairProposal{}
+var _ govtypes.Content = &RemoveDerivedPairProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddDerivedPair)
+	govtypes.RegisterProposalTypeCodec(&AddDerivedPairProposal{}, "oracle/AddDerivedPairProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveDerivedPair)
+	govtypes.RegisterProposalTypeCodec(&RemoveDerivedPairProposal{}, "oracle/RemoveDerivedPairProposal")
+}
+
+// AddDerivedPairProposal
+
+func (proposal *AddDerivedPairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *AddDerivedPairProposal) ProposalType() string {
+	return ProposalTypeAddDerivedPair
+}
+
+func (proposal *AddDerivedPairProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.DerivedPair.Validate()
+}
+
+// RemoveDerivedPairProposal
+
+func (proposal *RemoveDerivedPairProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *RemoveDerivedPairProposal) ProposalType() string {
+	return ProposalTypeRemoveDerivedPair
+}
+
+func (proposal *RemoveDerivedPairProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.Pair.Validate()
+}
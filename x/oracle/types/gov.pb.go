@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: oracle/v1/gov.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddDerivedPairProposal is a governance proposal to compute the price of a
+// pair from the prices of voted pairs.
+type AddDerivedPairProposal struct {
+	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DerivedPair DerivedPair `protobuf:"bytes,3,opt,name=derived_pair,json=derivedPair,proto3" json:"derived_pair"`
+}
+
+func (m *AddDerivedPairProposal) Reset()         { *m = AddDerivedPairProposal{} }
+func (m *AddDerivedPairProposal) String() string { return proto.CompactTextString(m) }
+func (*AddDerivedPairProposal) ProtoMessage()    {}
+func (*AddDerivedPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f65cc6e31d2933da, []int{0}
+}
+func (m *AddDerivedPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddDerivedPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddDerivedPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddDerivedPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDerivedPairProposal.Merge(m, src)
+}
+func (m *AddDerivedPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddDerivedPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDerivedPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDerivedPairProposal proto.InternalMessageInfo
+
+func (m *AddDerivedPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *AddDerivedPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AddDerivedPairProposal) GetDerivedPair() DerivedPair {
+	if m != nil {
+		return m.DerivedPair
+	}
+	return DerivedPair{}
+}
+
+// RemoveDerivedPairProposal is a governance proposal to stop computing the
+// price of a derived pair.
+type RemoveDerivedPairProposal struct {
+	Title       string                                            `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                            `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Pair        github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+}
+
+func (m *RemoveDerivedPairProposal) Reset()         { *m = RemoveDerivedPairProposal{} }
+func (m *RemoveDerivedPairProposal) String() string { return proto.CompactTextString(m) }
+func (*RemoveDerivedPairProposal) ProtoMessage()    {}
+func (*RemoveDerivedPairProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f65cc6e31d2933da, []int{1}
+}
+func (m *RemoveDerivedPairProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDerivedPairProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDerivedPairProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveDerivedPairProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDerivedPairProposal.Merge(m, src)
+}
+func (m *RemoveDerivedPairProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDerivedPairProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDerivedPairProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDerivedPairProposal proto.InternalMessageInfo
+
+func (m *RemoveDerivedPairProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *RemoveDerivedPairProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AddDerivedPairProposal)(nil), "nibiru.oracle.v1.AddDerivedPairProposal")
+	proto.RegisterType((*RemoveDerivedPairProposal)(nil), "nibiru.oracle.v1.RemoveDerivedPairProposal")
+}
+
+func init() { proto.RegisterFile("oracle/v1/gov.proto", fileDescriptor_f65cc6e31d2933da) }
+
+var fileDescriptor_f65cc6e31d2933da = []byte{
+	// 299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x73, 0x5a, 0x85, 0x5e, 0x1d, 0x24, 0x56, 0xa9, 0x05, 0xaf, 0xa5, 0x53, 0x07, 0xb9,
+	0xa3, 0x3a, 0x39, 0x1a, 0xa5, 0x9b, 0x52, 0x32, 0xba, 0xc8, 0x25, 0x39, 0xd2, 0x83, 0x24, 0x5f,
+	0xb8, 0xbb, 0x06, 0xfd, 0x17, 0x8e, 0xce, 0xfe, 0x9a, 0x8e, 0x1d, 0xc5, 0xa1, 0x48, 0xf2, 0x47,
+	0x24, 0x39, 0xa9, 0xc5, 0xc5, 0xc5, 0xed, 0xee, 0x7d, 0xef, 0x9e, 0xef, 0x81, 0x0f, 0x1f, 0x81,
+	0xe2, 0x61, 0x22, 0x58, 0x31, 0x61, 0x31, 0x14, 0x34, 0x57, 0x60, 0xc0, 0x3d, 0xcc, 0x64, 0x20,
+	0xd5, 0x82, 0xda, 0x8e, 0x16, 0x93, 0x7e, 0x37, 0x86, 0x18, 0x9a, 0x92, 0xd5, 0x27, 0xfb, 0xae,
+	0x7f, 0xfc, 0xf3, 0x59, 0x1b, 0x6e, 0x84, 0x8d, 0x47, 0xaf, 0x08, 0x9f, 0x5c, 0x47, 0xd1, 0xad,
+	0x50, 0xb2, 0x10, 0xd1, 0x8c, 0x4b, 0x35, 0x53, 0x90, 0x83, 0xe6, 0x89, 0xdb, 0xc5, 0x7b, 0x46,
+	0x9a, 0x44, 0xf4, 0xd0, 0x10, 0x8d, 0xdb, 0xbe, 0xbd, 0xb8, 0x43, 0xdc, 0x89, 0x84, 0x0e, 0x95,
+	0xcc, 0x8d, 0x84, 0xac, 0xb7, 0xd3, 0x74, 0xdb, 0x91, 0x3b, 0xc5, 0x07, 0x91, 0xc5, 0x3d, 0xe6,
+	0x5c, 0xaa, 0xde, 0xee, 0x10, 0x8d, 0x3b, 0x17, 0x67, 0xf4, 0xb7, 0x28, 0xdd, 0x1a, 0xea, 0xb5,
+	0x96, 0xeb, 0x81, 0x53, 0x73, 0x36, 0xd1, 0xe8, 0x0d, 0xe1, 0x53, 0x5f, 0xa4, 0x50, 0x88, 0xff,
+	0xb4, 0xbb, 0xc3, 0xad, 0x8d, 0x55, 0xdb, 0xbb, 0xaa, 0xc7, 0x7e, 0xac, 0x07, 0x93, 0x58, 0x9a,
+	0xf9, 0x22, 0xa0, 0x21, 0xa4, 0xec, 0xbe, 0xf1, 0xbc, 0x99, 0x73, 0x99, 0x31, 0xeb, 0xcc, 0x9e,
+	0x58, 0x08, 0x69, 0x0a, 0x19, 0xe3, 0x5a, 0x0b, 0x43, 0x6b, 0x11, 0xbf, 0xc1, 0x78, 0xd3, 0x65,
+	0x49, 0xd0, 0xaa, 0x24, 0xe8, 0xb3, 0x24, 0xe8, 0xa5, 0x22, 0xce, 0xaa, 0x22, 0xce, 0x7b, 0x45,
+	0x9c, 0x87, 0xf3, 0xbf, 0x90, 0xdf, 0x0b, 0x31, 0xcf, 0xb9, 0xd0, 0xc1, 0x7e, 0xb3, 0x8e, 0xcb,
+	0xaf, 0x01, 0x00, 0xc3, 0x1e, 0xf9, 0xa1, 0xe4, 0x01, 0x00, 0x00,
+}
+
+func (m *AddDerivedPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddDerivedPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddDerivedPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DerivedPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDerivedPairProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDerivedPairProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDerivedPairProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddDerivedPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.DerivedPair.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *RemoveDerivedPairProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddDerivedPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddDerivedPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddDerivedPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DerivedPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDerivedPairProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDerivedPairProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDerivedPairProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
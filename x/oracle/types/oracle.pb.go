@@ -66,6 +66,33 @@ type Params struct {
 	MinVoters uint64 `protobuf:"varint,9,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
+	// The number of twap lookback windows a price snapshot is kept for. Older
+	// snapshots are pruned by the EndBlocker, except the latest one, which starts
+	// the TWAP window. Zero disables the pruning.
+	SnapshotRetentionLookbacks uint64 `protobuf:"varint,11,opt,name=snapshot_retention_lookbacks,json=snapshotRetentionLookbacks,proto3" json:"snapshot_retention_lookbacks,omitempty" yaml:"snapshot_retention_lookbacks"`
+	// The maximum number of price snapshots the EndBlocker prunes in a block,
+	// across all pairs.
+	MaxSnapshotsPrunedPerBlock uint64 `protobuf:"varint,12,opt,name=max_snapshots_pruned_per_block,json=maxSnapshotsPrunedPerBlock,proto3" json:"max_snapshots_pruned_per_block,omitempty" yaml:"max_snapshots_pruned_per_block"`
+	// The maximum age of an exchange rate before it expires, checked at the end
+	// of every vote period. Zero expires the exchange rates at the end of every
+	// vote period.
+	MaxPriceAge time.Duration `protobuf:"bytes,13,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age,omitempty" yaml:"max_price_age"`
+	// The maximum ages of the exchange rates of specific pairs, overriding
+	// max_price_age.
+	PairMaxAges []PairMaxAge `protobuf:"bytes,14,rep,name=pair_max_ages,json=pairMaxAges,proto3" json:"pair_max_ages" yaml:"pair_max_ages"`
+	// The maximum relative deviation of a tallied exchange rate from the prior
+	// TWAP of the pair. Exchange rates deviating more are not published. Zero
+	// disables the check.
+	MaxPriceDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=max_price_deviation,json=maxPriceDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_price_deviation" yaml:"max_price_deviation"`
+	// The span, in number of price updates, of the exponential moving average
+	// (EMA) of the exchange rates. Zero disables the EMA.
+	EmaSpan uint64 `protobuf:"varint,16,opt,name=ema_span,json=emaSpan,proto3" json:"ema_span,omitempty" yaml:"ema_span"`
+	// The number of latest price snapshots of the rolling median of the
+	// exchange rates. Zero disables the median.
+	MedianWindow uint64 `protobuf:"varint,17,opt,name=median_window,json=medianWindow,proto3" json:"median_window,omitempty" yaml:"median_window"`
+	// The number of slash windows the performance statistics of the validators
+	// are kept for. Zero disables the statistics.
+	PerformanceHistoryWindows uint64 `protobuf:"varint,18,opt,name=performance_history_windows,json=performanceHistoryWindows,proto3" json:"performance_history_windows,omitempty" yaml:"performance_history_windows"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,6 +155,101 @@ func (m *Params) GetMinVoters() uint64 {
 	return 0
 }
 
+func (m *Params) GetSnapshotRetentionLookbacks() uint64 {
+	if m != nil {
+		return m.SnapshotRetentionLookbacks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshotsPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSnapshotsPrunedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetPairMaxAges() []PairMaxAge {
+	if m != nil {
+		return m.PairMaxAges
+	}
+	return nil
+}
+
+func (m *Params) GetEmaSpan() uint64 {
+	if m != nil {
+		return m.EmaSpan
+	}
+	return 0
+}
+
+func (m *Params) GetMedianWindow() uint64 {
+	if m != nil {
+		return m.MedianWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPerformanceHistoryWindows() uint64 {
+	if m != nil {
+		return m.PerformanceHistoryWindows
+	}
+	return 0
+}
+
+// PairMaxAge is the maximum age of the exchange rate of a pair.
+type PairMaxAge struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	MaxAge time.Duration                                     `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age" yaml:"max_age"`
+}
+
+func (m *PairMaxAge) Reset()         { *m = PairMaxAge{} }
+func (m *PairMaxAge) String() string { return proto.CompactTextString(m) }
+func (*PairMaxAge) ProtoMessage()    {}
+func (*PairMaxAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{1}
+}
+func (m *PairMaxAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairMaxAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairMaxAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairMaxAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairMaxAge.Merge(m, src)
+}
+func (m *PairMaxAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairMaxAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairMaxAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairMaxAge proto.InternalMessageInfo
+
+func (m *PairMaxAge) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -141,7 +263,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{1}
+	return fileDescriptor_652b57db11528d07, []int{2}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +302,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{2}
+	return fileDescriptor_652b57db11528d07, []int{3}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +340,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{3}
+	return fileDescriptor_652b57db11528d07, []int{4}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +385,7 @@ func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{4}
+	return fileDescriptor_652b57db11528d07, []int{5}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,6 +437,7 @@ func (m *Rewards) GetCoins() []types.Coin {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*PairMaxAge)(nil), "nibiru.oracle.v1.PairMaxAge")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
@@ -324,64 +447,84 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/oracle.proto", fileDescriptor_652b57db11528d07) }
 
 var fileDescriptor_652b57db11528d07 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x26, 0x4e, 0x1a, 0x8f, 0x93, 0x92, 0x4c, 0xdd, 0xb2, 0x09, 0xc8, 0x6b, 0xa6, 0x52,
-	0xe5, 0x43, 0xd9, 0x55, 0x0a, 0x08, 0x91, 0x1b, 0xdb, 0x10, 0xa8, 0x54, 0x90, 0x35, 0xaa, 0x40,
-	0x42, 0x48, 0xd6, 0xec, 0xee, 0x64, 0x3d, 0xf2, 0xee, 0x8e, 0xb5, 0x33, 0x8e, 0x1b, 0x09, 0x71,
-	0xe2, 0xc0, 0xb1, 0x27, 0xd4, 0x63, 0xce, 0xdc, 0xf9, 0x0e, 0x3d, 0xf6, 0x88, 0x7a, 0xd8, 0xa2,
-	0x84, 0x03, 0x42, 0x9c, 0xfc, 0x09, 0xd0, 0xcc, 0x8e, 0xe3, 0x4d, 0x62, 0x09, 0x42, 0x4f, 0xbb,
-	0xef, 0xbd, 0x99, 0xdf, 0xfb, 0xbd, 0x7f, 0xf3, 0xc0, 0x1d, 0x9e, 0x93, 0x30, 0xa1, 0xde, 0xd1,
-	0xae, 0x57, 0xfe, 0xb9, 0xa3, 0x9c, 0x4b, 0x0e, 0x37, 0x33, 0x16, 0xb0, 0x7c, 0xec, 0x1a, 0xe5,
-	0xd1, 0xee, 0x4e, 0x2b, 0xe6, 0x31, 0xd7, 0x46, 0x4f, 0xfd, 0x95, 0xe7, 0x76, 0xda, 0x31, 0xe7,
-	0x71, 0x42, 0x3d, 0x2d, 0x05, 0xe3, 0x43, 0x2f, 0x1a, 0xe7, 0x44, 0x32, 0x9e, 0xcd, 0xec, 0x21,
-	0x17, 0x29, 0x17, 0x5e, 0x40, 0x84, 0x72, 0x12, 0x50, 0x49, 0x76, 0xbd, 0x90, 0x33, 0x63, 0x47,
-	0x3f, 0xae, 0x81, 0xd5, 0x1e, 0xc9, 0x49, 0x2a, 0xe0, 0xc7, 0xa0, 0x79, 0xc4, 0x25, 0xed, 0x8f,
-	0x68, 0xce, 0x78, 0x64, 0x5b, 0x1d, 0xab, 0x5b, 0xf7, 0xef, 0x4c, 0x0b, 0x07, 0x1e, 0x93, 0x34,
-	0xd9, 0x43, 0x15, 0x23, 0xc2, 0x40, 0x49, 0x3d, 0x2d, 0xc0, 0x0c, 0xdc, 0xd4, 0x36, 0x39, 0xc8,
-	0xa9, 0x18, 0xf0, 0x24, 0xb2, 0x97, 0x3a, 0x56, 0xb7, 0xe1, 0x7f, 0xfe, 0xa2, 0x70, 0x6a, 0xaf,
-	0x0a, 0xe7, 0x5e, 0xcc, 0xe4, 0x60, 0x1c, 0xb8, 0x21, 0x4f, 0x3d, 0x43, 0xa7, 0xfc, 0xbc, 0x2f,
-	0xa2, 0xa1, 0x27, 0x8f, 0x47, 0x54, 0xb8, 0xfb, 0x34, 0x9c, 0x16, 0xce, 0xed, 0x8a, 0xa7, 0x73,
-	0x34, 0x84, 0x37, 0x94, 0xe2, 0xc9, 0x4c, 0x86, 0x14, 0x34, 0x73, 0x3a, 0x21, 0x79, 0xd4, 0x0f,
-	0x48, 0x16, 0xd9, 0xcb, 0xda, 0xd9, 0xfe, 0xb5, 0x9d, 0x99, 0xb0, 0x2a, 0x50, 0x08, 0x83, 0x52,
-	0xf2, 0x49, 0x16, 0xc1, 0x18, 0x34, 0x26, 0x03, 0x26, 0x69, 0xc2, 0x84, 0xb4, 0xeb, 0x9d, 0xe5,
-	0x6e, 0xc3, 0x7f, 0xf4, 0xaa, 0x70, 0x76, 0x2b, 0x0e, 0xbe, 0xd2, 0x45, 0x7a, 0x38, 0x20, 0x2c,
-	0xf3, 0xca, 0x82, 0x79, 0x4f, 0xbd, 0x90, 0xa7, 0x29, 0xcf, 0x3c, 0x22, 0x04, 0x95, 0x6e, 0x8f,
-	0xb0, 0x7c, 0x5a, 0x38, 0x9b, 0xa5, 0xaf, 0x73, 0x3c, 0x84, 0xe7, 0xd8, 0x2a, 0x7f, 0x22, 0x21,
-	0x62, 0xd0, 0x3f, 0xcc, 0x49, 0xa8, 0x6a, 0x67, 0xaf, 0xbc, 0x59, 0xfe, 0x2e, 0xa2, 0x21, 0xbc,
-	0xa1, 0x15, 0x07, 0x46, 0x86, 0x7b, 0x60, 0xbd, 0x3c, 0x31, 0x61, 0x59, 0xc4, 0x27, 0xf6, 0xaa,
-	0xae, 0xf4, 0xdb, 0xd3, 0xc2, 0xb9, 0x55, 0xbd, 0x5f, 0x5a, 0x11, 0x6e, 0x6a, 0xf1, 0x1b, 0x2d,
-	0xc1, 0x1f, 0x40, 0x2b, 0x65, 0x59, 0xff, 0x88, 0x24, 0x2c, 0x52, 0xcd, 0x30, 0xc3, 0xb8, 0xa1,
-	0x19, 0x7f, 0x79, 0x6d, 0xc6, 0xef, 0x94, 0x1e, 0x17, 0x61, 0x22, 0xbc, 0x95, 0xb2, 0xec, 0x6b,
-	0xa5, 0xed, 0xd1, 0xdc, 0xf8, 0xff, 0xd9, 0x02, 0x2d, 0x39, 0x21, 0xa3, 0x7e, 0xc2, 0xf9, 0x30,
-	0x20, 0xe1, 0x70, 0x46, 0x60, 0xad, 0x63, 0x75, 0x9b, 0x0f, 0xb6, 0xdd, 0x72, 0x1e, 0xdc, 0xd9,
-	0x3c, 0xb8, 0xfb, 0x66, 0x1e, 0xfc, 0x47, 0x8a, 0xdb, 0x5f, 0x85, 0xd3, 0x5e, 0x74, 0xfd, 0x3e,
-	0x4f, 0x99, 0xa4, 0xe9, 0x48, 0x1e, 0xcf, 0x39, 0x2d, 0x3a, 0x87, 0x9e, 0xbf, 0x76, 0x2c, 0x0c,
-	0x95, 0xe9, 0xb1, 0xb1, 0x18, 0x62, 0x1f, 0x02, 0xa0, 0x83, 0xe0, 0x92, 0xe6, 0xc2, 0x6e, 0xe8,
-	0x94, 0xde, 0x9e, 0x16, 0xce, 0x56, 0x25, 0x40, 0x6d, 0x43, 0xb8, 0xa1, 0xc2, 0xd2, 0xff, 0xf0,
-	0x7b, 0x70, 0x4b, 0x87, 0x4d, 0x24, 0xcf, 0xfb, 0x87, 0x94, 0xf6, 0x35, 0x59, 0x1b, 0xe8, 0x6c,
-	0x3e, 0xbe, 0x76, 0x36, 0x77, 0xcc, 0xfc, 0x5c, 0x85, 0x44, 0x78, 0xeb, 0x5c, 0x7b, 0x40, 0x29,
-	0x56, 0xba, 0xbd, 0xb5, 0xe7, 0x27, 0x4e, 0xed, 0xcf, 0x13, 0xc7, 0x42, 0xbf, 0x5a, 0xe0, 0xdd,
-	0x4f, 0xe3, 0x38, 0xa7, 0x31, 0x91, 0xf4, 0xb3, 0xa7, 0xe1, 0x80, 0x64, 0xb1, 0x3a, 0x44, 0x7b,
-	0x39, 0x55, 0xb4, 0xe1, 0x5d, 0x50, 0x1f, 0x10, 0x31, 0xd0, 0xaf, 0x42, 0xc3, 0x7f, 0x6b, 0x5a,
-	0x38, 0xcd, 0xd2, 0x97, 0xd2, 0x22, 0xac, 0x8d, 0xf0, 0x1e, 0x58, 0xd1, 0x31, 0x9a, 0xf9, 0xdf,
-	0x9c, 0x16, 0xce, 0xfa, 0x7c, 0xa2, 0x73, 0x84, 0x4b, 0xb3, 0x6e, 0xc0, 0x71, 0x90, 0x32, 0xd9,
-	0x0f, 0x12, 0x1e, 0x0e, 0xf5, 0x04, 0x5f, 0x6c, 0xc0, 0x8a, 0x55, 0x35, 0xa0, 0x16, 0x7d, 0x25,
-	0xed, 0xad, 0xff, 0x74, 0xe2, 0xd4, 0x0c, 0xef, 0x1a, 0xfa, 0xc3, 0x02, 0xdb, 0x0b, 0x79, 0xab,
-	0xfc, 0xc2, 0x67, 0x16, 0x68, 0x51, 0xa3, 0x54, 0x69, 0xa0, 0x7d, 0x39, 0x1e, 0x25, 0x54, 0xd8,
-	0x56, 0x67, 0xb9, 0xdb, 0x7c, 0x70, 0xd7, 0xbd, 0xfc, 0xc8, 0xba, 0x55, 0x88, 0x27, 0xea, 0xac,
-	0xff, 0x89, 0x2a, 0xc2, 0xbc, 0x29, 0x16, 0xc1, 0xa1, 0x5f, 0x5e, 0x3b, 0xf0, 0xca, 0x4d, 0x81,
-	0x21, 0xbd, 0xa2, 0xfb, 0xaf, 0x29, 0xba, 0x14, 0xe6, 0xdf, 0x16, 0xd8, 0xba, 0xe2, 0x00, 0x7e,
-	0x07, 0xea, 0x23, 0xc2, 0x72, 0x53, 0x93, 0x2f, 0x4c, 0xb7, 0xfc, 0xaf, 0xf7, 0xc9, 0x14, 0x53,
-	0xc1, 0x21, 0xac, 0x51, 0xe1, 0x10, 0x6c, 0x5c, 0x08, 0xd6, 0x30, 0x3e, 0xb8, 0x76, 0x53, 0xb6,
-	0x16, 0x64, 0x0e, 0xe1, 0xf5, 0x6a, 0x72, 0x2e, 0x85, 0x2b, 0xc0, 0x0d, 0xac, 0xdf, 0x61, 0x01,
-	0x6f, 0x82, 0x25, 0x66, 0x76, 0x11, 0x5e, 0x62, 0x11, 0x7c, 0x0f, 0xac, 0x57, 0xf6, 0x90, 0xd0,
-	0xa4, 0xea, 0xb8, 0x39, 0xdf, 0x46, 0x02, 0x7e, 0x04, 0x56, 0xd4, 0x82, 0x13, 0xf6, 0xb2, 0xae,
-	0xf2, 0xb6, 0x5b, 0xf2, 0x72, 0xd5, 0x0a, 0x74, 0xcd, 0x0a, 0x74, 0x1f, 0x72, 0x96, 0xf9, 0x75,
-	0x15, 0x0b, 0x2e, 0x4f, 0xfb, 0x07, 0x2f, 0x4e, 0xdb, 0xd6, 0xcb, 0xd3, 0xb6, 0xf5, 0xfb, 0x69,
-	0xdb, 0x7a, 0x76, 0xd6, 0xae, 0xbd, 0x3c, 0x6b, 0xd7, 0x7e, 0x3b, 0x6b, 0xd7, 0xbe, 0xbd, 0xff,
-	0x6f, 0x19, 0x35, 0x3b, 0x5c, 0x07, 0x1d, 0xac, 0xea, 0xa7, 0xe7, 0x83, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x39, 0x63, 0x00, 0xbd, 0xda, 0x07, 0x00, 0x00,
+	// 1219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x26, 0x6e, 0xd2, 0x8c, 0xed, 0x34, 0x99, 0xa6, 0xed, 0x26, 0x8d, 0xbc, 0xf9, 0x4e,
+	0xf5, 0x2d, 0x41, 0x2a, 0xbb, 0x4a, 0x01, 0x21, 0x82, 0x38, 0xd4, 0x0d, 0xa1, 0x95, 0xda, 0xca,
+	0x9a, 0x56, 0x20, 0x21, 0xc4, 0x6a, 0xec, 0x9d, 0xac, 0x47, 0xf1, 0xee, 0xac, 0x66, 0xd6, 0xf9,
+	0x81, 0x10, 0x67, 0x6e, 0xf4, 0x84, 0x7a, 0xec, 0x99, 0x3b, 0x77, 0x8e, 0x3d, 0xf6, 0x88, 0x7a,
+	0xd8, 0xa2, 0x96, 0x03, 0x42, 0x9c, 0xfc, 0x17, 0xa0, 0x99, 0x9d, 0xb5, 0x37, 0x8d, 0x69, 0x08,
+	0x15, 0x27, 0xfb, 0xbd, 0xcf, 0xdb, 0xf7, 0xe3, 0xf3, 0xde, 0x9b, 0x19, 0x70, 0x91, 0x0b, 0xd2,
+	0xed, 0x53, 0x6f, 0x6f, 0xc3, 0xcb, 0xff, 0xb9, 0x89, 0xe0, 0x29, 0x87, 0x0b, 0x31, 0xeb, 0x30,
+	0x31, 0x70, 0x8d, 0x72, 0x6f, 0x63, 0x65, 0x29, 0xe4, 0x21, 0xd7, 0xa0, 0xa7, 0xfe, 0xe5, 0x76,
+	0x2b, 0xcd, 0x90, 0xf3, 0xb0, 0x4f, 0x3d, 0x2d, 0x75, 0x06, 0x3b, 0x5e, 0x30, 0x10, 0x24, 0x65,
+	0x3c, 0x2e, 0xf0, 0x2e, 0x97, 0x11, 0x97, 0x5e, 0x87, 0x48, 0x15, 0xa4, 0x43, 0x53, 0xb2, 0xe1,
+	0x75, 0x39, 0x33, 0x38, 0xfa, 0x7e, 0x1e, 0xcc, 0xb4, 0x89, 0x20, 0x91, 0x84, 0x1f, 0x80, 0xda,
+	0x1e, 0x4f, 0xa9, 0x9f, 0x50, 0xc1, 0x78, 0x60, 0x5b, 0x6b, 0xd6, 0x7a, 0xb5, 0x75, 0x71, 0x98,
+	0x39, 0xf0, 0x90, 0x44, 0xfd, 0x4d, 0x54, 0x02, 0x11, 0x06, 0x4a, 0x6a, 0x6b, 0x01, 0xc6, 0x60,
+	0x5e, 0x63, 0x69, 0x4f, 0x50, 0xd9, 0xe3, 0xfd, 0xc0, 0x9e, 0x5a, 0xb3, 0xd6, 0xe7, 0x5a, 0x9f,
+	0x3e, 0xc9, 0x9c, 0xca, 0xb3, 0xcc, 0xb9, 0x1a, 0xb2, 0xb4, 0x37, 0xe8, 0xb8, 0x5d, 0x1e, 0x79,
+	0x26, 0x9d, 0xfc, 0xe7, 0x1d, 0x19, 0xec, 0x7a, 0xe9, 0x61, 0x42, 0xa5, 0xbb, 0x45, 0xbb, 0xc3,
+	0xcc, 0xb9, 0x50, 0x8a, 0x34, 0xf2, 0x86, 0x70, 0x43, 0x29, 0x1e, 0x14, 0x32, 0xa4, 0xa0, 0x26,
+	0xe8, 0x3e, 0x11, 0x81, 0xdf, 0x21, 0x71, 0x60, 0x4f, 0xeb, 0x60, 0x5b, 0xa7, 0x0e, 0x66, 0xca,
+	0x2a, 0xb9, 0x42, 0x18, 0xe4, 0x52, 0x8b, 0xc4, 0x01, 0x0c, 0xc1, 0xdc, 0x7e, 0x8f, 0xa5, 0xb4,
+	0xcf, 0x64, 0x6a, 0x57, 0xd7, 0xa6, 0xd7, 0xe7, 0x5a, 0xb7, 0x9f, 0x65, 0xce, 0x46, 0x29, 0xc0,
+	0x3d, 0xdd, 0xa4, 0x9b, 0x3d, 0xc2, 0x62, 0x2f, 0x6f, 0x98, 0x77, 0xe0, 0x75, 0x79, 0x14, 0xf1,
+	0xd8, 0x23, 0x52, 0xd2, 0xd4, 0x6d, 0x13, 0x26, 0x86, 0x99, 0xb3, 0x90, 0xc7, 0x1a, 0xf9, 0x43,
+	0x78, 0xec, 0x5b, 0xf1, 0x27, 0xfb, 0x44, 0xf6, 0xfc, 0x1d, 0x41, 0xba, 0xaa, 0x77, 0xf6, 0x99,
+	0x37, 0xe3, 0xef, 0xa8, 0x37, 0x84, 0x1b, 0x5a, 0xb1, 0x6d, 0x64, 0xb8, 0x09, 0xea, 0xb9, 0xc5,
+	0x3e, 0x8b, 0x03, 0xbe, 0x6f, 0xcf, 0xe8, 0x4e, 0x5f, 0x1a, 0x66, 0xce, 0xf9, 0xf2, 0xf7, 0x39,
+	0x8a, 0x70, 0x4d, 0x8b, 0x9f, 0x6b, 0x09, 0x7e, 0x0b, 0x96, 0x22, 0x16, 0xfb, 0x7b, 0xa4, 0xcf,
+	0x02, 0x35, 0x0c, 0x85, 0x8f, 0x59, 0x9d, 0xf1, 0xdd, 0x53, 0x67, 0x7c, 0x39, 0x8f, 0x38, 0xc9,
+	0x27, 0xc2, 0x8b, 0x11, 0x8b, 0x3f, 0x53, 0xda, 0x36, 0x15, 0x26, 0xfe, 0x0f, 0x16, 0x58, 0x4a,
+	0xf7, 0x49, 0xe2, 0xf7, 0x39, 0xdf, 0xed, 0x90, 0xee, 0x6e, 0x91, 0xc0, 0xd9, 0x35, 0x6b, 0xbd,
+	0x76, 0x7d, 0xd9, 0xcd, 0xf7, 0xc1, 0x2d, 0xf6, 0xc1, 0xdd, 0x32, 0xfb, 0xd0, 0xba, 0xad, 0x72,
+	0xfb, 0x23, 0x73, 0x9a, 0x93, 0x3e, 0xbf, 0xc6, 0x23, 0x96, 0xd2, 0x28, 0x49, 0x0f, 0xc7, 0x39,
+	0x4d, 0xb2, 0x43, 0x8f, 0x9e, 0x3b, 0x16, 0x86, 0x0a, 0xba, 0x63, 0x10, 0x93, 0xd8, 0x7b, 0x00,
+	0xe8, 0x22, 0x78, 0x4a, 0x85, 0xb4, 0xe7, 0x34, 0xa5, 0x17, 0x86, 0x99, 0xb3, 0x58, 0x2a, 0x50,
+	0x63, 0x08, 0xcf, 0xa9, 0xb2, 0xf4, 0x7f, 0xf8, 0x0d, 0x38, 0xaf, 0xcb, 0x26, 0x29, 0x17, 0xfe,
+	0x0e, 0xa5, 0xbe, 0x4e, 0xd6, 0x06, 0x9a, 0xcd, 0x3b, 0xa7, 0x66, 0x73, 0xc5, 0xec, 0xcf, 0x71,
+	0x97, 0x08, 0x2f, 0x8e, 0xb4, 0xdb, 0x94, 0x62, 0xa5, 0x83, 0x0c, 0xac, 0xca, 0x98, 0x24, 0xb2,
+	0xc7, 0x53, 0x5f, 0xd0, 0x94, 0xc6, 0x8a, 0xa8, 0x51, 0xc9, 0xd2, 0xae, 0xe9, 0x2a, 0xde, 0x1a,
+	0x66, 0xce, 0x15, 0x33, 0x18, 0xaf, 0xb1, 0x46, 0x78, 0xa5, 0x80, 0x71, 0x81, 0x16, 0x1c, 0x49,
+	0x18, 0x81, 0x66, 0x44, 0x0e, 0xfc, 0xc2, 0x42, 0xfa, 0x89, 0x18, 0xc4, 0x34, 0x6f, 0x77, 0xa7,
+	0xcf, 0xbb, 0xbb, 0x76, 0x5d, 0x07, 0x7b, 0x7b, 0x98, 0x39, 0xff, 0x37, 0x94, 0xbd, 0xd6, 0x1e,
+	0xe1, 0x95, 0x88, 0x1c, 0xdc, 0x2f, 0xf0, 0xb6, 0x86, 0xdb, 0x54, 0xb4, 0x14, 0x08, 0xbf, 0x06,
+	0x0d, 0xf5, 0x79, 0x22, 0x58, 0x97, 0xfa, 0x24, 0xa4, 0x76, 0xe3, 0xa4, 0xf1, 0xf8, 0xc8, 0x8c,
+	0xc7, 0xa5, 0x23, 0xdf, 0x1d, 0x99, 0x8b, 0xa5, 0x71, 0x5e, 0x23, 0x83, 0x7c, 0x20, 0x6a, 0x11,
+	0x39, 0x68, 0x2b, 0xd5, 0x8d, 0x90, 0xc2, 0xaf, 0x40, 0x23, 0x21, 0x4c, 0xf8, 0xca, 0x8e, 0x84,
+	0x54, 0xda, 0xf3, 0x6b, 0xd3, 0xeb, 0xb5, 0xeb, 0xab, 0xee, 0xab, 0x47, 0xba, 0x3e, 0x1c, 0xee,
+	0x92, 0x83, 0x1b, 0x21, 0x6d, 0xad, 0xaa, 0xf0, 0xe3, 0x18, 0x47, 0x1c, 0x20, 0x5c, 0x4b, 0x46,
+	0x96, 0x7a, 0x66, 0xc6, 0x29, 0x04, 0x74, 0x8f, 0xe9, 0x02, 0xec, 0x73, 0x6f, 0x36, 0x33, 0x13,
+	0x5c, 0xaa, 0x05, 0x34, 0x75, 0x6d, 0x15, 0x3a, 0xe8, 0x82, 0xb3, 0x34, 0x22, 0xbe, 0x4c, 0x48,
+	0x6c, 0x2f, 0xe8, 0x96, 0x9d, 0x1f, 0x66, 0xce, 0xb9, 0xdc, 0x49, 0x81, 0x20, 0x3c, 0x4b, 0x23,
+	0x72, 0x3f, 0x21, 0x31, 0xfc, 0x18, 0x34, 0x22, 0x1a, 0x30, 0x12, 0x17, 0x8b, 0xba, 0xa8, 0x3f,
+	0xb2, 0x4b, 0x7c, 0x96, 0x61, 0x84, 0xeb, 0xb9, 0x6c, 0xd6, 0x6a, 0x07, 0x5c, 0x4e, 0xa8, 0xd8,
+	0xe1, 0x22, 0x22, 0x71, 0x97, 0xfa, 0x3d, 0x26, 0x53, 0x2e, 0x0e, 0x8d, 0xb1, 0xb4, 0xa1, 0x76,
+	0x76, 0x75, 0x98, 0x39, 0xc8, 0x10, 0xf7, 0xf7, 0xc6, 0x08, 0x2f, 0x97, 0xd0, 0x5b, 0x39, 0x98,
+	0x87, 0x91, 0x9b, 0x67, 0x1f, 0x3d, 0x76, 0x2a, 0xbf, 0x3f, 0x76, 0x2c, 0xf4, 0xb3, 0x05, 0xc0,
+	0xb8, 0x31, 0xf0, 0x4b, 0x50, 0x55, 0xe4, 0xeb, 0xeb, 0x70, 0xae, 0x75, 0xcb, 0xd0, 0xfb, 0xaf,
+	0x2e, 0x81, 0xda, 0xb8, 0xb7, 0x08, 0x6b, 0xaf, 0xf0, 0x1e, 0x98, 0x35, 0x5d, 0xb6, 0xa7, 0x4e,
+	0x9a, 0xd0, 0x15, 0x33, 0x22, 0xf3, 0xe3, 0x86, 0x8d, 0x06, 0x70, 0x26, 0xd2, 0xd9, 0x6e, 0x56,
+	0x75, 0x09, 0x3f, 0x59, 0x60, 0xf5, 0x46, 0x18, 0x0a, 0x1a, 0x92, 0x94, 0x7e, 0x72, 0xd0, 0xed,
+	0x91, 0x38, 0x54, 0x2b, 0x4f, 0xdb, 0x82, 0xaa, 0x43, 0x08, 0x5e, 0x01, 0xd5, 0x1e, 0x91, 0x3d,
+	0x53, 0xd4, 0xb9, 0x71, 0x6e, 0x4a, 0x8b, 0xb0, 0x06, 0xe1, 0x55, 0x70, 0x46, 0x19, 0x0b, 0x73,
+	0x9b, 0x2f, 0x0c, 0x33, 0xa7, 0x3e, 0xbe, 0x9f, 0x05, 0xc2, 0x39, 0xac, 0xaf, 0x93, 0x41, 0x27,
+	0x62, 0xa9, 0x59, 0xe4, 0xe9, 0x63, 0xd7, 0x49, 0x09, 0x55, 0xd7, 0x89, 0x16, 0xf5, 0x9e, 0x6e,
+	0xd6, 0xbf, 0x7b, 0xec, 0x54, 0x0c, 0xf5, 0x15, 0xf4, 0x9b, 0x05, 0x96, 0x27, 0xe6, 0xad, 0x4e,
+	0x4b, 0xf8, 0xd0, 0x02, 0x4b, 0xd4, 0x28, 0xd5, 0xa1, 0x46, 0xfd, 0x74, 0x90, 0xf4, 0xa9, 0xb4,
+	0x2d, 0xbd, 0x5f, 0x57, 0x8e, 0xef, 0x57, 0xd9, 0xc5, 0x03, 0x65, 0xdb, 0xfa, 0xd0, 0x70, 0x68,
+	0x8e, 0xf8, 0x49, 0xee, 0xd0, 0x8f, 0xcf, 0x1d, 0x78, 0xec, 0x4b, 0x89, 0x21, 0x3d, 0xa6, 0xfb,
+	0xa7, 0x14, 0xbd, 0x52, 0xe6, 0x9f, 0x16, 0x58, 0x3c, 0x16, 0xe0, 0x3f, 0x1e, 0xb4, 0x5d, 0xd0,
+	0x38, 0x52, 0xac, 0xc9, 0x78, 0xfb, 0xd4, 0xc7, 0xc5, 0xd2, 0x04, 0xe6, 0x10, 0xae, 0x97, 0xc9,
+	0x79, 0xa5, 0x5c, 0x09, 0x66, 0xb1, 0x7e, 0x55, 0x49, 0x38, 0x0f, 0xa6, 0x98, 0x79, 0x59, 0xe2,
+	0x29, 0x16, 0xc0, 0xff, 0x81, 0x7a, 0xe9, 0x55, 0x29, 0x75, 0x52, 0x55, 0x5c, 0x1b, 0xbf, 0x2d,
+	0x25, 0x7c, 0x1f, 0x9c, 0x51, 0xcf, 0x55, 0x69, 0x4f, 0xeb, 0x2e, 0x2f, 0xbb, 0x79, 0x5e, 0xae,
+	0x7a, 0xd0, 0xba, 0xe6, 0x41, 0xeb, 0xde, 0xe4, 0x2c, 0x6e, 0x55, 0x55, 0x2d, 0x38, 0xb7, 0x6e,
+	0x6d, 0x3f, 0x79, 0xd1, 0xb4, 0x9e, 0xbe, 0x68, 0x5a, 0xbf, 0xbe, 0x68, 0x5a, 0x0f, 0x5f, 0x36,
+	0x2b, 0x4f, 0x5f, 0x36, 0x2b, 0xbf, 0xbc, 0x6c, 0x56, 0xbe, 0xb8, 0x76, 0x12, 0xa3, 0xe6, 0x45,
+	0xae, 0x8b, 0xee, 0xcc, 0xe8, 0x3d, 0x7c, 0xf7, 0xaf, 0x01, 0x00, 0x54, 0xad, 0xba, 0x88, 0xa8,
+	0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -438,6 +581,62 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ValidatorFeeRatio.Equal(that1.ValidatorFeeRatio) {
 		return false
 	}
+	if this.SnapshotRetentionLookbacks != that1.SnapshotRetentionLookbacks {
+		return false
+	}
+	if this.MaxSnapshotsPrunedPerBlock != that1.MaxSnapshotsPrunedPerBlock {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if len(this.PairMaxAges) != len(that1.PairMaxAges) {
+		return false
+	}
+	for i := range this.PairMaxAges {
+		if !this.PairMaxAges[i].Equal(&that1.PairMaxAges[i]) {
+			return false
+		}
+	}
+	if !this.MaxPriceDeviation.Equal(that1.MaxPriceDeviation) {
+		return false
+	}
+	if this.EmaSpan != that1.EmaSpan {
+		return false
+	}
+	if this.MedianWindow != that1.MedianWindow {
+		return false
+	}
+	if this.PerformanceHistoryWindows != that1.PerformanceHistoryWindows {
+		return false
+	}
+	return true
+}
+func (this *PairMaxAge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairMaxAge)
+	if !ok {
+		that2, ok := that.(PairMaxAge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.MaxAge != that1.MaxAge {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -460,6 +659,69 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PerformanceHistoryWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceHistoryWindows))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MedianWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MedianWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.EmaSpan != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaSpan))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.MaxPriceDeviation.Size()
+		i -= size
+		if _, err := m.MaxPriceDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.PairMaxAges) > 0 {
+		for iNdEx := len(m.PairMaxAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairMaxAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	if m.MaxSnapshotsPrunedPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxSnapshotsPrunedPerBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SnapshotRetentionLookbacks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SnapshotRetentionLookbacks))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
 		size := m.ValidatorFeeRatio.Size()
 		i -= size
@@ -475,12 +737,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x48
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x42
 	{
@@ -550,6 +812,47 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairMaxAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMaxAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMaxAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -770,6 +1073,44 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ValidatorFeeRatio.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if m.SnapshotRetentionLookbacks != 0 {
+		n += 1 + sovOracle(uint64(m.SnapshotRetentionLookbacks))
+	}
+	if m.MaxSnapshotsPrunedPerBlock != 0 {
+		n += 1 + sovOracle(uint64(m.MaxSnapshotsPrunedPerBlock))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.PairMaxAges) > 0 {
+		for _, e := range m.PairMaxAges {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.MaxPriceDeviation.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.EmaSpan != 0 {
+		n += 2 + sovOracle(uint64(m.EmaSpan))
+	}
+	if m.MedianWindow != 0 {
+		n += 2 + sovOracle(uint64(m.MedianWindow))
+	}
+	if m.PerformanceHistoryWindows != 0 {
+		n += 2 + sovOracle(uint64(m.PerformanceHistoryWindows))
+	}
+	return n
+}
+
+func (m *PairMaxAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -1177,6 +1518,319 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionLookbacks", wireType)
+			}
+			m.SnapshotRetentionLookbacks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetentionLookbacks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotsPrunedPerBlock", wireType)
+			}
+			m.MaxSnapshotsPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotsPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairMaxAges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairMaxAges = append(m.PairMaxAges, PairMaxAge{})
+			if err := m.PairMaxAges[len(m.PairMaxAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaSpan", wireType)
+			}
+			m.EmaSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmaSpan |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MedianWindow", wireType)
+			}
+			m.MedianWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MedianWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistoryWindows", wireType)
+			}
+			m.PerformanceHistoryWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceHistoryWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMaxAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMaxAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMaxAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -25,6 +25,17 @@ var (
 	KeyMinValidPerWindow  = []byte("MinValidPerWindow")
 	KeyTwapLookbackWindow = []byte("TwapLookbackWindow")
 	KeyValidatorFeeRatio  = []byte("ValidatorFeeRatio")
+
+	KeySnapshotRetentionLookbacks = []byte("SnapshotRetentionLookbacks")
+	KeyMaxSnapshotsPrunedPerBlock = []byte("MaxSnapshotsPrunedPerBlock")
+
+	KeyMaxPriceAge       = []byte("MaxPriceAge")
+	KeyPairMaxAges       = []byte("PairMaxAges")
+	KeyMaxPriceDeviation = []byte("MaxPriceDeviation")
+	KeyEmaSpan           = []byte("EmaSpan")
+	KeyMedianWindow      = []byte("MedianWindow")
+
+	KeyPerformanceHistoryWindows = []byte("PerformanceHistoryWindows")
 )
 
 // Default parameter values
@@ -35,6 +46,14 @@ const (
 	DefaultVotePeriod  = 10     // vote every 10s
 	DefaultSlashWindow = 604800 // 1 week
 	DefaultMinVoters   = 4      // minimum of 4 voters for a pair to become valid
+
+	DefaultSnapshotRetentionLookbacks = 4    // keep price snapshots for 4 twap lookback windows
+	DefaultMaxSnapshotsPrunedPerBlock = 1000 // prune at most 1000 price snapshots per block
+
+	DefaultEmaSpan      = 30 // EMA over ~30 price updates
+	DefaultMedianWindow = 5  // median of the 5 latest price snapshots
+
+	DefaultPerformanceHistoryWindows = 12 // keep performance statistics for 12 slash windows
 )
 
 // Default parameter values
@@ -73,6 +92,8 @@ var (
 	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(5, 2)        // 5%
 	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute) // 15 minutes
 	DefaultValidatorFeeRatio  = sdk.MustNewDecFromStr("0.05")   // 1%
+	DefaultMaxPriceAge        = time.Duration(0)                // expire at every vote period
+	DefaultMaxPriceDeviation  = sdk.ZeroDec()                   // circuit breaker disabled
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -90,6 +111,17 @@ func DefaultParams() Params {
 		MinValidPerWindow:  DefaultMinValidPerWindow,
 		TwapLookbackWindow: DefaultTwapLookbackWindow,
 		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+
+		SnapshotRetentionLookbacks: DefaultSnapshotRetentionLookbacks,
+		MaxSnapshotsPrunedPerBlock: DefaultMaxSnapshotsPrunedPerBlock,
+
+		MaxPriceAge:       DefaultMaxPriceAge,
+		PairMaxAges:       []PairMaxAge{},
+		MaxPriceDeviation: DefaultMaxPriceDeviation,
+		EmaSpan:           DefaultEmaSpan,
+		MedianWindow:      DefaultMedianWindow,
+
+		PerformanceHistoryWindows: DefaultPerformanceHistoryWindows,
 	}
 }
 
@@ -112,9 +144,27 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyTwapLookbackWindow, &p.TwapLookbackWindow, validateTwapLookbackWindow),
 		paramstypes.NewParamSetPair(KeyValidatorFeeRatio, &p.ValidatorFeeRatio, validateValidatorFeeRatio),
+		paramstypes.NewParamSetPair(KeySnapshotRetentionLookbacks, &p.SnapshotRetentionLookbacks, validateSnapshotRetentionLookbacks),
+		paramstypes.NewParamSetPair(KeyMaxSnapshotsPrunedPerBlock, &p.MaxSnapshotsPrunedPerBlock, validateMaxSnapshotsPrunedPerBlock),
+		paramstypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramstypes.NewParamSetPair(KeyPairMaxAges, &p.PairMaxAges, validatePairMaxAges),
+		paramstypes.NewParamSetPair(KeyMaxPriceDeviation, &p.MaxPriceDeviation, validateMaxPriceDeviation),
+		paramstypes.NewParamSetPair(KeyEmaSpan, &p.EmaSpan, validateEmaSpan),
+		paramstypes.NewParamSetPair(KeyMedianWindow, &p.MedianWindow, validateMedianWindow),
+		paramstypes.NewParamSetPair(KeyPerformanceHistoryWindows, &p.PerformanceHistoryWindows, validatePerformanceHistoryWindows),
 	}
 }
 
+// MaxPriceAgeOf returns the maximum age of the exchange rate of a pair.
+func (p Params) MaxPriceAgeOf(pair asset.Pair) time.Duration {
+	for _, pairMaxAge := range p.PairMaxAges {
+		if pairMaxAge.Pair == pair {
+			return pairMaxAge.MaxAge
+		}
+	}
+	return p.MaxPriceAge
+}
+
 // String implements fmt.Stringer interface
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -155,6 +205,22 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter ValidatorFeeRatio must be between [0, 1]")
 	}
 
+	if p.SnapshotRetentionLookbacks > 0 && p.MaxSnapshotsPrunedPerBlock == 0 {
+		return fmt.Errorf("oracle parameter MaxSnapshotsPrunedPerBlock must be positive when the snapshots are pruned")
+	}
+
+	if p.MaxPriceAge < 0 {
+		return fmt.Errorf("oracle parameter MaxPriceAge must not be negative")
+	}
+
+	if err := validatePairMaxAges(p.PairMaxAges); err != nil {
+		return fmt.Errorf("oracle parameter PairMaxAges invalid: %w", err)
+	}
+
+	if p.MaxPriceDeviation.IsNil() || p.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxPriceDeviation must not be negative")
+	}
+
 	for _, pair := range p.Whitelist {
 		if err := pair.Validate(); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
@@ -312,3 +378,82 @@ func validateValidatorFeeRatio(i interface{}) error {
 
 	return nil
 }
+
+func validateSnapshotRetentionLookbacks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSnapshotsPrunedPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxPriceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max price age should not be negative: %s", v)
+	}
+	return nil
+}
+
+func validatePairMaxAges(i interface{}) error {
+	v, ok := i.([]PairMaxAge)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[asset.Pair]struct{}, len(v))
+	for _, pairMaxAge := range v {
+		if err := pairMaxAge.Pair.Validate(); err != nil {
+			return err
+		}
+		if _, exists := seen[pairMaxAge.Pair]; exists {
+			return fmt.Errorf("duplicate max age for pair %s", pairMaxAge.Pair)
+		}
+		seen[pairMaxAge.Pair] = struct{}{}
+		if pairMaxAge.MaxAge < 0 {
+			return fmt.Errorf("max age of pair %s should not be negative: %s", pairMaxAge.Pair, pairMaxAge.MaxAge)
+		}
+	}
+	return nil
+}
+
+func validateMaxPriceDeviation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max price deviation should not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateEmaSpan(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMedianWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validatePerformanceHistoryWindows(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
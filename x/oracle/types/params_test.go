@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -93,6 +94,28 @@ func TestValidate(t *testing.T) {
 			require.NoError(t, pair.ValidatorFn([]asset.Pair{"BTC:USDT"}))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn([]asset.Pair{""}))
+		case bytes.Equal(types.KeyMaxPriceAge, pair.Key):
+			require.NoError(t, pair.ValidatorFn(time.Minute))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(-time.Minute))
+		case bytes.Equal(types.KeyPairMaxAges, pair.Key):
+			require.NoError(t, pair.ValidatorFn([]types.PairMaxAge{{Pair: "BTC:USDT", MaxAge: time.Minute}}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxAge{{Pair: "", MaxAge: time.Minute}}))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxAge{{Pair: "BTC:USDT", MaxAge: -time.Minute}}))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxAge{
+				{Pair: "BTC:USDT", MaxAge: time.Minute},
+				{Pair: "BTC:USDT", MaxAge: time.Hour},
+			}))
+		case bytes.Equal(types.KeyEmaSpan, pair.Key) ||
+			bytes.Equal(types.KeyMedianWindow, pair.Key) ||
+			bytes.Equal(types.KeyPerformanceHistoryWindows, pair.Key):
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Equal(types.KeyMaxPriceDeviation, pair.Key):
+			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(10, 2)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
 		}
 	}
 }
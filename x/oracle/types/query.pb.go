@@ -9,6 +9,7 @@ import (
 	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -77,6 +78,9 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of assets voted by validators
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// derived is whether the exchange rate is derived from the exchange rates
+	// of voted pairs rather than voted on.
+	Derived bool `protobuf:"varint,2,opt,name=derived,proto3" json:"derived,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -112,6 +116,13 @@ func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
 
+func (m *QueryExchangeRateResponse) GetDerived() bool {
+	if m != nil {
+		return m.Derived
+	}
+	return false
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC
 // method.
 type QueryExchangeRatesRequest struct {
@@ -156,6 +167,9 @@ type QueryExchangeRatesResponse struct {
 	// exchange_rates defines a list of the exchange rate for all whitelisted
 	// pairs.
 	ExchangeRates ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	// derived_pairs lists the pairs of exchange_rates whose exchange rates are
+	// derived from the exchange rates of voted pairs.
+	DerivedPairs []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,rep,name=derived_pairs,json=derivedPairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"derived_pairs"`
 }
 
 func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
@@ -198,6 +212,90 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() ExchangeRateTuples {
 	return nil
 }
 
+// QueryDerivedPairsRequest is the request type for the Query/DerivedPairs RPC
+// method.
+type QueryDerivedPairsRequest struct {
+}
+
+func (m *QueryDerivedPairsRequest) Reset()         { *m = QueryDerivedPairsRequest{} }
+func (m *QueryDerivedPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPairsRequest) ProtoMessage()    {}
+func (*QueryDerivedPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+}
+func (m *QueryDerivedPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPairsRequest.Merge(m, src)
+}
+func (m *QueryDerivedPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedPairsRequest proto.InternalMessageInfo
+
+// QueryDerivedPairsResponse is response type for the Query/DerivedPairs RPC
+// method.
+type QueryDerivedPairsResponse struct {
+	DerivedPairs []DerivedPair `protobuf:"bytes,1,rep,name=derived_pairs,json=derivedPairs,proto3" json:"derived_pairs"`
+}
+
+func (m *QueryDerivedPairsResponse) Reset()         { *m = QueryDerivedPairsResponse{} }
+func (m *QueryDerivedPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedPairsResponse) ProtoMessage()    {}
+func (*QueryDerivedPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+}
+func (m *QueryDerivedPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedPairsResponse.Merge(m, src)
+}
+func (m *QueryDerivedPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedPairsResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedPairsResponse) GetDerivedPairs() []DerivedPair {
+	if m != nil {
+		return m.DerivedPairs
+	}
+	return nil
+}
+
 // QueryActivesRequest is the request type for the Query/Actives RPC method.
 type QueryActivesRequest struct {
 }
@@ -206,7 +304,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +344,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +382,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +423,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +463,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{10}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +503,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{11}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +550,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{10}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{12}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +590,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{11}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{13}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,6 +626,233 @@ func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
 	return 0
 }
 
+// QueryValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceRequest struct {
+	// validator defines the validator address to query for.
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorPerformanceRequest) Reset()         { *m = QueryValidatorPerformanceRequest{} }
+func (m *QueryValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceRequest) ProtoMessage()    {}
+func (*QueryValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{14}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceRequest proto.InternalMessageInfo
+
+// QueryValidatorPerformanceResponse is response type for the
+// Query/ValidatorPerformance RPC method.
+type QueryValidatorPerformanceResponse struct {
+	// stats defines the performance statistics of the validator, oldest slash
+	// window first
+	Stats []ValidatorPerformanceStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// current_slash_window defines the index of the current slash window
+	CurrentSlashWindow uint64              `protobuf:"varint,2,opt,name=current_slash_window,json=currentSlashWindow,proto3" json:"current_slash_window,omitempty"`
+	Pagination         *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorPerformanceResponse) Reset()         { *m = QueryValidatorPerformanceResponse{} }
+func (m *QueryValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorPerformanceResponse) ProtoMessage()    {}
+func (*QueryValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{15}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *QueryValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorPerformanceResponse) GetStats() []ValidatorPerformanceStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryValidatorPerformanceResponse) GetCurrentSlashWindow() uint64 {
+	if m != nil {
+		return m.CurrentSlashWindow
+	}
+	return 0
+}
+
+func (m *QueryValidatorPerformanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPerformanceLeaderboardRequest is the request type for the
+// Query/PerformanceLeaderboard RPC method.
+type QueryPerformanceLeaderboardRequest struct {
+	// slash_window defines the index of the slash window to query for.
+	SlashWindow uint64 `protobuf:"varint,1,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	// pagination supports offset based pagination only.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPerformanceLeaderboardRequest) Reset()         { *m = QueryPerformanceLeaderboardRequest{} }
+func (m *QueryPerformanceLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPerformanceLeaderboardRequest) ProtoMessage()    {}
+func (*QueryPerformanceLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{16}
+}
+func (m *QueryPerformanceLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerformanceLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerformanceLeaderboardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPerformanceLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerformanceLeaderboardRequest.Merge(m, src)
+}
+func (m *QueryPerformanceLeaderboardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerformanceLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerformanceLeaderboardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPerformanceLeaderboardRequest proto.InternalMessageInfo
+
+func (m *QueryPerformanceLeaderboardRequest) GetSlashWindow() uint64 {
+	if m != nil {
+		return m.SlashWindow
+	}
+	return 0
+}
+
+func (m *QueryPerformanceLeaderboardRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPerformanceLeaderboardResponse is response type for the
+// Query/PerformanceLeaderboard RPC method.
+type QueryPerformanceLeaderboardResponse struct {
+	// stats defines the performance statistics of the validators, by decreasing
+	// reward weight, then increasing average deviation
+	Stats []ValidatorPerformanceStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// current_slash_window defines the index of the current slash window
+	CurrentSlashWindow uint64              `protobuf:"varint,2,opt,name=current_slash_window,json=currentSlashWindow,proto3" json:"current_slash_window,omitempty"`
+	Pagination         *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPerformanceLeaderboardResponse) Reset()         { *m = QueryPerformanceLeaderboardResponse{} }
+func (m *QueryPerformanceLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPerformanceLeaderboardResponse) ProtoMessage()    {}
+func (*QueryPerformanceLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{17}
+}
+func (m *QueryPerformanceLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPerformanceLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPerformanceLeaderboardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPerformanceLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPerformanceLeaderboardResponse.Merge(m, src)
+}
+func (m *QueryPerformanceLeaderboardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPerformanceLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPerformanceLeaderboardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPerformanceLeaderboardResponse proto.InternalMessageInfo
+
+func (m *QueryPerformanceLeaderboardResponse) GetStats() []ValidatorPerformanceStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryPerformanceLeaderboardResponse) GetCurrentSlashWindow() uint64 {
+	if m != nil {
+		return m.CurrentSlashWindow
+	}
+	return 0
+}
+
+func (m *QueryPerformanceLeaderboardResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryAggregatePrevoteRequest is the request type for the
 // Query/AggregatePrevote RPC method.
 type QueryAggregatePrevoteRequest struct {
@@ -539,7 +864,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{12}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{18}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +905,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{13}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{19}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +950,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{14}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{20}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +991,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{15}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{21}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +1038,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{16}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{22}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1079,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{17}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{23}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1124,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{18}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{24}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +1165,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{19}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{25}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1209,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{20}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{26}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1248,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{21}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{27}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,6 +1289,8 @@ func init() {
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "nibiru.oracle.v1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "nibiru.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryDerivedPairsRequest)(nil), "nibiru.oracle.v1.QueryDerivedPairsRequest")
+	proto.RegisterType((*QueryDerivedPairsResponse)(nil), "nibiru.oracle.v1.QueryDerivedPairsResponse")
 	proto.RegisterType((*QueryActivesRequest)(nil), "nibiru.oracle.v1.QueryActivesRequest")
 	proto.RegisterType((*QueryActivesResponse)(nil), "nibiru.oracle.v1.QueryActivesResponse")
 	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "nibiru.oracle.v1.QueryVoteTargetsRequest")
@@ -972,6 +1299,10 @@ func init() {
 	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "nibiru.oracle.v1.QueryFeederDelegationResponse")
 	proto.RegisterType((*QueryMissCounterRequest)(nil), "nibiru.oracle.v1.QueryMissCounterRequest")
 	proto.RegisterType((*QueryMissCounterResponse)(nil), "nibiru.oracle.v1.QueryMissCounterResponse")
+	proto.RegisterType((*QueryValidatorPerformanceRequest)(nil), "nibiru.oracle.v1.QueryValidatorPerformanceRequest")
+	proto.RegisterType((*QueryValidatorPerformanceResponse)(nil), "nibiru.oracle.v1.QueryValidatorPerformanceResponse")
+	proto.RegisterType((*QueryPerformanceLeaderboardRequest)(nil), "nibiru.oracle.v1.QueryPerformanceLeaderboardRequest")
+	proto.RegisterType((*QueryPerformanceLeaderboardResponse)(nil), "nibiru.oracle.v1.QueryPerformanceLeaderboardResponse")
 	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "nibiru.oracle.v1.QueryAggregatePrevoteRequest")
 	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "nibiru.oracle.v1.QueryAggregatePrevoteResponse")
 	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "nibiru.oracle.v1.QueryAggregatePrevotesRequest")
@@ -987,77 +1318,100 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/query.proto", fileDescriptor_34238c8dfdfcd7ec) }
 
 var fileDescriptor_34238c8dfdfcd7ec = []byte{
-	// 1106 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x50, 0x52, 0x18, 0xc7, 0xae, 0x33, 0xa4, 0x90, 0x6e, 0x53, 0xbb, 0x2c, 0x4d,
-	0xd4, 0x36, 0xc9, 0x2e, 0x4e, 0x50, 0x51, 0xf8, 0x21, 0x70, 0x12, 0x22, 0x81, 0x1a, 0x08, 0xa6,
-	0x8a, 0x50, 0x85, 0x64, 0x8d, 0xd7, 0xd3, 0xcd, 0xaa, 0xf6, 0xce, 0x76, 0x67, 0x6d, 0x12, 0x01,
-	0x97, 0x0a, 0x10, 0x47, 0x24, 0x84, 0xb8, 0x41, 0x2f, 0x48, 0x88, 0x33, 0x70, 0x87, 0x53, 0x8f,
-	0x95, 0xb8, 0x20, 0x0e, 0x05, 0x25, 0x1c, 0xf8, 0x33, 0xd0, 0xce, 0x8c, 0xd7, 0xbb, 0x5e, 0x8f,
-	0xbc, 0x38, 0xe2, 0x94, 0x68, 0xde, 0xdb, 0xf7, 0x3e, 0xef, 0x3b, 0xb3, 0xf3, 0xf5, 0xc2, 0xb3,
-	0xd4, 0xc7, 0x56, 0x9b, 0x98, 0xbd, 0xaa, 0x79, 0xa7, 0x4b, 0xfc, 0x43, 0xc3, 0xf3, 0x69, 0x40,
-	0x51, 0xc9, 0x75, 0x9a, 0x8e, 0xdf, 0x35, 0x44, 0xd4, 0xe8, 0x55, 0xb5, 0x59, 0x9b, 0xda, 0x94,
-	0x07, 0xcd, 0xf0, 0x3f, 0x91, 0xa7, 0xcd, 0xdb, 0x94, 0xda, 0x6d, 0x62, 0x62, 0xcf, 0x31, 0xb1,
-	0xeb, 0xd2, 0x00, 0x07, 0x0e, 0x75, 0x99, 0x8c, 0x3e, 0x35, 0x28, 0x2e, 0x0b, 0x89, 0xf5, 0xb2,
-	0x45, 0x59, 0x87, 0x32, 0xb3, 0x89, 0x59, 0x18, 0x6c, 0x92, 0x00, 0x57, 0x4d, 0x8b, 0x3a, 0xae,
-	0x88, 0xeb, 0x0c, 0xce, 0xbd, 0x13, 0xc2, 0xbc, 0x7e, 0x60, 0xed, 0x63, 0xd7, 0x26, 0x75, 0x1c,
-	0x90, 0x3a, 0xb9, 0xd3, 0x25, 0x2c, 0x40, 0x3b, 0xf0, 0x94, 0x87, 0x1d, 0x7f, 0x0e, 0x5c, 0x04,
-	0x97, 0x9f, 0xd8, 0x58, 0xbf, 0xff, 0xb0, 0x92, 0xfb, 0xe3, 0x61, 0xa5, 0x6a, 0x3b, 0xc1, 0x7e,
-	0xb7, 0x69, 0x58, 0xb4, 0x63, 0xbe, 0xc5, 0xd1, 0x37, 0xf7, 0xb1, 0xe3, 0x9a, 0x62, 0x0c, 0xf3,
-	0xc0, 0xb4, 0x68, 0xa7, 0x43, 0x5d, 0x13, 0x33, 0x46, 0x02, 0x63, 0x17, 0x3b, 0x7e, 0x9d, 0x97,
-	0x79, 0xf1, 0xf1, 0xcf, 0xef, 0x55, 0x72, 0xff, 0xdc, 0xab, 0xe4, 0x74, 0x0f, 0x9e, 0x1b, 0xd1,
-	0x94, 0x79, 0xd4, 0x65, 0x04, 0xbd, 0x0b, 0x0b, 0x44, 0xae, 0x37, 0x7c, 0x1c, 0x10, 0xd9, 0xde,
-	0x90, 0xed, 0x17, 0x63, 0xed, 0xe5, 0x6c, 0xe2, 0xcf, 0x0a, 0x6b, 0xdd, 0x36, 0x83, 0x43, 0x8f,
-	0x30, 0x63, 0x8b, 0x58, 0xf5, 0x69, 0x12, 0x2b, 0xae, 0x9f, 0x1f, 0xd1, 0x91, 0xc9, 0x39, 0xf5,
-	0x4f, 0x00, 0xd4, 0x46, 0x45, 0x25, 0xd0, 0x2d, 0x58, 0x4c, 0x00, 0xb1, 0x39, 0x70, 0xf1, 0xd1,
-	0xcb, 0xf9, 0xd5, 0x67, 0x8d, 0xe1, 0x9d, 0x33, 0xe2, 0x05, 0x6e, 0x74, 0xbd, 0x36, 0xd9, 0xd0,
-	0x42, 0xec, 0x1f, 0xfe, 0xac, 0xa0, 0x54, 0x88, 0xd5, 0x0b, 0x71, 0x44, 0xa6, 0x9f, 0x85, 0x4f,
-	0x72, 0x8a, 0x9a, 0x15, 0x38, 0xbd, 0x01, 0xdd, 0x6d, 0x38, 0x9b, 0x5c, 0x8e, 0x74, 0x3a, 0x8d,
-	0xc5, 0x12, 0xe7, 0x39, 0xd1, 0x06, 0xf5, 0x2b, 0xe9, 0xe7, 0xe0, 0xd3, 0xbc, 0xd9, 0x1e, 0x0d,
-	0xc8, 0x0d, 0xec, 0xdb, 0x24, 0x88, 0x38, 0x0e, 0xe4, 0x49, 0x49, 0x84, 0x24, 0xcb, 0xfb, 0x70,
-	0xba, 0x47, 0x03, 0xd2, 0x08, 0xc4, 0xfa, 0xc9, 0x81, 0xf2, 0xbd, 0x41, 0x17, 0xfd, 0x6d, 0x38,
-	0xcf, 0x3b, 0x6f, 0x13, 0xd2, 0x22, 0xfe, 0x16, 0x69, 0x13, 0x9b, 0x9f, 0xfd, 0xfe, 0x39, 0x5d,
-	0x80, 0xc5, 0x1e, 0x6e, 0x3b, 0x2d, 0x1c, 0x50, 0xbf, 0x81, 0x5b, 0x2d, 0x79, 0x62, 0xeb, 0x85,
-	0x68, 0xb5, 0xd6, 0x6a, 0xc5, 0xcf, 0xdf, 0x6b, 0xf0, 0x82, 0xa2, 0xa0, 0x9c, 0xa7, 0x02, 0xf3,
-	0xb7, 0x78, 0x2c, 0x5e, 0x0e, 0x8a, 0xa5, 0xb0, 0x96, 0xfe, 0xa6, 0xd4, 0x69, 0xc7, 0x61, 0x6c,
-	0x93, 0x76, 0xdd, 0x80, 0xf8, 0x13, 0xd3, 0xbc, 0x22, 0x85, 0x4d, 0xd4, 0x92, 0x20, 0xcf, 0xc0,
-	0xe9, 0x8e, 0xc3, 0x58, 0xc3, 0x12, 0xeb, 0xbc, 0xd4, 0xa9, 0x7a, 0xbe, 0x33, 0x48, 0x8d, 0xd4,
-	0xa9, 0xd9, 0xb6, 0x1f, 0xce, 0x41, 0x76, 0x7d, 0x12, 0xaa, 0x37, 0x31, 0xcf, 0x5d, 0x20, 0xe5,
-	0x49, 0x57, 0x94, 0x54, 0x18, 0xce, 0xe0, 0x7e, 0xac, 0xe1, 0x89, 0x20, 0xaf, 0x9a, 0x5f, 0x35,
-	0xd2, 0x2f, 0x45, 0x54, 0x26, 0xfe, 0x0a, 0xc8, 0x92, 0x1b, 0xa7, 0xc2, 0x33, 0x52, 0x2f, 0xe1,
-	0xa1, 0x56, 0x7a, 0x45, 0xc1, 0x10, 0x1d, 0xc7, 0x4f, 0x01, 0x2c, 0xab, 0x32, 0x24, 0xa6, 0x05,
-	0x51, 0x0a, 0xb3, 0xff, 0xf2, 0x4e, 0xc6, 0x39, 0x33, 0xcc, 0xc9, 0xf4, 0xeb, 0xf2, 0x66, 0x89,
-	0x9e, 0xde, 0x3b, 0x89, 0xf6, 0x3d, 0x79, 0x13, 0x0d, 0x55, 0x93, 0x03, 0xbd, 0x07, 0x8b, 0x83,
-	0x81, 0x62, 0xa2, 0x2f, 0x65, 0x1c, 0x66, 0x6f, 0x30, 0x49, 0x01, 0xc7, 0x3b, 0xe8, 0xf3, 0xa3,
-	0xfa, 0x46, 0x5a, 0x1f, 0xc2, 0xf3, 0x23, 0xa3, 0x12, 0xeb, 0x26, 0x3c, 0x93, 0xc4, 0xea, 0x8b,
-	0x3c, 0x01, 0x57, 0x31, 0xc1, 0xc5, 0xf4, 0x59, 0x88, 0x78, 0xeb, 0x5d, 0xec, 0xe3, 0x4e, 0x04,
-	0xb4, 0x23, 0xaf, 0xca, 0xfe, 0xaa, 0x04, 0xb9, 0x06, 0xa7, 0x3c, 0xbe, 0x22, 0x75, 0x99, 0x4b,
-	0xf7, 0x17, 0x4f, 0xc8, 0x66, 0x32, 0x7b, 0xf5, 0xd7, 0x33, 0xf0, 0x31, 0x5e, 0x0f, 0x7d, 0x05,
-	0xe0, 0x74, 0x9c, 0x0c, 0x5d, 0x4d, 0x97, 0x50, 0xf9, 0xa5, 0xb6, 0x94, 0x29, 0x57, 0xb0, 0xea,
-	0xcb, 0x77, 0x7f, 0xfb, 0xfb, 0xcb, 0x47, 0x16, 0xd1, 0xa5, 0xfe, 0x35, 0x18, 0x19, 0xb8, 0xf0,
-	0xe8, 0x84, 0xe5, 0xa0, 0x6f, 0x00, 0x2c, 0x25, 0x1c, 0xe4, 0x03, 0xec, 0xfd, 0x7f, 0x6c, 0x55,
-	0xce, 0xb6, 0x84, 0xae, 0x64, 0x61, 0x6b, 0x04, 0x21, 0xcb, 0xb7, 0x00, 0x16, 0x12, 0xf6, 0x89,
-	0xb2, 0x74, 0xec, 0x6f, 0xa8, 0xb6, 0x9c, 0x2d, 0x59, 0xf2, 0xad, 0x71, 0xbe, 0x15, 0xb4, 0xa4,
-	0xe0, 0x0b, 0x7f, 0x6e, 0xb0, 0x24, 0x25, 0x43, 0x9f, 0x01, 0x78, 0x5a, 0x7a, 0x28, 0x5a, 0x50,
-	0xb4, 0x4b, 0x5a, 0xaf, 0xb6, 0x38, 0x2e, 0x2d, 0xe3, 0x5e, 0x0a, 0x1e, 0xe9, 0xb1, 0xe8, 0x6b,
-	0x00, 0xf3, 0x31, 0x13, 0x45, 0x57, 0x14, 0x5d, 0xd2, 0x1e, 0xac, 0x5d, 0xcd, 0x92, 0x9a, 0x71,
-	0x13, 0x05, 0x54, 0xdc, 0xb6, 0xd1, 0xcf, 0x00, 0x96, 0x86, 0x3d, 0x11, 0x19, 0x8a, 0x9e, 0x0a,
-	0x37, 0xd6, 0xcc, 0xcc, 0xf9, 0x12, 0xb4, 0xc6, 0x41, 0x5f, 0x42, 0xeb, 0x0a, 0xd0, 0xe8, 0xae,
-	0x64, 0xe6, 0x87, 0xc9, 0xdb, 0xf4, 0x63, 0x53, 0x58, 0x32, 0xfa, 0x0e, 0xc0, 0x7c, 0xcc, 0x3e,
-	0x95, 0x92, 0xa6, 0xed, 0x5a, 0x29, 0xe9, 0x08, 0x37, 0xd6, 0x5f, 0xe5, 0xa4, 0xeb, 0xe8, 0x85,
-	0x09, 0x48, 0x43, 0xcb, 0x46, 0xbf, 0x00, 0x58, 0x1a, 0xf6, 0x2b, 0xa5, 0xc0, 0x0a, 0x43, 0x57,
-	0x0a, 0xac, 0xb2, 0x6b, 0xfd, 0x3a, 0xc7, 0xde, 0x46, 0x5b, 0x13, 0x60, 0xa7, 0x0c, 0x14, 0xfd,
-	0x08, 0xe0, 0x4c, 0xca, 0x73, 0x51, 0x56, 0xa8, 0xe8, 0x28, 0x3f, 0x97, 0xfd, 0x01, 0x39, 0xc6,
-	0xcb, 0x7c, 0x8c, 0x6b, 0xe8, 0xf9, 0xf1, 0x63, 0xa4, 0x6d, 0x1f, 0xfd, 0x04, 0x60, 0x21, 0xe1,
-	0x5f, 0xca, 0x0b, 0x6a, 0x94, 0x93, 0x2b, 0x2f, 0xa8, 0x91, 0x46, 0xad, 0xbf, 0xc1, 0x51, 0x37,
-	0x51, 0x4d, 0x8d, 0xda, 0x72, 0xc6, 0x2a, 0xce, 0xe5, 0xfe, 0x1e, 0xc0, 0x62, 0xd2, 0x77, 0x51,
-	0x26, 0x96, 0x48, 0xe8, 0x95, 0x8c, 0xd9, 0x12, 0x7d, 0x9d, 0xa3, 0xaf, 0xa1, 0xea, 0x7f, 0x51,
-	0x59, 0x48, 0xfc, 0x11, 0x9c, 0x12, 0xf6, 0x8a, 0x2e, 0x29, 0x7a, 0x26, 0x5c, 0x5c, 0x5b, 0x18,
-	0x93, 0x25, 0x89, 0x16, 0x38, 0x51, 0x05, 0x5d, 0x50, 0x5e, 0x64, 0xdc, 0xd2, 0xb7, 0xef, 0x1f,
-	0x95, 0xc1, 0x83, 0xa3, 0x32, 0xf8, 0xeb, 0xa8, 0x0c, 0xbe, 0x38, 0x2e, 0xe7, 0x1e, 0x1c, 0x97,
-	0x73, 0xbf, 0x1f, 0x97, 0x73, 0x37, 0x97, 0xc7, 0x7d, 0x7f, 0xc8, 0x82, 0xfc, 0xe3, 0xb1, 0x39,
-	0xc5, 0x3f, 0x8c, 0xd7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x07, 0x60, 0xa3, 0x6d, 0xaf, 0x0f,
-	0x00, 0x00,
+	// 1485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0xdd, 0x6f, 0x14, 0x55,
+	0x14, 0xc0, 0x7b, 0xa1, 0x7c, 0x78, 0xb6, 0x2d, 0xe5, 0x52, 0x70, 0x19, 0xe8, 0x6e, 0x19, 0x68,
+	0x2d, 0x6d, 0x99, 0xa1, 0x2d, 0x62, 0xea, 0x27, 0x85, 0x52, 0xd4, 0x50, 0xad, 0x0b, 0x41, 0x43,
+	0x8c, 0x9b, 0xdb, 0x9d, 0xcb, 0x76, 0xc2, 0xee, 0xcc, 0x32, 0x77, 0x76, 0x81, 0xa8, 0x2f, 0x24,
+	0x1a, 0xe3, 0x8b, 0x26, 0xc6, 0xf8, 0xa0, 0x51, 0x62, 0x62, 0x62, 0x7c, 0xf5, 0xe3, 0xdd, 0x27,
+	0x79, 0x24, 0xf1, 0xc5, 0xf8, 0x80, 0x06, 0x48, 0xd4, 0xff, 0xc2, 0xcc, 0xbd, 0x77, 0x76, 0xef,
+	0xec, 0xec, 0x74, 0xa7, 0xad, 0xbc, 0xf8, 0xd4, 0xee, 0x3d, 0xe7, 0x9e, 0xf3, 0x3b, 0xe7, 0xce,
+	0xb9, 0x73, 0xce, 0xc0, 0x5e, 0xd7, 0x23, 0xa5, 0x0a, 0x35, 0x1b, 0xd3, 0xe6, 0xb5, 0x3a, 0xf5,
+	0x6e, 0x1a, 0x35, 0xcf, 0xf5, 0x5d, 0x3c, 0xe8, 0xd8, 0x2b, 0xb6, 0x57, 0x37, 0x84, 0xd4, 0x68,
+	0x4c, 0x6b, 0x43, 0x65, 0xb7, 0xec, 0x72, 0xa1, 0x19, 0xfc, 0x27, 0xf4, 0xb4, 0x83, 0x65, 0xd7,
+	0x2d, 0x57, 0xa8, 0x49, 0x6a, 0xb6, 0x49, 0x1c, 0xc7, 0xf5, 0x89, 0x6f, 0xbb, 0x0e, 0x93, 0xd2,
+	0x7d, 0x2d, 0xe3, 0xd2, 0x90, 0x58, 0x57, 0x9c, 0x32, 0x9f, 0xf8, 0xe1, 0x72, 0xae, 0xe4, 0xb2,
+	0xaa, 0xcb, 0xcc, 0x15, 0xc2, 0x02, 0xd9, 0x0a, 0xf5, 0xc9, 0xb4, 0x59, 0x72, 0x6d, 0x47, 0xca,
+	0x27, 0x54, 0x39, 0xa7, 0x6d, 0x6a, 0xd5, 0x48, 0xd9, 0x76, 0xb8, 0x6f, 0xa1, 0xab, 0x33, 0xc8,
+	0xbe, 0x16, 0x68, 0x9c, 0xbd, 0x51, 0x5a, 0x25, 0x4e, 0x99, 0x16, 0x88, 0x4f, 0x0b, 0xf4, 0x5a,
+	0x9d, 0x32, 0x1f, 0x2f, 0x41, 0x6f, 0x8d, 0xd8, 0x5e, 0x16, 0x8d, 0xa0, 0xf1, 0xc7, 0x4e, 0xcf,
+	0xdd, 0xb9, 0x97, 0xef, 0xf9, 0xfd, 0x5e, 0x7e, 0xba, 0x6c, 0xfb, 0xab, 0xf5, 0x15, 0xa3, 0xe4,
+	0x56, 0xcd, 0x57, 0x78, 0xf4, 0x67, 0x56, 0x89, 0xed, 0x98, 0x22, 0x13, 0xe6, 0x0d, 0xb3, 0xe4,
+	0x56, 0xab, 0xae, 0x63, 0x12, 0xc6, 0xa8, 0x6f, 0x2c, 0x13, 0xdb, 0x2b, 0x70, 0x33, 0x4f, 0xef,
+	0xfc, 0xe0, 0x76, 0xbe, 0xe7, 0xef, 0xdb, 0xf9, 0x1e, 0xfd, 0x43, 0x04, 0xfb, 0x3b, 0x78, 0x65,
+	0x35, 0xd7, 0x61, 0x14, 0x5f, 0x80, 0x7e, 0x2a, 0xd7, 0x8b, 0x1e, 0xf1, 0xa9, 0xf4, 0x6f, 0x48,
+	0xff, 0x63, 0x8a, 0x7f, 0x19, 0xa8, 0xf8, 0x73, 0x8c, 0x59, 0x57, 0x4d, 0xff, 0x66, 0x8d, 0x32,
+	0x63, 0x81, 0x96, 0x0a, 0x7d, 0x54, 0x31, 0x8e, 0xb3, 0xb0, 0xc3, 0xa2, 0x9e, 0xdd, 0xa0, 0x56,
+	0x76, 0xcb, 0x08, 0x1a, 0xdf, 0x59, 0x08, 0x7f, 0xea, 0x07, 0x3a, 0xb0, 0x30, 0x99, 0x02, 0xfd,
+	0x21, 0x02, 0xad, 0x93, 0x54, 0xa2, 0x5e, 0x81, 0x81, 0x08, 0x2a, 0xcb, 0xa2, 0x91, 0xad, 0xe3,
+	0x99, 0x99, 0xc3, 0x46, 0xfb, 0x73, 0x61, 0xa8, 0x06, 0x2e, 0xd6, 0x6b, 0x15, 0x7a, 0x5a, 0x0b,
+	0x02, 0xfa, 0xee, 0x8f, 0x3c, 0x8e, 0x89, 0x58, 0xa1, 0x5f, 0x85, 0x67, 0xf8, 0x2d, 0xe8, 0x97,
+	0xb8, 0xc5, 0x20, 0x95, 0x2c, 0xbb, 0x65, 0x64, 0xeb, 0xe6, 0x8e, 0xa4, 0x4f, 0xda, 0x0b, 0x7e,
+	0x30, 0x5d, 0x93, 0x4f, 0xc1, 0x82, 0xb2, 0x18, 0xa6, 0x80, 0xc2, 0xfe, 0x0e, 0x32, 0x99, 0x80,
+	0x17, 0xdb, 0xc1, 0x44, 0xfc, 0xc3, 0xf1, 0xf8, 0x95, 0xed, 0xa7, 0x7b, 0x03, 0xee, 0x36, 0x84,
+	0xbd, 0xb0, 0x87, 0xbb, 0x99, 0x2f, 0xf9, 0x76, 0xa3, 0x75, 0x00, 0x57, 0x61, 0x28, 0xba, 0xdc,
+	0x7c, 0x48, 0x76, 0x10, 0xb1, 0x94, 0x45, 0x9b, 0xcd, 0x45, 0x68, 0x49, 0xdf, 0x0f, 0x8f, 0x73,
+	0x67, 0x97, 0x5c, 0x9f, 0x5e, 0x24, 0x5e, 0x99, 0xfa, 0x4d, 0x8e, 0x1b, 0x90, 0x8d, 0x8b, 0x24,
+	0xcb, 0x9b, 0xd0, 0xd7, 0x70, 0x7d, 0x5a, 0xf4, 0xc5, 0xfa, 0xe6, 0x81, 0x32, 0x8d, 0x96, 0x17,
+	0xfd, 0x55, 0x38, 0xc8, 0x3d, 0x2f, 0x52, 0x6a, 0x51, 0x6f, 0x81, 0x56, 0x68, 0x99, 0x17, 0x70,
+	0x58, 0xa5, 0xa3, 0x30, 0xd0, 0x20, 0x15, 0xdb, 0x22, 0xbe, 0xeb, 0x15, 0x89, 0x65, 0xc9, 0x7a,
+	0x2d, 0xf4, 0x37, 0x57, 0xe7, 0x2d, 0x4b, 0xad, 0xbe, 0x53, 0x30, 0x9c, 0x60, 0x50, 0xc6, 0x93,
+	0x87, 0xcc, 0x15, 0x2e, 0x53, 0xcd, 0x81, 0x58, 0x0a, 0x6c, 0xe9, 0x2f, 0xcb, 0x3c, 0x2d, 0xd9,
+	0x8c, 0x9d, 0x71, 0xeb, 0x8e, 0x4f, 0xbd, 0x0d, 0xd3, 0x3c, 0x07, 0xd9, 0xb8, 0x2d, 0x09, 0x72,
+	0x08, 0xfa, 0xaa, 0x36, 0x63, 0xc5, 0x92, 0x58, 0xe7, 0xa6, 0x7a, 0x0b, 0x99, 0x6a, 0x4b, 0x55,
+	0xff, 0x1c, 0xc1, 0x88, 0x38, 0x98, 0xd0, 0xfe, 0x32, 0xf5, 0xae, 0xb8, 0x5e, 0x95, 0x38, 0x25,
+	0xba, 0x3e, 0x28, 0xbc, 0x08, 0xd0, 0xba, 0x1f, 0xf9, 0x35, 0x91, 0x99, 0x19, 0x33, 0xc4, 0xe5,
+	0x62, 0x04, 0x97, 0xa9, 0x21, 0xae, 0x7e, 0x79, 0x99, 0x1a, 0xcb, 0xa4, 0x1c, 0xba, 0x28, 0x28,
+	0x3b, 0x95, 0xe0, 0xfe, 0x42, 0x70, 0x68, 0x0d, 0x3a, 0x19, 0xe6, 0x39, 0xd8, 0x16, 0x5c, 0xef,
+	0x61, 0xf1, 0x4c, 0xc6, 0x8b, 0xa7, 0xd3, 0xf6, 0x0b, 0xc1, 0x16, 0x59, 0x4a, 0x62, 0x3f, 0x3e,
+	0x0e, 0x43, 0xa5, 0xba, 0xe7, 0x51, 0xc7, 0x2f, 0xb2, 0x0a, 0x61, 0xab, 0xc5, 0xeb, 0xb6, 0x63,
+	0xb9, 0xd7, 0x79, 0x28, 0xbd, 0x05, 0x2c, 0x65, 0x17, 0x02, 0xd1, 0xeb, 0x5c, 0x82, 0xcf, 0x45,
+	0x42, 0xde, 0xca, 0x43, 0x7e, 0xa2, 0x6b, 0xc8, 0x82, 0x5b, 0x8d, 0x59, 0xff, 0x08, 0x81, 0xce,
+	0x23, 0x55, 0x08, 0xcf, 0x53, 0x62, 0x51, 0x6f, 0xc5, 0x25, 0x9e, 0x15, 0x9e, 0xc4, 0x21, 0xe8,
+	0x8b, 0x90, 0xc9, 0x13, 0x65, 0x0a, 0xd2, 0x7f, 0x74, 0x0a, 0xfa, 0x3f, 0x08, 0x0e, 0xaf, 0x49,
+	0xf4, 0x3f, 0xca, 0x7e, 0x78, 0x47, 0xcc, 0x97, 0xcb, 0x5e, 0x50, 0xcd, 0x74, 0xd9, 0xa3, 0x0d,
+	0xd7, 0xa7, 0x1b, 0xae, 0xca, 0x5b, 0x08, 0x86, 0x13, 0x2c, 0xca, 0xb4, 0x11, 0xd8, 0x4d, 0x42,
+	0x59, 0xb1, 0x26, 0x84, 0xdc, 0x6a, 0x66, 0xc6, 0x88, 0xa7, 0xb0, 0x69, 0x46, 0x7d, 0xd7, 0x49,
+	0x93, 0x32, 0x8b, 0x83, 0xa4, 0xcd, 0x95, 0x9e, 0x4f, 0x60, 0x68, 0x5e, 0xca, 0xef, 0x21, 0xc8,
+	0x25, 0x69, 0x48, 0xcc, 0x12, 0xe0, 0x18, 0x66, 0x78, 0xd4, 0x1b, 0xe3, 0xdc, 0xdd, 0xce, 0xc9,
+	0xf4, 0xf3, 0xf2, 0x15, 0xd9, 0xdc, 0x7d, 0x69, 0x33, 0xb9, 0x6f, 0x80, 0xd6, 0xc9, 0x9a, 0x0c,
+	0xe8, 0x0d, 0x18, 0x68, 0x05, 0xa4, 0x24, 0x7d, 0x32, 0x65, 0x30, 0x97, 0x5a, 0x91, 0xf4, 0x13,
+	0xd5, 0x83, 0x7e, 0xb0, 0x93, 0xdf, 0x66, 0xae, 0x6f, 0xc2, 0x81, 0x8e, 0x52, 0x89, 0x75, 0x19,
+	0x76, 0x45, 0xb1, 0xd6, 0xa8, 0xa7, 0x6e, 0x5c, 0x03, 0x11, 0x2e, 0xa6, 0x0f, 0x01, 0x16, 0x85,
+	0x4c, 0x3c, 0x52, 0x6d, 0x02, 0x2d, 0xc1, 0x9e, 0xc8, 0xaa, 0x04, 0x39, 0x09, 0xdb, 0x6b, 0x7c,
+	0x45, 0xe6, 0x25, 0x1b, 0xf7, 0x2f, 0x76, 0x48, 0x67, 0x52, 0x7b, 0xe6, 0xfb, 0x7d, 0xb0, 0x8d,
+	0xdb, 0xc3, 0x9f, 0x22, 0xe8, 0x53, 0xc9, 0xf0, 0x44, 0xdc, 0x44, 0x52, 0xcf, 0xac, 0x4d, 0xa6,
+	0xd2, 0x15, 0xac, 0xfa, 0xd4, 0xad, 0x5f, 0x1f, 0x7e, 0xb2, 0x65, 0x0c, 0x1f, 0x09, 0x9b, 0x81,
+	0x66, 0xbf, 0x2f, 0xba, 0xf5, 0x48, 0x6f, 0x89, 0xbf, 0x44, 0x30, 0x18, 0x69, 0x15, 0xaf, 0x93,
+	0xda, 0xa3, 0x63, 0x9b, 0xe6, 0x6c, 0x93, 0xf8, 0x68, 0x1a, 0xb6, 0xa2, 0x1f, 0xb0, 0x7c, 0x81,
+	0x60, 0x97, 0x6a, 0xeb, 0x6c, 0x95, 0x3c, 0x3a, 0xbe, 0xe3, 0x9c, 0x6f, 0x02, 0x8f, 0xa7, 0xe2,
+	0xa3, 0x55, 0x82, 0xbf, 0x46, 0x10, 0x69, 0xb5, 0x97, 0xa8, 0x65, 0x13, 0xe7, 0xd1, 0x11, 0xce,
+	0x72, 0xc2, 0x63, 0x78, 0x32, 0x15, 0x61, 0x55, 0xd0, 0x7c, 0x85, 0xa0, 0xff, 0x6c, 0xa4, 0xf7,
+	0x4f, 0xe3, 0x33, 0x2c, 0x0a, 0x6d, 0x2a, 0x9d, 0x72, 0x4a, 0x42, 0xde, 0xd2, 0x47, 0x39, 0x19,
+	0x2f, 0x0f, 0x75, 0x16, 0x48, 0x4c, 0x60, 0x87, 0x61, 0x42, 0x9b, 0x4c, 0xa5, 0x9b, 0xb2, 0x3c,
+	0x04, 0x9e, 0x9c, 0x22, 0xf0, 0xfb, 0x08, 0x76, 0xc8, 0x29, 0x01, 0x8f, 0x26, 0xb8, 0x89, 0x0e,
+	0x17, 0xda, 0x58, 0x37, 0xb5, 0x75, 0x81, 0xc8, 0x29, 0x02, 0x7f, 0x86, 0x20, 0xa3, 0x8c, 0x09,
+	0xf8, 0x68, 0x82, 0x97, 0xf8, 0x94, 0xa1, 0x4d, 0xa4, 0x51, 0x4d, 0x59, 0xa0, 0x02, 0x4a, 0x1d,
+	0x4c, 0xf0, 0x4f, 0x08, 0x06, 0xdb, 0xbb, 0x7e, 0x6c, 0x24, 0xf8, 0x4c, 0x98, 0x37, 0x34, 0x33,
+	0xb5, 0xbe, 0x04, 0x9d, 0xe7, 0xa0, 0xcf, 0xe0, 0xb9, 0x04, 0xd0, 0xe6, 0x7b, 0x90, 0x99, 0x6f,
+	0x47, 0xdf, 0x94, 0xef, 0x9a, 0x62, 0xe8, 0xc0, 0xdf, 0x20, 0xc8, 0x28, 0x03, 0x42, 0x62, 0x4a,
+	0xe3, 0x03, 0x89, 0x36, 0x91, 0x46, 0x55, 0x92, 0xbe, 0xc0, 0x49, 0xe7, 0xf0, 0x53, 0x1b, 0x20,
+	0x0d, 0x86, 0x12, 0xfc, 0x0b, 0x82, 0xa1, 0x4e, 0xdd, 0x22, 0x9e, 0x49, 0x3a, 0xd8, 0xe4, 0xa9,
+	0x45, 0x9b, 0x5d, 0xd7, 0x1e, 0x19, 0xc2, 0x22, 0x0f, 0xe1, 0x14, 0x7e, 0x7e, 0x03, 0x21, 0xd4,
+	0x14, 0xe0, 0x3b, 0x08, 0xf6, 0x75, 0x6e, 0x9c, 0xf1, 0x89, 0x04, 0xae, 0x35, 0x3b, 0x7f, 0xed,
+	0xc9, 0x75, 0xee, 0x5a, 0xff, 0xc3, 0xa3, 0xe0, 0x17, 0x2b, 0x0a, 0xef, 0xcf, 0x08, 0x06, 0xdb,
+	0x1b, 0xc4, 0xc4, 0xa7, 0x3e, 0xa1, 0x83, 0xd6, 0xcc, 0xd4, 0xfa, 0x12, 0xfc, 0x3c, 0x07, 0x5f,
+	0xc4, 0x0b, 0x1b, 0x38, 0x88, 0x58, 0xc7, 0x8a, 0x7f, 0x40, 0xb0, 0xbb, 0xdd, 0x15, 0xc3, 0x69,
+	0xa1, 0x9a, 0xf7, 0xcb, 0xf1, 0xf4, 0x1b, 0x64, 0x18, 0xcf, 0xf2, 0x30, 0x4e, 0xe2, 0x13, 0xdd,
+	0xc3, 0x88, 0xf7, 0xd9, 0xf8, 0x47, 0x04, 0xfd, 0x91, 0x86, 0x31, 0xf1, 0x6d, 0xd6, 0xa9, 0x75,
+	0xd6, 0xa6, 0xd2, 0x29, 0x4b, 0xd4, 0x97, 0x38, 0xea, 0x19, 0x3c, 0x9f, 0x8c, 0x6a, 0xd9, 0x5d,
+	0x33, 0xce, 0xd3, 0xfd, 0x2d, 0x82, 0x81, 0x88, 0x13, 0x86, 0x53, 0xb1, 0x34, 0x13, 0x7d, 0x2c,
+	0xa5, 0xb6, 0x44, 0x9f, 0xe3, 0xe8, 0xb3, 0x78, 0x7a, 0x3d, 0x59, 0x16, 0x29, 0x7e, 0x07, 0xb6,
+	0x8b, 0x7e, 0x16, 0x1f, 0x49, 0xaa, 0x30, 0xb5, 0x6d, 0xd6, 0x46, 0xbb, 0x68, 0x49, 0xa2, 0x51,
+	0x4e, 0x94, 0xc7, 0xc3, 0x89, 0x6f, 0x17, 0xde, 0x43, 0x2f, 0xde, 0xb9, 0x9f, 0x43, 0x77, 0xef,
+	0xe7, 0xd0, 0x9f, 0xf7, 0x73, 0xe8, 0xe3, 0x07, 0xb9, 0x9e, 0xbb, 0x0f, 0x72, 0x3d, 0xbf, 0x3d,
+	0xc8, 0xf5, 0x5c, 0x9e, 0xea, 0xf6, 0xd9, 0x4b, 0x1a, 0xe4, 0x1f, 0x6c, 0x57, 0xb6, 0xf3, 0xaf,
+	0xd1, 0xb3, 0xff, 0x0e, 0x00, 0xe9, 0x39, 0x61, 0xfb, 0x67, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1076,8 +1430,16 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponential moving average exchange rate of a
+	// pair
+	ExchangeRateEma(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRateMedian returns the median exchange rate of a pair over its
+	// latest price snapshots
+	ExchangeRateMedian(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// DerivedPairs returns the pairs whose prices are derived from voted pairs
+	DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error)
 	// Actives returns all active pairs
 	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target for pairs
@@ -1086,6 +1448,12 @@ type QueryClient interface {
 	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// ValidatorPerformance returns the oracle performance statistics of a
+	// validator over the kept slash windows
+	ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error)
+	// PerformanceLeaderboard returns the oracle performance statistics of all
+	// validators over a slash window, best performing first
+	PerformanceLeaderboard(ctx context.Context, in *QueryPerformanceLeaderboardRequest, opts ...grpc.CallOption) (*QueryPerformanceLeaderboardResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -1124,6 +1492,24 @@ func (c *queryClient) ExchangeRateTwap(ctx context.Context, in *QueryExchangeRat
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateEma(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateEma", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRateMedian(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateMedian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
 	out := new(QueryExchangeRatesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRates", in, out, opts...)
@@ -1133,6 +1519,15 @@ func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesR
 	return out, nil
 }
 
+func (c *queryClient) DerivedPairs(ctx context.Context, in *QueryDerivedPairsRequest, opts ...grpc.CallOption) (*QueryDerivedPairsResponse, error) {
+	out := new(QueryDerivedPairsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/DerivedPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
 	out := new(QueryActivesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/Actives", in, out, opts...)
@@ -1169,6 +1564,24 @@ func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterReque
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *QueryValidatorPerformanceRequest, opts ...grpc.CallOption) (*QueryValidatorPerformanceResponse, error) {
+	out := new(QueryValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ValidatorPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PerformanceLeaderboard(ctx context.Context, in *QueryPerformanceLeaderboardRequest, opts ...grpc.CallOption) (*QueryPerformanceLeaderboardResponse, error) {
+	out := new(QueryPerformanceLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/PerformanceLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
 	out := new(QueryAggregatePrevoteResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/AggregatePrevote", in, out, opts...)
@@ -1220,8 +1633,16 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateEma returns the exponential moving average exchange rate of a
+	// pair
+	ExchangeRateEma(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRateMedian returns the median exchange rate of a pair over its
+	// latest price snapshots
+	ExchangeRateMedian(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRates returns exchange rates of all pairs
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// DerivedPairs returns the pairs whose prices are derived from voted pairs
+	DerivedPairs(context.Context, *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error)
 	// Actives returns all active pairs
 	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target for pairs
@@ -1230,6 +1651,12 @@ type QueryServer interface {
 	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
 	// MissCounter returns oracle miss counter of a validator
 	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
+	// ValidatorPerformance returns the oracle performance statistics of a
+	// validator over the kept slash windows
+	ValidatorPerformance(context.Context, *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error)
+	// PerformanceLeaderboard returns the oracle performance statistics of all
+	// validators over a slash window, best performing first
+	PerformanceLeaderboard(context.Context, *QueryPerformanceLeaderboardRequest) (*QueryPerformanceLeaderboardResponse, error)
 	// AggregatePrevote returns an aggregate prevote of a validator
 	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
 	// AggregatePrevotes returns aggregate prevotes of all validators
@@ -1252,9 +1679,18 @@ func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExc
 func (*UnimplementedQueryServer) ExchangeRateTwap(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateTwap not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateEma(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateEma not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRateMedian(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateMedian not implemented")
+}
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
+func (*UnimplementedQueryServer) DerivedPairs(ctx context.Context, req *QueryDerivedPairsRequest) (*QueryDerivedPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedPairs not implemented")
+}
 func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
 }
@@ -1267,6 +1703,12 @@ func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
 }
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *QueryValidatorPerformanceRequest) (*QueryValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) PerformanceLeaderboard(ctx context.Context, req *QueryPerformanceLeaderboardRequest) (*QueryPerformanceLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PerformanceLeaderboard not implemented")
+}
 func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
 }
@@ -1323,17 +1765,53 @@ func _Query_ExchangeRateTwap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
+func _Query_ExchangeRateEma_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
+		return srv.(QueryServer).ExchangeRateEma(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRates",
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateEma",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateEma(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRateMedian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateMedian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateMedian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateMedian(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
@@ -1341,6 +1819,24 @@ func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DerivedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/DerivedPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedPairs(ctx, req.(*QueryDerivedPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActivesRequest)
 	if err := dec(in); err != nil {
@@ -1413,6 +1909,42 @@ func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*QueryValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerformanceLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPerformanceLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerformanceLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/PerformanceLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerformanceLeaderboard(ctx, req.(*QueryPerformanceLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAggregatePrevoteRequest)
 	if err := dec(in); err != nil {
@@ -1515,10 +2047,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRateTwap",
 			Handler:    _Query_ExchangeRateTwap_Handler,
 		},
+		{
+			MethodName: "ExchangeRateEma",
+			Handler:    _Query_ExchangeRateEma_Handler,
+		},
+		{
+			MethodName: "ExchangeRateMedian",
+			Handler:    _Query_ExchangeRateMedian_Handler,
+		},
 		{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
 		},
+		{
+			MethodName: "DerivedPairs",
+			Handler:    _Query_DerivedPairs_Handler,
+		},
 		{
 			MethodName: "Actives",
 			Handler:    _Query_Actives_Handler,
@@ -1535,6 +2079,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MissCounter",
 			Handler:    _Query_MissCounter_Handler,
 		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "PerformanceLeaderboard",
+			Handler:    _Query_PerformanceLeaderboard_Handler,
+		},
 		{
 			MethodName: "AggregatePrevote",
 			Handler:    _Query_AggregatePrevote_Handler,
@@ -1613,6 +2165,16 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Derived {
+		i--
+		if m.Derived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.ExchangeRate.Size()
 		i -= size
@@ -1669,6 +2231,20 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.DerivedPairs[iNdEx].Size()
+				i -= size
+				if _, err := m.DerivedPairs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ExchangeRates) > 0 {
 		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1686,6 +2262,66 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDerivedPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDerivedPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDerivedPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDerivedPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DerivedPairs) > 0 {
+		for iNdEx := len(m.DerivedPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivedPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1924,7 +2560,7 @@ func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1934,16 +2570,28 @@ func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ValidatorAddr) > 0 {
 		i -= len(m.ValidatorAddr)
 		copy(dAtA[i:], m.ValidatorAddr)
@@ -1954,7 +2602,7 @@ func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1964,30 +2612,51 @@ func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CurrentSlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentSlashWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPerformanceLeaderboardRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1997,20 +2666,37 @@ func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPerformanceLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPerformanceLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPerformanceLeaderboardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2020,20 +2706,37 @@ func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPerformanceLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPerformanceLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CurrentSlashWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentSlashWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2047,7 +2750,7 @@ func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2057,12 +2760,12 @@ func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2077,7 +2780,7 @@ func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2087,18 +2790,18 @@ func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2110,7 +2813,7 @@ func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2120,12 +2823,12 @@ func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2133,7 +2836,7 @@ func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2143,20 +2846,20 @@ func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2170,7 +2873,7 @@ func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2180,20 +2883,27 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2203,7 +2913,123 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -2256,6 +3082,9 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	_ = l
 	l = m.ExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Derived {
+		n += 2
+	}
 	return n
 }
 
@@ -2280,6 +3109,36 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDerivedPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDerivedPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DerivedPairs) > 0 {
+		for _, e := range m.DerivedPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2382,6 +3241,83 @@ func (m *QueryMissCounterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentSlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentSlashWindow))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPerformanceLeaderboardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SlashWindow))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPerformanceLeaderboardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentSlashWindow != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentSlashWindow))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryAggregatePrevoteRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2651,6 +3587,26 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Derived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2785,6 +3741,42 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.DerivedPairs = append(m.DerivedPairs, v)
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2806,7 +3798,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2829,10 +3821,10 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2856,7 +3848,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDerivedPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,17 +3871,17 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDerivedPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDerivedPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivedPairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2899,7 +3891,141 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivedPairs = append(m.DerivedPairs, DerivedPair{})
+			if err := m.DerivedPairs[len(m.DerivedPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3393,6 +4519,507 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, ValidatorPerformanceStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlashWindow", wireType)
+			}
+			m.CurrentSlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerformanceLeaderboardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerformanceLeaderboardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerformanceLeaderboardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPerformanceLeaderboardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPerformanceLeaderboardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPerformanceLeaderboardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, ValidatorPerformanceStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSlashWindow", wireType)
+			}
+			m.CurrentSlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,8 +6,8 @@ package types
 import (
 	fmt "fmt"
 	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -75,35 +75,214 @@ func (m *PriceSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// DerivedPair is a pair whose price is computed from the prices of voted
+// pairs rather than voted on: the inverse of a voted pair, or the cross rate
+// of two pairs voted in the same intermediate denom.
+type DerivedPair struct {
+	// pair is the derived pair, e.g. "ubtc:unibi".
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// intermediate_denom is the quote denom of the voted pairs of the base and
+	// quote denoms of the pair, e.g. "uusd" for a price of "ubtc:unibi" computed
+	// as the price of "ubtc:uusd" over the price of "unibi:uusd". Empty for the
+	// inverse of a voted pair.
+	IntermediateDenom string `protobuf:"bytes,2,opt,name=intermediate_denom,json=intermediateDenom,proto3" json:"intermediate_denom,omitempty"`
+}
+
+func (m *DerivedPair) Reset()         { *m = DerivedPair{} }
+func (m *DerivedPair) String() string { return proto.CompactTextString(m) }
+func (*DerivedPair) ProtoMessage()    {}
+func (*DerivedPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8840885873256d8c, []int{1}
+}
+func (m *DerivedPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivedPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivedPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivedPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivedPair.Merge(m, src)
+}
+func (m *DerivedPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivedPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivedPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivedPair proto.InternalMessageInfo
+
+func (m *DerivedPair) GetIntermediateDenom() string {
+	if m != nil {
+		return m.IntermediateDenom
+	}
+	return ""
+}
+
+// ValidatorPerformanceStats are the oracle voting statistics of a validator
+// over a slash window.
+type ValidatorPerformanceStats struct {
+	// validator is the operator address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// slash_window is the index of the slash window, i.e. the block height
+	// divided by the slash window parameter.
+	SlashWindow uint64 `protobuf:"varint,2,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty"`
+	// win_count is the number of pair votes, abstaining votes included, within
+	// the reward band of the tallied exchange rates.
+	WinCount uint64 `protobuf:"varint,3,opt,name=win_count,json=winCount,proto3" json:"win_count,omitempty"`
+	// miss_count is the number of vote periods in which the validator did not
+	// vote validly on every whitelisted pair.
+	MissCount uint64 `protobuf:"varint,4,opt,name=miss_count,json=missCount,proto3" json:"miss_count,omitempty"`
+	// reward_weight is the sum of the reward weights of the validator, in units
+	// of consensus power, over the vote periods.
+	RewardWeight int64 `protobuf:"varint,5,opt,name=reward_weight,json=rewardWeight,proto3" json:"reward_weight,omitempty"`
+	// vote_count is the number of non-abstaining pair votes counted in
+	// average_deviation.
+	VoteCount uint64 `protobuf:"varint,6,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	// average_deviation is the average relative deviation of the votes from the
+	// tallied exchange rates.
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation"`
+	// rewards are the oracle rewards earned by the validator.
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *ValidatorPerformanceStats) Reset()         { *m = ValidatorPerformanceStats{} }
+func (m *ValidatorPerformanceStats) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceStats) ProtoMessage()    {}
+func (*ValidatorPerformanceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8840885873256d8c, []int{2}
+}
+func (m *ValidatorPerformanceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceStats.Merge(m, src)
+}
+func (m *ValidatorPerformanceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceStats proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceStats) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorPerformanceStats) GetSlashWindow() uint64 {
+	if m != nil {
+		return m.SlashWindow
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceStats) GetWinCount() uint64 {
+	if m != nil {
+		return m.WinCount
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceStats) GetMissCount() uint64 {
+	if m != nil {
+		return m.MissCount
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceStats) GetRewardWeight() int64 {
+	if m != nil {
+		return m.RewardWeight
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceStats) GetVoteCount() uint64 {
+	if m != nil {
+		return m.VoteCount
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceStats) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1.PriceSnapshot")
+	proto.RegisterType((*DerivedPair)(nil), "nibiru.oracle.v1.DerivedPair")
+	proto.RegisterType((*ValidatorPerformanceStats)(nil), "nibiru.oracle.v1.ValidatorPerformanceStats")
 }
 
 func init() { proto.RegisterFile("oracle/v1/state.proto", fileDescriptor_8840885873256d8c) }
 
 var fileDescriptor_8840885873256d8c = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xc1, 0x4a, 0x33, 0x31,
-	0x10, 0xc7, 0x77, 0xbf, 0x7e, 0x0a, 0x6e, 0x15, 0x64, 0x51, 0x29, 0x45, 0xd2, 0xda, 0x83, 0xf4,
-	0xa0, 0x09, 0x8b, 0x37, 0x8f, 0xb5, 0x88, 0x17, 0xa5, 0xd4, 0x9b, 0x08, 0x32, 0x1b, 0xc3, 0x36,
-	0xd8, 0x64, 0xc2, 0x26, 0x2d, 0xf6, 0x2d, 0x7c, 0xac, 0x1e, 0x7b, 0x12, 0xf1, 0x50, 0xa4, 0x7d,
-	0x03, 0x9f, 0x40, 0x76, 0xb3, 0xa8, 0x37, 0x4f, 0x09, 0xf3, 0x4b, 0x7e, 0xf3, 0x9f, 0x89, 0xf6,
-	0x31, 0x07, 0x3e, 0x16, 0x6c, 0x9a, 0x30, 0xeb, 0xc0, 0x09, 0x6a, 0x72, 0x74, 0x18, 0xef, 0x6a,
-	0x99, 0xca, 0x7c, 0x42, 0x3d, 0xa5, 0xd3, 0xa4, 0xb9, 0x97, 0x61, 0x86, 0x25, 0x64, 0xc5, 0xcd,
-	0xbf, 0x6b, 0x1e, 0x66, 0x88, 0xd9, 0x58, 0x30, 0x30, 0x92, 0x81, 0xd6, 0xe8, 0xc0, 0x49, 0xd4,
-	0xb6, 0xa2, 0x07, 0x3f, 0xf2, 0x4a, 0xe4, 0xeb, 0x84, 0xa3, 0x55, 0x68, 0x59, 0x0a, 0xb6, 0x80,
-	0xa9, 0x70, 0x90, 0x30, 0x8e, 0x52, 0x7b, 0xde, 0x79, 0x0d, 0xa3, 0x9d, 0x41, 0x2e, 0xb9, 0xb8,
-	0xd5, 0x60, 0xec, 0x08, 0x5d, 0x7c, 0x1f, 0xfd, 0x37, 0x20, 0xf3, 0x46, 0xd8, 0x0e, 0xbb, 0x5b,
-	0xbd, 0xab, 0xf9, 0xb2, 0x15, 0xbc, 0x2f, 0x5b, 0x49, 0x26, 0xdd, 0x68, 0x92, 0x52, 0x8e, 0x8a,
-	0xdd, 0x94, 0x81, 0x2f, 0x46, 0x20, 0x35, 0xf3, 0xe1, 0xd9, 0x33, 0xe3, 0xa8, 0x14, 0x6a, 0x06,
-	0xd6, 0x0a, 0x47, 0x07, 0x20, 0xf3, 0xcf, 0x65, 0xab, 0x3e, 0x03, 0x35, 0x3e, 0xef, 0x14, 0xba,
-	0xce, 0xb0, 0xb4, 0xc6, 0xfd, 0x68, 0xc3, 0x14, 0xed, 0x1a, 0xff, 0x4a, 0x3d, 0xad, 0xf4, 0xc7,
-	0xbf, 0xf4, 0x55, 0x62, 0x7f, 0x9c, 0xda, 0xc7, 0x27, 0xe6, 0x66, 0x46, 0x58, 0xda, 0x17, 0x7c,
-	0xe8, 0x3f, 0xc7, 0x47, 0xd1, 0xb6, 0x93, 0x4a, 0x58, 0x07, 0xca, 0x3c, 0x28, 0xdb, 0xa8, 0xb5,
-	0xc3, 0x6e, 0x6d, 0x58, 0xff, 0xae, 0x5d, 0xdb, 0xde, 0xe5, 0x7c, 0x45, 0xc2, 0xc5, 0x8a, 0x84,
-	0x1f, 0x2b, 0x12, 0xbe, 0xac, 0x49, 0xb0, 0x58, 0x93, 0xe0, 0x6d, 0x4d, 0x82, 0xbb, 0x93, 0xbf,
-	0x46, 0xa9, 0x56, 0x59, 0x76, 0x4d, 0x37, 0xcb, 0x3d, 0x9d, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x59, 0x7f, 0x0f, 0x73, 0xbe, 0x01, 0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xda, 0xfd, 0xa9, 0xbb, 0x49, 0x5b, 0x04, 0x28, 0x1b, 0x23, 0x1d, 0x45, 0x42,
+	0x3d, 0xb0, 0x98, 0xc2, 0x09, 0x8e, 0x5b, 0x85, 0xb8, 0x0c, 0x55, 0x99, 0xc4, 0x24, 0x40, 0xaa,
+	0xdc, 0xc4, 0xa4, 0x16, 0xb5, 0xdf, 0xc8, 0x76, 0x13, 0xf6, 0x19, 0xb8, 0xf0, 0x11, 0x38, 0xf3,
+	0x49, 0x76, 0xdc, 0x09, 0x21, 0x0e, 0x05, 0xb5, 0xdf, 0x80, 0x4f, 0x80, 0x6c, 0xa7, 0x6c, 0x9c,
+	0x40, 0x3b, 0xb5, 0x79, 0x9e, 0xf8, 0xf7, 0x3e, 0xef, 0x9b, 0xd7, 0xe8, 0x16, 0x48, 0x92, 0x4c,
+	0x28, 0x2e, 0x7a, 0x58, 0x69, 0xa2, 0x69, 0x94, 0x4b, 0xd0, 0xe0, 0x6f, 0x09, 0x36, 0x62, 0x72,
+	0x1a, 0x39, 0x37, 0x2a, 0x7a, 0xbb, 0x37, 0x33, 0xc8, 0xc0, 0x9a, 0xd8, 0xfc, 0x73, 0xef, 0xed,
+	0xee, 0x65, 0x00, 0xd9, 0x84, 0x62, 0x92, 0x33, 0x4c, 0x84, 0x00, 0x4d, 0x34, 0x03, 0xa1, 0x2a,
+	0xf7, 0xf6, 0x25, 0xbc, 0x02, 0x39, 0x3d, 0x4c, 0x40, 0x71, 0x50, 0x78, 0x44, 0x94, 0x31, 0x47,
+	0x54, 0x93, 0x1e, 0x4e, 0x80, 0x09, 0xe7, 0x77, 0xbe, 0x7a, 0x68, 0x73, 0x20, 0x59, 0x42, 0x4f,
+	0x04, 0xc9, 0xd5, 0x18, 0xb4, 0xff, 0x16, 0x35, 0x72, 0xc2, 0x64, 0xe0, 0xed, 0x7b, 0xdd, 0xe6,
+	0xe1, 0x8b, 0xf3, 0x59, 0xbb, 0xf6, 0x7d, 0xd6, 0xee, 0x65, 0x4c, 0x8f, 0xa7, 0xa3, 0x28, 0x01,
+	0x8e, 0x5f, 0xda, 0xc0, 0x47, 0x63, 0xc2, 0x04, 0x76, 0xe1, 0xf1, 0x07, 0x9c, 0x00, 0xe7, 0x20,
+	0x30, 0x51, 0x8a, 0xea, 0x68, 0x40, 0x98, 0xfc, 0x35, 0x6b, 0xb7, 0xce, 0x08, 0x9f, 0x3c, 0xeb,
+	0x18, 0x5c, 0x27, 0xb6, 0x54, 0xbf, 0x8f, 0x56, 0x72, 0x53, 0x2e, 0xb8, 0x61, 0xf1, 0x51, 0x85,
+	0x7f, 0x70, 0x05, 0x5f, 0x25, 0x76, 0x3f, 0x07, 0x2a, 0x7d, 0x8f, 0xf5, 0x59, 0x4e, 0x55, 0xd4,
+	0xa7, 0x49, 0xec, 0x0e, 0xfb, 0xf7, 0xd0, 0x86, 0x66, 0x9c, 0x2a, 0x4d, 0x78, 0x3e, 0xe4, 0x2a,
+	0xa8, 0xef, 0x7b, 0xdd, 0x7a, 0xdc, 0xfa, 0xa3, 0x1d, 0xab, 0xce, 0x47, 0x0f, 0xb5, 0xfa, 0x54,
+	0xb2, 0x82, 0xa6, 0x26, 0x8d, 0x7f, 0xfc, 0x57, 0x5b, 0x4f, 0xaf, 0xdd, 0x56, 0xd5, 0xc7, 0x01,
+	0xf2, 0x99, 0xd0, 0x54, 0x72, 0x9a, 0x32, 0xa2, 0xe9, 0x30, 0xa5, 0x02, 0xb8, 0x6b, 0x2a, 0xde,
+	0xbe, 0xea, 0xf4, 0x8d, 0xd1, 0xf9, 0x5c, 0x47, 0x3b, 0xaf, 0xc8, 0x84, 0xa5, 0x44, 0x83, 0x1c,
+	0x50, 0xf9, 0x0e, 0x24, 0x27, 0x22, 0xa1, 0x27, 0x9a, 0x68, 0xe5, 0xef, 0xa1, 0x66, 0xb1, 0x34,
+	0x5d, 0xc0, 0xf8, 0x52, 0x30, 0xcd, 0xaa, 0x09, 0x51, 0xe3, 0x61, 0xc9, 0x44, 0x0a, 0xa5, 0x2d,
+	0xd2, 0x88, 0x5b, 0x56, 0x3b, 0xb5, 0x92, 0x7f, 0x07, 0x35, 0x4b, 0x26, 0x86, 0x09, 0x4c, 0x85,
+	0xb6, 0xc3, 0x68, 0xc4, 0xeb, 0x25, 0x13, 0x47, 0xe6, 0xd9, 0xbf, 0x8b, 0x10, 0x67, 0x4a, 0x55,
+	0x6e, 0xc3, 0xba, 0x4d, 0xa3, 0x38, 0xfb, 0x3e, 0xda, 0x94, 0xb4, 0x24, 0x32, 0x1d, 0x96, 0x94,
+	0x65, 0x63, 0x1d, 0xac, 0xd8, 0x61, 0x6e, 0x38, 0xf1, 0xd4, 0x6a, 0x86, 0x51, 0x80, 0xa6, 0x15,
+	0x63, 0xd5, 0x31, 0x8c, 0xe2, 0x18, 0x6f, 0xd0, 0x36, 0x29, 0xa8, 0x24, 0x99, 0x19, 0x44, 0xc1,
+	0xec, 0x66, 0x06, 0x6b, 0xd7, 0xfa, 0xc2, 0x5b, 0x15, 0xa8, 0xbf, 0xe4, 0xf8, 0x14, 0xad, 0xb9,
+	0x2c, 0x2a, 0x58, 0xdf, 0xaf, 0x77, 0x5b, 0x8f, 0x77, 0x22, 0x77, 0x32, 0x32, 0x4b, 0x1d, 0x55,
+	0x4b, 0x1d, 0x1d, 0x01, 0x13, 0x87, 0x8f, 0x4c, 0xb5, 0x2f, 0x3f, 0xda, 0xdd, 0xff, 0xa8, 0x66,
+	0x0e, 0xa8, 0x78, 0xc9, 0x3e, 0x7c, 0x7e, 0x3e, 0x0f, 0xbd, 0x8b, 0x79, 0xe8, 0xfd, 0x9c, 0x87,
+	0xde, 0xa7, 0x45, 0x58, 0xbb, 0x58, 0x84, 0xb5, 0x6f, 0x8b, 0xb0, 0xf6, 0xfa, 0xe1, 0xbf, 0x96,
+	0xa4, 0xba, 0x7b, 0x16, 0x3b, 0x5a, 0xb5, 0x17, 0xeb, 0xc9, 0xef, 0x01, 0x00, 0x2d, 0xcb, 0xd1,
+	0xdd, 0xef, 0x03, 0x00, 0x00,
 }
 
 func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
@@ -154,6 +333,125 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DerivedPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivedPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivedPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntermediateDenom) > 0 {
+		i -= len(m.IntermediateDenom)
+		copy(dAtA[i:], m.IntermediateDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.IntermediateDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.VoteCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VoteCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RewardWeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.RewardWeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MissCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WinCount != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.WinCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SlashWindow != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -181,6 +479,57 @@ func (m *PriceSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *DerivedPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.IntermediateDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.SlashWindow != 0 {
+		n += 1 + sovState(uint64(m.SlashWindow))
+	}
+	if m.WinCount != 0 {
+		n += 1 + sovState(uint64(m.WinCount))
+	}
+	if m.MissCount != 0 {
+		n += 1 + sovState(uint64(m.MissCount))
+	}
+	if m.RewardWeight != 0 {
+		n += 1 + sovState(uint64(m.RewardWeight))
+	}
+	if m.VoteCount != 0 {
+		n += 1 + sovState(uint64(m.VoteCount))
+	}
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovState(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -324,6 +673,367 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DerivedPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivedPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivedPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediateDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinCount", wireType)
+			}
+			m.WinCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCount", wireType)
+			}
+			m.MissCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			m.RewardWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteCount", wireType)
+			}
+			m.VoteCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,6 +10,7 @@ import (
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/perp/amm/types"
 )
@@ -269,6 +270,28 @@ func (k Keeper) GetLastSnapshot(ctx sdk.Context, pool types.Market) (types.Reser
 	return latestSnapshot, nil
 }
 
+/*
+PruneReserveSnapshots deletes the reserve snapshots taken more than retention ago,
+except the latest one of each market, up to limit snapshots across all markets.
+
+args:
+  - ctx: the cosmos-sdk context
+  - retention: how long a snapshot is kept for
+  - limit: the maximum number of snapshots to delete
+
+ret:
+  - pruned: the number of snapshots deleted
+*/
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context, retention time.Duration, limit uint64) (pruned uint64) {
+	for _, pair := range k.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if pruned >= limit {
+			break
+		}
+		pruned += common.PruneSnapshots(ctx, k.ReserveSnapshots, pair, ctx.BlockTime().Add(-retention), limit-pruned)
+	}
+	return pruned
+}
+
 /*
 IsOverSpreadLimit compares the current spot price of the market (given by pair) to the underlying's index price (given by an oracle).
 It panics if you provide it with a pair that doesn't exist in the state.
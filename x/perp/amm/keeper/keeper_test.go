@@ -715,3 +715,52 @@ func TestGetMaintenanceMarginRatio(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneReserveSnapshots(t *testing.T) {
+	perpammKeeper, ctx := PerpAmmKeeper(t,
+		mock.NewMockOracleKeeper(gomock.NewController(t)),
+	)
+	btc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	eth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	for _, pair := range []asset.Pair{btc, eth} {
+		require.NoError(t, perpammKeeper.CreatePool(
+			ctx,
+			pair,
+			sdk.NewDec(5*common.TO_MICRO),
+			sdk.NewDec(5*common.TO_MICRO),
+			types.MarketConfig{
+				TradeLimitRatio:        sdk.OneDec(),
+				FluctuationLimitRatio:  sdk.OneDec(),
+				MaxOracleSpreadRatio:   sdk.OneDec(),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			},
+			sdk.OneDec(),
+		))
+	}
+
+	// a snapshot of each pool every minute for 10 minutes, on top of the one
+	// taken when the pool was created
+	start := time.UnixMilli(1_700_000_000_000)
+	for i := 0; i <= 10; i++ {
+		snapshotTime := start.Add(time.Duration(i) * time.Minute)
+		for _, pair := range []asset.Pair{btc, eth} {
+			perpammKeeper.ReserveSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), types.NewReserveSnapshot(
+				pair, sdk.NewDec(5*common.TO_MICRO), sdk.NewDec(5*common.TO_MICRO), sdk.OneDec(), snapshotTime,
+			))
+		}
+	}
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Minute))
+
+	require.Zero(t, perpammKeeper.PruneReserveSnapshots(ctx, 5*time.Minute, 0))
+	require.EqualValues(t, 6, perpammKeeper.PruneReserveSnapshots(ctx, 5*time.Minute, 6))
+	require.EqualValues(t, 4, perpammKeeper.PruneReserveSnapshots(ctx, 5*time.Minute, 6))
+	require.Zero(t, perpammKeeper.PruneReserveSnapshots(ctx, 5*time.Minute, 6))
+
+	// the snapshots of minutes 4 to 10 are left, the one of minute 4 starts the TWAP window
+	for _, pair := range []asset.Pair{btc, eth} {
+		snapshots := perpammKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Values()
+		require.Len(t, snapshots, 7)
+		require.Equal(t, start.Add(4*time.Minute).UnixMilli(), snapshots[0].TimestampMs)
+	}
+}
@@ -13,11 +13,15 @@ import (
 	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
 )
 
+// CommandName is the name of the root tx and query commands of perp v1, which
+// moved aside for perp v2 to take over `nibid tx perp` and `nibid query perp`.
+const CommandName = "perp-v1"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	// Group stablecoin queries under a subcommand
 	moduleQueryCmd := &cobra.Command{
-		Use: types.ModuleName,
+		Use: CommandName,
 		Short: fmt.Sprintf(
 			"Querying commands for the %s module", types.ModuleName),
 		DisableFlagParsing:         true,
@@ -18,7 +18,7 @@ import (
 
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
-		Use:                        types.ModuleName,
+		Use:                        CommandName,
 		Short:                      "Generalized automated market maker transaction subcommands",
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
@@ -43,7 +43,7 @@ func MultiLiquidateCmd() *cobra.Command {
 		Short: "liquidates multiple positions at once",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-			$ %s tx perp multi-liquidate ubtc:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl ueth:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl
+			$ %s tx perp-v1 multi-liquidate ubtc:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl ueth:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl
 			`, version.AppName),
 		),
 		Args: cobra.MinimumNArgs(1),
@@ -195,7 +195,7 @@ func RemoveMarginCmd() *cobra.Command {
 		Short: "Removes margin from a position, decreasing its margin ratio",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-			$ %s tx perp remove-margin osmo:nusd 100nusd
+			$ %s tx perp-v1 remove-margin osmo:nusd 100nusd
 			`, version.AppName),
 		),
 		Args: cobra.ExactArgs(2),
@@ -239,7 +239,7 @@ func AddMarginCmd() *cobra.Command {
 		Short: "Adds margin to a position, increasing its margin ratio",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-			$ %s tx perp add-margin osmo:nusd 100nusd
+			$ %s tx perp-v1 add-margin osmo:nusd 100nusd
 			`, version.AppName),
 		),
 		Args: cobra.ExactArgs(2),
@@ -283,7 +283,7 @@ func DonateToEcosystemFundCmd() *cobra.Command {
 		Short: "Donates <amount> of coins to the Ecosystem Fund.",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
-			$ %s tx perp donate-ef 100unusd
+			$ %s tx perp-v1 donate-ef 100unusd
 			`, version.AppName),
 		),
 		Args: cobra.ExactArgs(1),
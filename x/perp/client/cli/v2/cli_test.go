@@ -0,0 +1,304 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	"github.com/stretchr/testify/suite"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	testutilcli "github.com/NibiruChain/nibiru/x/common/testutil/cli"
+	"github.com/NibiruChain/nibiru/x/common/testutil/genesis"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	cli "github.com/NibiruChain/nibiru/x/perp/client/cli/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     testutilcli.Config
+	network *testutilcli.Network
+	users   []sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test suite")
+	}
+
+	s.T().Log("setting up integration test suite")
+
+	app.SetPrefixes(app.AccountAddressPrefix)
+	encodingConfig := app.MakeTestEncodingConfig()
+	genesisState := genesis.NewTestGenesisState()
+
+	// setup perp v2 markets
+	perpGenesis := types.DefaultGenesis()
+	for _, pair := range []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+		asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	} {
+		market := mock.TestMarket()
+		market.Pair = pair
+		perpGenesis.Markets = append(perpGenesis.Markets, *market)
+
+		amm := mock.TestAMM(sdk.NewDec(10*common.TO_MICRO), sdk.NewDec(6_000))
+		amm.Pair = pair
+		perpGenesis.Amms = append(perpGenesis.Amms, *amm)
+		perpGenesis.ReserveSnapshots = append(perpGenesis.ReserveSnapshots, types.ReserveSnapshot{
+			Amm:         *amm,
+			TimestampMs: time.Now().UnixMilli(),
+		})
+	}
+	genesisState[types.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(perpGenesis)
+
+	oracleGenesis := oracletypes.DefaultGenesisState()
+	oracleGenesis.Params.Whitelist = []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+		asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	}
+	oracleGenesis.Params.VotePeriod = 1_000
+	oracleGenesis.ExchangeRates = []oracletypes.ExchangeRateTuple{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD), ExchangeRate: sdk.NewDec(6_000)},
+		{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), ExchangeRate: sdk.NewDec(6_000)},
+		{Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD), ExchangeRate: sdk.NewDec(6_000)},
+	}
+	genesisState[oracletypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(oracleGenesis)
+
+	s.cfg = testutilcli.BuildNetworkConfig(genesisState)
+	s.cfg.NumValidators = 1
+	s.network = testutilcli.NewNetwork(s.T(), s.cfg)
+	s.NoError(s.network.WaitForNextBlock())
+
+	val := s.network.Validators[0]
+
+	for i := 0; i < 4; i++ {
+		newUser := testutilcli.NewAccount(s.network, fmt.Sprintf("user%d", i))
+		s.users = append(s.users, newUser)
+		s.NoError(
+			testutilcli.FillWalletFromValidator(newUser,
+				sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.NIBI, 10*common.TO_MICRO),
+					sdk.NewInt64Coin(denoms.NUSD, 5e3*common.TO_MICRO),
+				),
+				val,
+				denoms.NIBI,
+			),
+		)
+	}
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) queryPosition(pair asset.Pair, trader sdk.AccAddress) (*types.QueryPositionResponse, error) {
+	var queryResp types.QueryPositionResponse
+	if err := testutilcli.ExecQuery(
+		s.network.Validators[0].ClientCtx,
+		cli.CmdQueryPosition(),
+		[]string{trader.String(), pair.String()},
+		&queryResp,
+	); err != nil {
+		return nil, err
+	}
+	return &queryResp, nil
+}
+
+// user[0] opens a long position, adds and removes margin, and closes it
+func (s *IntegrationTestSuite) TestOpenPositionAndMarginCmds() {
+	user := s.users[0]
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	s.T().Log("A. check trader has no existing positions")
+	_, err := s.queryPosition(pair, user)
+	s.Error(err)
+
+	s.T().Log("B. open position")
+	txResp, err := testutilcli.ExecTx(s.network, cli.OpenPositionCmd(), user, []string{
+		"buy",
+		pair.String(),
+		/* leverage */ "2",
+		/* quoteAmt */ "1000000", // 10^6 uNUSD
+		/* baseAssetLimit */ "0",
+	})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	s.T().Log("B. check trader position")
+	queryResp, err := s.queryPosition(pair, user)
+	s.Require().NoError(err)
+	s.EqualValues(user.String(), queryResp.Position.TraderAddress)
+	s.EqualValues(pair, queryResp.Position.Pair)
+	s.EqualValues(sdk.NewDec(1*common.TO_MICRO), queryResp.Position.Margin)
+	s.EqualValues(sdk.NewDec(2*common.TO_MICRO), queryResp.Position.OpenNotional)
+	s.True(queryResp.Position.Size_.IsPositive())
+
+	s.T().Log("C. add margin")
+	txResp, err = testutilcli.ExecTx(s.network, cli.AddMarginCmd(), user, []string{
+		pair.String(),
+		fmt.Sprintf("10000%s", denoms.NUSD),
+	})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	queryResp, err = s.queryPosition(pair, user)
+	s.Require().NoError(err)
+	s.EqualValues(sdk.NewDec(1_010_000), queryResp.Position.Margin)
+
+	s.T().Log("D. remove margin")
+	txResp, err = testutilcli.ExecTx(s.network, cli.RemoveMarginCmd(), user, []string{
+		pair.String(),
+		fmt.Sprintf("10000%s", denoms.NUSD),
+	})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	queryResp, err = s.queryPosition(pair, user)
+	s.Require().NoError(err)
+	s.EqualValues(sdk.NewDec(1*common.TO_MICRO), queryResp.Position.Margin)
+
+	s.T().Log("E. query all positions of the trader")
+	var positionsResp types.QueryPositionsResponse
+	s.Require().NoError(testutilcli.ExecQuery(
+		s.network.Validators[0].ClientCtx,
+		cli.CmdQueryPositions(),
+		[]string{user.String()},
+		&positionsResp,
+	))
+	s.Len(positionsResp.Positions, 1)
+
+	s.T().Log("F. close position")
+	txResp, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{
+		pair.String(),
+	})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	_, err = s.queryPosition(pair, user)
+	s.Error(err)
+}
+
+func (s *IntegrationTestSuite) TestPositionEmptyAndClose() {
+	user := s.users[1]
+	pair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	_, err := s.queryPosition(pair, user)
+	s.Error(err, "no position found")
+
+	_, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{
+		pair.String(),
+	})
+	s.Contains(err.Error(), collections.ErrNotFound.Error())
+}
+
+func (s *IntegrationTestSuite) TestMultiLiquidate() {
+	pair := asset.Registry.Pair(denoms.ATOM, denoms.NUSD)
+
+	s.T().Log("opening a healthy position")
+	_, err := testutilcli.ExecTx(s.network, cli.OpenPositionCmd(), s.users[2], []string{
+		"buy",
+		pair.String(),
+		"1",       // Leverage
+		"1000000", // Quote asset amount
+		"0",       // Base asset limit
+	})
+	s.Require().NoError(err)
+
+	s.T().Log("liquidating a healthy position fails")
+	txResp, err := testutilcli.ExecTx(s.network, cli.MultiLiquidateCmd(), s.users[3], []string{
+		fmt.Sprintf("%s:%s", pair, s.users[2]),
+	}, testutilcli.WithTxCanFail(true))
+	s.Require().NoError(err)
+	s.NotEqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	s.T().Log("position is still open")
+	_, err = s.queryPosition(pair, s.users[2])
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TestDonateToEcosystemFund() {
+	out, err := testutilcli.ExecTx(s.network, cli.DonateToEcosystemFundCmd(), s.users[3], []string{"100unusd"})
+	s.Require().NoError(err)
+	s.Require().EqualValues(abcitypes.CodeTypeOK, out.Code)
+
+	var moduleAccounts types.QueryModuleAccountsResponse
+	s.Require().NoError(testutilcli.ExecQuery(
+		s.network.Validators[0].ClientCtx,
+		cli.CmdQueryModuleAccounts(),
+		[]string{},
+		&moduleAccounts,
+	))
+
+	var perpEFAddress string
+	for _, acc := range moduleAccounts.Accounts {
+		if acc.Name == types.PerpEFModuleAccount {
+			perpEFAddress = acc.Address
+		}
+	}
+	s.Require().NotEmpty(perpEFAddress)
+
+	resp := new(sdk.Coin)
+	s.Require().NoError(
+		testutilcli.ExecQuery(
+			s.network.Validators[0].ClientCtx,
+			bankcli.GetBalancesCmd(),
+			[]string{perpEFAddress, "--denom", denoms.NUSD},
+			resp,
+		),
+	)
+	s.True(resp.Amount.GTE(sdk.NewInt(100)))
+}
+
+func (s *IntegrationTestSuite) TestQueryParams() {
+	var resp types.QueryParamsResponse
+	s.Require().NoError(testutilcli.ExecQuery(
+		s.network.Validators[0].ClientCtx,
+		cli.CmdQueryParams(),
+		[]string{},
+		&resp,
+	))
+}
+
+func (s *IntegrationTestSuite) TestQueryMarketsAndAMMs() {
+	clientCtx := s.network.Validators[0].ClientCtx
+	pair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	var marketsResp types.QueryMarketsResponse
+	s.Require().NoError(testutilcli.ExecQuery(clientCtx, cli.CmdQueryMarkets(), []string{}, &marketsResp))
+	s.Len(marketsResp.Markets, 3)
+
+	var marketResp types.QueryMarketResponse
+	s.Require().NoError(testutilcli.ExecQuery(clientCtx, cli.CmdQueryMarket(), []string{pair.String()}, &marketResp))
+	s.Equal(pair, marketResp.Market.Pair)
+
+	var ammsResp types.QueryAMMsResponse
+	s.Require().NoError(testutilcli.ExecQuery(clientCtx, cli.CmdQueryAMMs(), []string{"--limit", "2"}, &ammsResp))
+	s.Len(ammsResp.Amms, 2)
+
+	var ammResp types.QueryAMMResponse
+	s.Require().NoError(testutilcli.ExecQuery(clientCtx, cli.CmdQueryAMM(), []string{pair.String()}, &ammResp))
+	s.Equal(pair, ammResp.Amm.Amm.Pair)
+	s.Equal(sdk.NewDec(6_000), ammResp.Amm.MarkPrice)
+
+	var snapshotsResp types.QueryReserveSnapshotsResponse
+	s.Require().NoError(testutilcli.ExecQuery(clientCtx, cli.CmdQueryReserveSnapshots(), []string{pair.String(), "0", "0", "1"}, &snapshotsResp))
+	s.Len(snapshotsResp.ReserveSnapshots, 1)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,758 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// CommandName is the name of the root tx and query commands of perp v2, which
+// replaces perp v1 under `nibid tx perp` and `nibid query perp`.
+const CommandName = "perp"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	moduleQueryCmd := &cobra.Command{
+		Use: CommandName,
+		Short: fmt.Sprintf(
+			"Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmds := []*cobra.Command{
+		CmdQueryParams(),
+		CmdQueryPosition(),
+		CmdQueryPositions(),
+		CmdQueryModuleAccounts(),
+		CmdQueryMarkets(),
+		CmdQueryMarket(),
+		CmdQueryAMMs(),
+		CmdQueryAMM(),
+		CmdQueryReserveSnapshots(),
+		CmdQueryOrders(),
+		CmdQueryOrder(),
+		CmdQueryCrossMarginAccount(),
+		CmdQueryInsuranceFund(),
+		CmdQueryInsuranceFundWithdrawals(),
+		CmdQueryReferralCode(),
+		CmdQueryReferralEarnings(),
+		CmdQueryADLRank(),
+		CmdQueryFundingRates(),
+		CmdQueryCollateralAssets(),
+	}
+	for _, cmd := range cmds {
+		moduleQueryCmd.AddCommand(cmd)
+	}
+
+	return moduleQueryCmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "shows the parameters of the x/perp v2 module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(
+				cmd.Context(), &types.QueryParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [trader] [token-pair]",
+		Short: "trader's position for a given token pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryPosition(
+				cmd.Context(), &types.QueryPositionRequest{
+					Trader: trader.String(),
+					Pair:   pair,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [trader]",
+		Short: "return all of a trader's open positions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			res, err := queryClient.QueryPositions(
+				cmd.Context(), &types.QueryPositionsRequest{
+					Trader: trader.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryModuleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "shows all the module accounts in the blockchain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &types.QueryModuleAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets",
+		Short: "shows all the markets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Markets(
+				cmd.Context(), &types.QueryMarketsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "markets")
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryMarket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market [token-pair]",
+		Short: "shows the market of a given token pair",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Market(
+				cmd.Context(), &types.QueryMarketRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAMMs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amms",
+		Short: "shows all the amms with their mark price, bias and market value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AMMs(
+				cmd.Context(), &types.QueryAMMsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "amms")
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryAMM() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amm [token-pair]",
+		Short: "shows the amm of a given token pair with its mark price, bias and market value",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AMM(
+				cmd.Context(), &types.QueryAMMRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryReserveSnapshots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-snapshots [token-pair] [start-time-ms] [end-time-ms] [limit]",
+		Short: "shows the reserve snapshots of an amm between two unix timestamps (ms), most recent first",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			An end time of 0 means no upper bound and a limit of 0 means no limit.
+
+			$ %s query perp reserve-snapshots ubtc:unusd 1680000000000 0 10
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTimeMs, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start time: %w", err)
+			}
+
+			endTimeMs, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end time: %w", err)
+			}
+
+			limit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid limit: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReserveSnapshots(
+				cmd.Context(), &types.QueryReserveSnapshotsRequest{
+					Pair:        pair,
+					StartTimeMs: startTimeMs,
+					EndTimeMs:   endTimeMs,
+					Limit:       limit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders [trader] [token-pair]",
+		Short: "shows the open trigger orders of a trader, optionally on a single pair",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryOrdersRequest{Trader: trader.String()}
+			if len(args) == 2 {
+				pair, err := asset.TryNewPair(args[1])
+				if err != nil {
+					return err
+				}
+				req.Pair = pair.String()
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Orders(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [order-id]",
+		Short: "shows a trigger order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Order(
+				cmd.Context(), &types.QueryOrderRequest{OrderId: orderID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCrossMarginAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-margin-account [trader]",
+		Short: "shows the cross-margin account of a trader and its margin per denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CrossMarginAccount(
+				cmd.Context(), &types.QueryCrossMarginAccountRequest{Trader: trader.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund [denom]",
+		Short: "shows the insurance fund balance, share supply and share price of a quote denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InsuranceFund(
+				cmd.Context(), &types.QueryInsuranceFundRequest{Denom: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFundWithdrawals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-withdrawals [staker]",
+		Short: "shows the pending insurance fund withdrawals of a staker",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			staker, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.InsuranceFundWithdrawals(
+				cmd.Context(), &types.QueryInsuranceFundWithdrawalsRequest{Staker: staker.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryReferralCode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-code [code]",
+		Short: "shows a referral code and its referrer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReferralCode(
+				cmd.Context(), &types.QueryReferralCodeRequest{Code: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryReferralEarnings() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-earnings [referrer]",
+		Short: "shows the unclaimed referral earnings of a referrer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			referrer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReferralEarnings(
+				cmd.Context(), &types.QueryReferralEarningsRequest{Referrer: referrer.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryADLRank() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "adl-rank [trader] [token-pair]",
+		Short: "shows the rank of a trader's position in the auto-deleveraging queue",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ADLRank(
+				cmd.Context(), &types.QueryADLRankRequest{
+					Trader: trader.String(),
+					Pair:   pair,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryFundingRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-rates [token-pair] [start-epoch] [end-epoch]",
+		Short: "shows the funding rates of a market between two funding epochs, inclusive",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			An end epoch of 0 means no upper bound.
+
+			$ %s query perp funding-rates ubtc:unusd 100 0
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			startEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start epoch: %w", err)
+			}
+
+			endEpoch, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end epoch: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FundingRates(
+				cmd.Context(), &types.QueryFundingRatesRequest{
+					Pair:       pair,
+					StartEpoch: startEpoch,
+					EndEpoch:   endEpoch,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCollateralAssets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-assets",
+		Short: "shows the assets accepted as cross-margin collateral besides the quote denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollateralAssets(
+				cmd.Context(), &types.QueryCollateralAssetsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
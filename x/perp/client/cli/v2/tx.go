@@ -0,0 +1,967 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        CommandName,
+		Short:                      fmt.Sprintf("Transaction subcommands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		RemoveMarginCmd(),
+		AddMarginCmd(),
+		OpenPositionCmd(),
+		ClosePositionCmd(),
+		PartialCloseCmd(),
+		MultiLiquidateCmd(),
+		DonateToEcosystemFundCmd(),
+		SettlePositionCmd(),
+		PlaceOrderCmd(),
+		CancelOrderCmd(),
+		ReplaceOrderCmd(),
+		EnableCrossMarginCmd(),
+		DisableCrossMarginCmd(),
+		AddCrossMarginCollateralCmd(),
+		RemoveCrossMarginCollateralCmd(),
+		DepositInsuranceFundCmd(),
+		WithdrawInsuranceFundCmd(),
+		RegisterReferralCodeCmd(),
+		SetReferrerCmd(),
+		ClaimReferralEarningsCmd(),
+	)
+
+	return txCmd
+}
+
+func MultiLiquidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-liquidate [Pair1:Trader1] [Pair2:Trader2] ...",
+		Short: "liquidates multiple positions at once",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp multi-liquidate ubtc:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl ueth:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl
+			`, version.AppName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidations := make([]*types.MsgMultiLiquidate_Liquidation, len(args))
+
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid liquidation format: %s", arg)
+				}
+
+				pair, err := asset.TryNewPair(fmt.Sprintf("%s:%s", parts[0], parts[1]))
+				if err != nil {
+					return err
+				}
+
+				traderAddr, err := sdk.AccAddressFromBech32(parts[2])
+				if err != nil {
+					return err
+				}
+
+				liquidations[i] = &types.MsgMultiLiquidate_Liquidation{
+					Pair:   pair,
+					Trader: traderAddr.String(),
+				}
+			}
+
+			msg := &types.MsgMultiLiquidate{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Liquidations: liquidations,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func OpenPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-position [buy/sell] [pair] [leverage] [quoteAmt / sdk.Dec] [baseAmtLimit / sdk.Dec]",
+		Short: "Opens a position",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var side types.Direction
+			switch args[0] {
+			case "buy":
+				side = types.Direction_LONG
+			case "sell":
+				side = types.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s", args[0])
+			}
+
+			assetPair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			leverage := sdk.MustNewDecFromStr(args[2])
+
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[3])
+			}
+
+			baseAmtLimit := sdk.MustNewDecFromStr(args[4])
+
+			reduceOnly, err := cmd.Flags().GetBool(FlagReduceOnly)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgOpenPosition{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Pair:                 assetPair,
+				Side:                 side,
+				QuoteAssetAmount:     amount,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit.RoundInt(),
+				ReduceOnly:           reduceOnly,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagReduceOnly, false, "only reduce or close the existing position, never increase or flip it")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ClosePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-position [pair]",
+		Short: "Closes a position",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClosePosition{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// PartialCloseCmd is a CLI command that closes part of a position, given an
+// amount of base assets.
+func PartialCloseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "partial-close [pair] [sizeAmt / sdk.Dec]",
+		Short: "Closes part of a position",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			sizeAmt, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			quoteAmtLimitStr, err := cmd.Flags().GetString(FlagQuoteAmountLimit)
+			if err != nil {
+				return err
+			}
+			quoteAmtLimit, ok := sdk.NewIntFromString(quoteAmtLimitStr)
+			if !ok {
+				return fmt.Errorf("invalid quote amount limit: %s", quoteAmtLimitStr)
+			}
+
+			msg := &types.MsgPartialClose{
+				Sender:                clientCtx.GetFromAddress().String(),
+				Pair:                  pair,
+				SizeAmount:            sizeAmt,
+				QuoteAssetAmountLimit: quoteAmtLimit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagQuoteAmountLimit, "0", "minimum quote amount received when reducing a long, or maximum paid when reducing a short")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// SettlePositionCmd is a CLI command that settles a position of a settled
+// market at the settlement price of the market.
+func SettlePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-position [pair]",
+		Short: "Settles a position of a settled market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSettlePosition{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+/*
+RemoveMarginCmd is a CLI command that removes margin from a position,
+realizing any outstanding funding payments and decreasing the margin ratio.
+*/
+func RemoveMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-margin [market] [margin]",
+		Short: "Removes margin from a position, decreasing its margin ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp remove-margin osmo:nusd 100nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marginToRemove, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+				Margin: marginToRemove,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AddMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-margin [market] [margin]",
+		Short: "Adds margin to a position, increasing its margin ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp add-margin osmo:nusd 100nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marginToAdd, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+				Margin: marginToAdd,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DonateToEcosystemFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "donate-ef [amount]",
+		Short: "Donates <amount> of coins to the Ecosystem Fund.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp donate-ef 100unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			donation, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDonateToEcosystemFund{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Donation: donation,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const (
+	FlagSide             = "side"
+	FlagQuoteAmount      = "quote-amount"
+	FlagLeverage         = "leverage"
+	FlagBaseAmountLimit  = "base-amount-limit"
+	FlagPriceSource      = "price-source"
+	FlagReduceOnly       = "reduce-only"
+	FlagQuoteAmountLimit = "quote-amount-limit"
+)
+
+var orderTypes = map[string]types.OrderType{
+	"limit":       types.OrderType_LIMIT,
+	"stop-market": types.OrderType_STOP_MARKET,
+	"take-profit": types.OrderType_TAKE_PROFIT,
+	"stop-loss":   types.OrderType_STOP_LOSS,
+}
+
+var priceSources = map[string]types.TriggerPriceSource{
+	"mark":  types.TriggerPriceSource_MARK_PRICE,
+	"index": types.TriggerPriceSource_INDEX_TWAP,
+}
+
+/*
+PlaceOrderCmd is a CLI command that places a trigger order, executed at the
+end of the first block where the price reaches the trigger price.
+*/
+func PlaceOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-order [limit/stop-market/take-profit/stop-loss] [pair] [trigger-price]",
+		Short: "Places a trigger order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			limit and stop-market orders open a position and need --side, --quote-amount
+			and --leverage. take-profit and stop-loss orders close the whole position and
+			escrow the closing order deposit until they are executed or cancelled.
+
+			$ %s tx perp place-order limit ubtc:unusd 20000 --side buy --quote-amount 1000 --leverage 5
+			$ %s tx perp place-order stop-loss ubtc:unusd 18000 --price-source index
+			`, version.AppName, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderType, found := orderTypes[args[0]]
+			if !found {
+				return fmt.Errorf("invalid order type: %s", args[0])
+			}
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			priceSourceStr, err := cmd.Flags().GetString(FlagPriceSource)
+			if err != nil {
+				return err
+			}
+			priceSource, found := priceSources[priceSourceStr]
+			if !found {
+				return fmt.Errorf("invalid price source: %s", priceSourceStr)
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Pair:         pair,
+				OrderType:    orderType,
+				TriggerPrice: triggerPrice,
+				PriceSource:  priceSource,
+			}
+
+			if orderType == types.OrderType_LIMIT || orderType == types.OrderType_STOP_MARKET {
+				msg.Side, msg.QuoteAssetAmount, msg.Leverage, msg.BaseAssetAmountLimit, err = openOrderFlags(cmd)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSide, "", "side of the position to open: buy or sell")
+	cmd.Flags().String(FlagQuoteAmount, "0", "margin of the position to open")
+	cmd.Flags().String(FlagLeverage, "1", "leverage of the position to open")
+	cmd.Flags().String(FlagBaseAmountLimit, "0", "minimum base amount of the position to open")
+	cmd.Flags().String(FlagPriceSource, "mark", "price the order is evaluated against: mark or index")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// openOrderFlags reads the flags describing the position opened by an order.
+func openOrderFlags(cmd *cobra.Command) (side types.Direction, quoteAmt sdk.Int, leverage sdk.Dec, baseAmtLimit sdk.Int, err error) {
+	sideStr, err := cmd.Flags().GetString(FlagSide)
+	if err != nil {
+		return
+	}
+	switch sideStr {
+	case "buy":
+		side = types.Direction_LONG
+	case "sell":
+		side = types.Direction_SHORT
+	default:
+		err = fmt.Errorf("invalid side: %s", sideStr)
+		return
+	}
+
+	quoteAmt, leverage, baseAmtLimit, err = orderAmountFlags(cmd)
+	return
+}
+
+// orderAmountFlags reads the margin, leverage and base amount limit flags.
+func orderAmountFlags(cmd *cobra.Command) (quoteAmt sdk.Int, leverage sdk.Dec, baseAmtLimit sdk.Int, err error) {
+	quoteAmtStr, err := cmd.Flags().GetString(FlagQuoteAmount)
+	if err != nil {
+		return
+	}
+	quoteAmt, ok := sdk.NewIntFromString(quoteAmtStr)
+	if !ok {
+		err = fmt.Errorf("invalid quote amount: %s", quoteAmtStr)
+		return
+	}
+
+	leverageStr, err := cmd.Flags().GetString(FlagLeverage)
+	if err != nil {
+		return
+	}
+	leverage, err = sdk.NewDecFromStr(leverageStr)
+	if err != nil {
+		err = fmt.Errorf("invalid leverage: %w", err)
+		return
+	}
+
+	baseAmtLimitStr, err := cmd.Flags().GetString(FlagBaseAmountLimit)
+	if err != nil {
+		return
+	}
+	baseAmtLimit, ok = sdk.NewIntFromString(baseAmtLimitStr)
+	if !ok {
+		err = fmt.Errorf("invalid base amount limit: %s", baseAmtLimitStr)
+	}
+	return
+}
+
+func CancelOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [order-id]",
+		Short: "Cancels a trigger order and refunds its margin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			msg := &types.MsgCancelOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				OrderId: orderID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ReplaceOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-order [order-id] [trigger-price]",
+		Short: "Changes the trigger price and the amounts of a trigger order",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid trigger price: %w", err)
+			}
+
+			quoteAmt, leverage, baseAmtLimit, err := orderAmountFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgReplaceOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				OrderId:              orderID,
+				TriggerPrice:         triggerPrice,
+				QuoteAssetAmount:     quoteAmt,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagQuoteAmount, "0", "new margin of the position to open")
+	cmd.Flags().String(FlagLeverage, "1", "new leverage of the position to open")
+	cmd.Flags().String(FlagBaseAmountLimit, "0", "new minimum base amount of the position to open")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func EnableCrossMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-cross-margin",
+		Short: "Opens a cross-margin account, sharing collateral across all your positions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgEnableCrossMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DisableCrossMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-cross-margin",
+		Short: "Closes your cross-margin account and refunds its collateral",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDisableCrossMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AddCrossMarginCollateralCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-cross-margin-collateral [collateral]",
+		Short: "Deposits collateral into your cross-margin account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp add-cross-margin-collateral 10000unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddCrossMarginCollateral{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Collateral: collateral,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RemoveCrossMarginCollateralCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-cross-margin-collateral [collateral]",
+		Short: "Withdraws collateral from your cross-margin account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp remove-cross-margin-collateral 10000unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveCrossMarginCollateral{
+				Sender:     clientCtx.GetFromAddress().String(),
+				Collateral: collateral,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DepositInsuranceFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-insurance-fund [amount]",
+		Short: "Stakes quote tokens in the insurance fund in exchange for insurance fund shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp deposit-insurance-fund 10000unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDepositInsuranceFund{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func WithdrawInsuranceFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-insurance-fund [shares]",
+		Short: "Requests the redemption of insurance fund shares, paid out once the withdrawal cooldown is over",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp withdraw-insurance-fund 10000insurance/unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			shares, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawInsuranceFund{
+				Sender: clientCtx.GetFromAddress().String(),
+				Shares: shares,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RegisterReferralCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-referral-code [code]",
+		Short: "Registers a referral code earning the sender a share of the exchange fees of the traders using it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp register-referral-code my-desk
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterReferralCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				Code:   args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SetReferrerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-referrer [code]",
+		Short: "Binds the sender to a referral code, once and for all",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp set-referrer my-desk
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetReferrer{
+				Sender: clientCtx.GetFromAddress().String(),
+				Code:   args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ClaimReferralEarningsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-referral-earnings",
+		Short: "Pays out the referral earnings of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx perp claim-referral-earnings
+			`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimReferralEarnings{
+				Sender: clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
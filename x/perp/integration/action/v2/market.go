@@ -98,6 +98,18 @@ func WithTotalShort(amount sdk.Dec) marketModifier {
 	}
 }
 
+func WithMaxFundingRate(rate sdk.Dec) marketModifier {
+	return func(market *v2types.Market, amm *v2types.AMM) {
+		market.MaxFundingRate = rate
+	}
+}
+
+func WithFundingDampener(dampener sdk.Dec) marketModifier {
+	return func(market *v2types.Market, amm *v2types.AMM) {
+		market.FundingDampener = dampener
+	}
+}
+
 func WithSqrtDepth(amount sdk.Dec) marketModifier {
 	return func(market *v2types.Market, amm *v2types.AMM) {
 		amm.SqrtDepth = amount
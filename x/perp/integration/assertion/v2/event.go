@@ -89,6 +89,14 @@ func (p positionChangedEventShouldBeEqual) Do(_ *app.NibiruApp, ctx sdk.Context)
 		if theEvent.BlockTimeMs != p.ExpectedEvent.BlockTimeMs {
 			return ctx, fmt.Errorf("expected block time ms %d, got %d", p.ExpectedEvent.BlockTimeMs, theEvent.BlockTimeMs), false
 		}
+
+		if theEvent.FeeTier != p.ExpectedEvent.FeeTier {
+			return ctx, fmt.Errorf("expected fee tier %d, got %d", p.ExpectedEvent.FeeTier, theEvent.FeeTier), false
+		}
+
+		if !theEvent.MakerRebate.Equal(p.ExpectedEvent.MakerRebate) {
+			return ctx, fmt.Errorf("expected maker rebate %s, got %s", p.ExpectedEvent.MakerRebate, theEvent.MakerRebate), false
+		}
 	}
 
 	return ctx, nil, false
@@ -3,10 +3,12 @@ package assertion
 import (
 	"fmt"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
 
@@ -48,6 +50,35 @@ func Market_LatestCPFShouldBeEqualTo(expectedCPF sdk.Dec) MarketChecker {
 	}
 }
 
+type fundingRatesShouldBeEqual struct {
+	Pair             asset.Pair
+	PremiumFractions []sdk.Dec
+}
+
+func (f fundingRatesShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	records := app.PerpKeeperV2.FundingRates.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}.Prefix(f.Pair)).Values()
+	if len(records) != len(f.PremiumFractions) {
+		return ctx, fmt.Errorf("expected %d funding rates, got %d", len(f.PremiumFractions), len(records)), false
+	}
+
+	for i, record := range records {
+		if !record.PremiumFraction.Equal(f.PremiumFractions[i]) {
+			return ctx, fmt.Errorf("expected premium fraction %s on epoch %d, got %s", f.PremiumFractions[i], record.Epoch, record.PremiumFraction), false
+		}
+	}
+
+	return ctx, nil, false
+}
+
+// FundingRatesShouldBeEqual checks the premium fractions of the funding rate
+// history of the market, in epoch order.
+func FundingRatesShouldBeEqual(pair asset.Pair, premiumFractions ...sdk.Dec) action.Action {
+	return fundingRatesShouldBeEqual{
+		Pair:             pair,
+		PremiumFractions: premiumFractions,
+	}
+}
+
 func Market_PrepaidBadDebtShouldBeEqualTo(expectedAmount sdk.Int) MarketChecker {
 	return func(market v2types.Market) error {
 		expectedBadDebt := sdk.NewCoin(market.Pair.QuoteDenom(), expectedAmount)
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NibiruChain/collections"
 
@@ -85,6 +86,20 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// SnapshotRetention returns how long the perp amm reserve snapshots are kept
+// for, zero if they are not pruned, and the maximum number of them pruned in a
+// block. The pruning params take their default value until set.
+func (k Keeper) SnapshotRetention(ctx sdk.Context) (retention time.Duration, maxPrunedPerBlock uint64) {
+	retentionLookbacks, maxPrunedPerBlock := types.DefaultSnapshotRetentionLookbacks, types.DefaultMaxSnapshotsPrunedPerBlock
+	k.ParamSubspace.GetIfExists(ctx, types.KeySnapshotRetentionLookbacks, &retentionLookbacks)
+	k.ParamSubspace.GetIfExists(ctx, types.KeyMaxSnapshotsPrunedPerBlock, &maxPrunedPerBlock)
+
+	var twapLookbackWindow time.Duration
+	k.ParamSubspace.GetIfExists(ctx, types.KeyTwapLookbackWindow, &twapLookbackWindow)
+
+	return time.Duration(retentionLookbacks) * twapLookbackWindow, maxPrunedPerBlock
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.ParamSubspace.SetParamSet(ctx, &params)
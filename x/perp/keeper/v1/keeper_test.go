@@ -65,3 +65,25 @@ func TestGetAndSetParams_Errors(t *testing.T) {
 		)
 	})
 }
+
+func TestSnapshotRetention(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext(true)
+	perpKeeper := &nibiruApp.PerpKeeper
+	params := types.DefaultParams()
+	params.TwapLookbackWindow = 15 * time.Minute
+	perpKeeper.SetParams(ctx, params)
+
+	retention, maxPrunedPerBlock := perpKeeper.SnapshotRetention(ctx)
+	require.Equal(t, time.Hour, retention)
+	require.EqualValues(t, types.DefaultMaxSnapshotsPrunedPerBlock, maxPrunedPerBlock)
+
+	perpKeeper.ParamSubspace.Set(ctx, types.KeySnapshotRetentionLookbacks, uint64(2))
+	perpKeeper.ParamSubspace.Set(ctx, types.KeyMaxSnapshotsPrunedPerBlock, uint64(10))
+	retention, maxPrunedPerBlock = perpKeeper.SnapshotRetention(ctx)
+	require.Equal(t, 30*time.Minute, retention)
+	require.EqualValues(t, 10, maxPrunedPerBlock)
+
+	perpKeeper.ParamSubspace.Set(ctx, types.KeySnapshotRetentionLookbacks, uint64(0))
+	retention, _ = perpKeeper.SnapshotRetention(ctx)
+	require.Zero(t, retention)
+}
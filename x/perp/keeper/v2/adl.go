@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// adlCandidate is a profitable position of the auto-deleveraging queue.
+type adlCandidate struct {
+	position v2types.Position
+	score    sdk.Dec
+}
+
+// adlQueue returns the profitable positions of the market on the side dir, or
+// on both sides if dir is unspecified, ranked by unrealized PnL x effective
+// leverage at the mark price, highest first. The effective leverage of a
+// position is its notional over its margin, unrealized PnL and funding payment included. Ties
+// are broken by trader address.
+func (k Keeper) adlQueue(
+	ctx sdk.Context, market v2types.Market, amm v2types.AMM, dir v2types.Direction,
+) (queue []adlCandidate, err error) {
+	positions := k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(market.Pair)).Values()
+	for _, position := range positions {
+		if position.Size_.IsZero() ||
+			(dir == v2types.Direction_LONG && position.Size_.IsNegative()) ||
+			(dir == v2types.Direction_SHORT && position.Size_.IsPositive()) {
+			continue
+		}
+
+		positionNotional := position.Size_.Abs().Mul(amm.MarkPrice())
+		unrealizedPnl := UnrealizedPnl(position, positionNotional)
+		if !unrealizedPnl.IsPositive() {
+			continue
+		}
+
+		equity := position.Margin.Add(unrealizedPnl).Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+		if !equity.IsPositive() {
+			continue
+		}
+
+		queue = append(queue, adlCandidate{
+			position: position,
+			score:    unrealizedPnl.Mul(positionNotional).Quo(equity),
+		})
+	}
+
+	sort.SliceStable(queue, func(i, j int) bool {
+		if !queue[i].score.Equal(queue[j].score) {
+			return queue[i].score.GT(queue[j].score)
+		}
+		return queue[i].position.TraderAddress < queue[j].position.TraderAddress
+	})
+
+	return queue, nil
+}
+
+// autoDeleverage absorbs a loss the insurance and ecosystem funds couldn't
+// cover by reducing the profitable positions of the market on the side dir, or
+// on both sides if dir is unspecified, in the order of the ADL queue. Each
+// position is reduced at the mark price just enough for the PnL it realizes to
+// absorb the rest of the loss, and that PnL is withheld in the vault instead
+// of being paid to the trader. Settled markets are not deleveraged.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - pair: the market to deleverage
+//   - dir: the side of the positions to deleverage
+//   - loss: the loss to absorb
+//
+// returns:
+//   - uncovered: the part of the loss the queue couldn't absorb
+//   - err: error if any
+func (k Keeper) autoDeleverage(
+	ctx sdk.Context, pair asset.Pair, dir v2types.Direction, loss sdk.Int,
+) (uncovered sdk.Int, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return sdk.Int{}, v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	if market.Settled || !loss.IsPositive() {
+		return loss, nil
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	queue, err := k.adlQueue(ctx, market, amm, dir)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// the positions are settled at the mark price without moving it, so the
+	// queue ranked before the first reduction stays valid
+	remaining := loss.ToDec()
+	for i, candidate := range queue {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		absorbed, err := k.deleveragePosition(cachedCtx, candidate.position, uint64(i+1), remaining)
+		if err != nil {
+			k.Logger(ctx).Error("failed to deleverage position", "pair", pair, "trader", candidate.position.TraderAddress, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		remaining = remaining.Sub(absorbed)
+	}
+
+	return sdk.MaxInt(remaining.RoundInt(), sdk.ZeroInt()), nil
+}
+
+// deleveragePosition reduces the position until the PnL it realizes at the
+// mark price covers the loss, closing it if its whole unrealized PnL doesn't,
+// and withholds that PnL in the vault. The reduced size is settled at the mark
+// price without swapping against the AMM, like a settled market, so the
+// deleveraged traders don't pay slippage and the price doesn't move along the
+// queue: the reduced size is only removed from the totals of the AMM.
+//
+// returns:
+//   - absorbed: the part of the loss absorbed by the position
+//   - err: error if any
+func (k Keeper) deleveragePosition(
+	ctx sdk.Context, position v2types.Position, rank uint64, loss sdk.Dec,
+) (absorbed sdk.Dec, err error) {
+	trader, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	market, err := k.Markets.Get(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	amm, err := k.AMMs.Get(ctx, position.Pair)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	markPrice := amm.MarkPrice()
+	unrealizedPnl := UnrealizedPnl(position, position.Size_.Abs().Mul(markPrice))
+	if !unrealizedPnl.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+
+	// the share of the position to reduce, the whole PnL it realizes is
+	// absorbed
+	ratio := sdk.OneDec()
+	absorbed = unrealizedPnl
+	if unrealizedPnl.GT(loss) {
+		ratio, absorbed = loss.Quo(unrealizedPnl), loss
+	}
+	reducedSize := position.Size_.Mul(ratio)
+
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Sub(fundingPayment)
+	if remainingMargin.IsNegative() {
+		return sdk.Dec{}, v2types.ErrMarginRatioTooLow.Wrapf("position of %s is underwater", trader)
+	}
+
+	if position.Size_.IsPositive() {
+		amm.TotalLong = amm.TotalLong.Sub(reducedSize)
+	} else {
+		amm.TotalShort = amm.TotalShort.Add(reducedSize)
+	}
+	k.AMMs.Insert(ctx, position.Pair, amm)
+
+	if ratio.Equal(sdk.OneDec()) {
+		if err = k.Withdraw(ctx, market, trader, remainingMargin.RoundInt()); err != nil {
+			return sdk.Dec{}, err
+		}
+		if err = k.DeletePosition(ctx, position.Pair, trader); err != nil {
+			return sdk.Dec{}, err
+		}
+	} else {
+		updatedPosition := v2types.Position{
+			TraderAddress:                   position.TraderAddress,
+			Pair:                            position.Pair,
+			Size_:                           position.Size_.Sub(reducedSize),
+			Margin:                          remainingMargin,
+			OpenNotional:                    position.OpenNotional.Mul(sdk.OneDec().Sub(ratio)),
+			LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+			LastUpdatedBlockNumber:          ctx.BlockHeight(),
+		}
+		updatedPosition.CarryAccounting(
+			position,
+			sdk.ZeroDec(),
+			fundingPayment,
+			sdk.ZeroDec(),
+			ctx.BlockTime().UnixMilli(),
+		)
+		k.SetPosition(ctx, trader, updatedPosition)
+	}
+
+	return absorbed, ctx.EventManager().EmitTypedEvent(&v2types.PositionAutoDeleveragedEvent{
+		Pair:              position.Pair,
+		TraderAddress:     trader.String(),
+		Rank:              rank,
+		ExchangedSize:     reducedSize.Neg(),
+		ExchangedNotional: reducedSize.Abs().Mul(markPrice),
+		RealizedPnl:       absorbed,
+		AbsorbedLoss:      absorbed,
+		BlockHeight:       ctx.BlockHeight(),
+	})
+}
+
+// ADLRank returns the rank of the position of the trader in the ADL queue of
+// its side, starting at 1, or zero if the position is not profitable.
+//
+// returns:
+//   - rank: the rank of the position
+//   - queueLength: the number of profitable positions on its side
+//   - score: the unrealized PnL x effective leverage of the position
+//   - err: error if any
+func (k Keeper) ADLRank(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress,
+) (rank uint64, queueLength uint64, score sdk.Dec, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return 0, 0, sdk.Dec{}, v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return 0, 0, sdk.Dec{}, err
+	}
+	position, err := k.Positions.Get(ctx, collections.Join(pair, trader))
+	if err != nil || position.Size_.IsZero() {
+		return 0, 0, sdk.Dec{}, v2types.ErrPositionZero.Wrapf("no position of %s on %s", trader, pair)
+	}
+
+	dir := v2types.Direction_LONG
+	if position.Size_.IsNegative() {
+		dir = v2types.Direction_SHORT
+	}
+	queue, err := k.adlQueue(ctx, market, amm, dir)
+	if err != nil {
+		return 0, 0, sdk.Dec{}, err
+	}
+
+	score = sdk.ZeroDec()
+	for i, candidate := range queue {
+		if candidate.position.TraderAddress == trader.String() {
+			rank, score = uint64(i+1), candidate.score
+			break
+		}
+	}
+
+	return rank, uint64(len(queue)), score, nil
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// autoDeleveragedEvents returns the PositionAutoDeleveragedEvents emitted on ctx.
+func autoDeleveragedEvents(t *testing.T, ctx sdk.Context) (events []*v2types.PositionAutoDeleveragedEvent) {
+	for _, abciEvent := range ctx.EventManager().Events() {
+		if abciEvent.Type != proto.MessageName(&v2types.PositionAutoDeleveragedEvent{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event{Type: abciEvent.Type, Attributes: abciEvent.Attributes})
+		require.NoError(t, err)
+		events = append(events, typedEvent.(*v2types.PositionAutoDeleveragedEvent))
+	}
+	return events
+}
+
+func TestAutoDeleveraging(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	dave := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, testutil.AccAddress(), 10)
+
+	// leave 200 in the ecosystem fund
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.PerpEFModuleAccount, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e4-200))))
+
+	// alice's long lost ~1000 on a margin of 100, bob's and carol's shorts are
+	// in profit, and so is dave's long
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	insertPosition(t, app, ctx, btc, bob, -1000, 2000, 100)
+	insertPosition(t, app, ctx, btc, carol, -500, 1000, 1000)
+	insertPosition(t, app, ctx, btc, dave, 10, 5, 5)
+
+	t.Log("the shorts are ranked by PnL x leverage")
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	for trader, expectedRank := range map[string]uint64{bob.String(): 1, carol.String(): 2} {
+		resp, err := queryServer.ADLRank(sdk.WrapSDKContext(ctx), &v2types.QueryADLRankRequest{Pair: btc, Trader: trader})
+		require.NoError(t, err)
+		require.Equal(t, expectedRank, resp.Rank)
+		require.EqualValues(t, 2, resp.QueueLength)
+		require.True(t, resp.Score.IsPositive())
+	}
+	resp, err := queryServer.ADLRank(sdk.WrapSDKContext(ctx), &v2types.QueryADLRankRequest{Pair: btc, Trader: alice.String()})
+	require.NoError(t, err)
+	require.Zero(t, resp.Rank)
+	require.EqualValues(t, 1, resp.QueueLength)
+
+	liquidations, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, liquidations[0].Success)
+	require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD).IsZero())
+
+	t.Log("the bad debt left is absorbed by reducing bob's short, the top of the queue")
+	events := autoDeleveragedEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, bob.String(), events[0].TraderAddress)
+	require.EqualValues(t, 1, events[0].Rank)
+	require.True(t, events[0].ExchangedSize.IsPositive())
+	require.True(t, events[0].AbsorbedLoss.IsPositive())
+	require.Equal(t, events[0].RealizedPnl, events[0].AbsorbedLoss)
+	testutil.RequireNotHasTypedEvent(t, ctx, &v2types.LossSocializedEvent{})
+
+	bobPosition, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(btc, bob))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(-1000).Add(events[0].ExchangedSize), bobPosition.Size_)
+	require.Equal(t, sdk.NewDec(100), bobPosition.Margin, "the realized PnL is withheld")
+
+	for trader, size := range map[string]int64{carol.String(): -500, dave.String(): 10} {
+		position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(btc, sdk.MustAccAddressFromBech32(trader)))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(size), position.Size_)
+	}
+}
+
+func TestAutoDeleveragingMarketUpdate(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	for _, tc := range []struct {
+		name         string
+		openNotional int64
+		expectedErr  error
+	}{
+		{
+			name:         "the long absorbs the repeg cost",
+			openNotional: 900,
+		},
+		{
+			name:         "the long can't absorb the repeg cost",
+			openNotional: 1050,
+			expectedErr:  types.ErrNotEnoughFundToPayAction,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bob := testutil.AccAddress()
+			app, ctx := setupCrossMarginMarkets(t, testutil.AccAddress(), 10)
+
+			// drain the ecosystem fund
+			require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.PerpEFModuleAccount, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e4))))
+			insertPosition(t, app, ctx, btc, bob, 1000, tc.openNotional, 100)
+			ammBefore, err := app.PerpKeeperV2.AMMs.Get(ctx, btc)
+			require.NoError(t, err)
+
+			err = app.PerpKeeperV2.EditPriceMultiplier(ctx, btc, sdk.MustNewDecFromStr("1.1"))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			events := autoDeleveragedEvents(t, ctx)
+			require.Len(t, events, 1)
+			require.Equal(t, bob.String(), events[0].TraderAddress)
+			require.True(t, events[0].ExchangedSize.IsNegative())
+
+			amm, err := app.PerpKeeperV2.AMMs.Get(ctx, btc)
+			require.NoError(t, err)
+			require.Equal(t, sdk.MustNewDecFromStr("1.1"), amm.PriceMultiplier)
+			require.Equal(t, ammBefore.TotalLong.Add(events[0].ExchangedSize), amm.TotalLong)
+			require.True(t, amm.TotalShort.IsZero())
+			require.Equal(t, ammBefore.BaseReserve, amm.BaseReserve, "the position is reduced without swapping")
+			require.Equal(t, ammBefore.QuoteReserve, amm.QuoteReserve)
+			require.Equal(t, events[0].ExchangedSize.Abs().Mul(amm.MarkPrice()), events[0].ExchangedNotional)
+			testutil.RequireHasTypedEvent(t, ctx, &v2types.AmmUpdatedEvent{FinalAmm: amm, Cost: events[0].AbsorbedLoss.RoundInt()})
+		})
+	}
+}
@@ -16,6 +16,10 @@ func (k Keeper) EditPriceMultiplier(
 	pair asset.Pair,
 	newPriceMultiplier sdk.Dec,
 ) (err error) {
+	if err = k.checkMarketNotSettled(ctx, pair); err != nil {
+		return err
+	}
+
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
 		return err
@@ -32,7 +36,7 @@ func (k Keeper) EditPriceMultiplier(
 		return err
 	}
 
-	err = k.handleMarketUpdateCost(ctx, pair, cost)
+	shortfall, err := k.handleMarketUpdateCost(ctx, pair, cost)
 	if err != nil {
 		return err
 	}
@@ -41,15 +45,24 @@ func (k Keeper) EditPriceMultiplier(
 	amm.PriceMultiplier = newPriceMultiplier
 	k.AMMs.Insert(ctx, pair, amm)
 
-	// TODO(k-yang): emit event
+	if amm, err = k.deleverageMarketUpdateShortfall(ctx, pair, shortfall); err != nil {
+		return err
+	}
 
-	return
+	return ctx.EventManager().EmitTypedEvent(&v2types.AmmUpdatedEvent{
+		FinalAmm: amm,
+		Cost:     cost,
+	})
 }
 
 // EditSwapInvariant edits the swap invariant of an amm pool after making
 // sure there's enough money in the perp EF fund to pay for the repeg. These
 // funds get send to the vault to pay for trader's new net margin.
 func (k Keeper) EditSwapInvariant(ctx sdk.Context, pair asset.Pair, multiplier sdk.Dec) (err error) {
+	if err = k.checkMarketNotSettled(ctx, pair); err != nil {
+		return err
+	}
+
 	// Get the pool
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
@@ -62,7 +75,7 @@ func (k Keeper) EditSwapInvariant(ctx sdk.Context, pair asset.Pair, multiplier s
 		return err
 	}
 
-	err = k.handleMarketUpdateCost(ctx, pair, cost)
+	shortfall, err := k.handleMarketUpdateCost(ctx, pair, cost)
 	if err != nil {
 		return err
 	}
@@ -74,29 +87,40 @@ func (k Keeper) EditSwapInvariant(ctx sdk.Context, pair asset.Pair, multiplier s
 
 	k.AMMs.Insert(ctx, pair, amm)
 
-	// TODO(k-yang): emit event
+	if amm, err = k.deleverageMarketUpdateShortfall(ctx, pair, shortfall); err != nil {
+		return err
+	}
 
-	return
+	return ctx.EventManager().EmitTypedEvent(&v2types.AmmUpdatedEvent{
+		FinalAmm: amm,
+		Cost:     cost,
+	})
 }
 
-func (k Keeper) handleMarketUpdateCost(ctx sdk.Context, pair asset.Pair, cost sdk.Int) (err error) {
+// handleMarketUpdateCost pays the cost of an AMM update: a positive cost is
+// sent from the perp EF to the vault, up to the balance of the perp EF, and a
+// negative cost from the vault to the perp EF.
+//
+// returns:
+//   - shortfall: the part of the cost the perp EF couldn't pay
+//   - err: error if any
+func (k Keeper) handleMarketUpdateCost(ctx sdk.Context, pair asset.Pair, cost sdk.Int) (shortfall sdk.Int, err error) {
+	shortfall = sdk.ZeroInt()
 	if cost.IsPositive() {
 		// Positive cost, send from perp EF to vault
-		costCoin := sdk.NewCoins(
-			sdk.NewCoin(pair.QuoteDenom(), cost),
-		)
-		err = k.BankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			v2types.PerpEFModuleAccount,
-			v2types.VaultModuleAccount,
-			costCoin,
-		)
-		if err != nil {
-			return types.ErrNotEnoughFundToPayAction.Wrapf(
-				"not enough fund in perp ef to pay for repeg, need %s got %s",
-				costCoin.String(),
-				k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), pair.QuoteDenom()).String(),
+		efBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), pair.QuoteDenom())
+		paid := sdk.MinInt(cost, efBalance.Amount)
+		shortfall = cost.Sub(paid)
+		if paid.IsPositive() {
+			err = k.BankKeeper.SendCoinsFromModuleToModule(
+				ctx,
+				v2types.PerpEFModuleAccount,
+				v2types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), paid)),
 			)
+			if err != nil {
+				return sdk.Int{}, err
+			}
 		}
 	} else if cost.IsNegative() {
 		// Negative cost, send from margin vault to perp ef.
@@ -115,5 +139,44 @@ func (k Keeper) handleMarketUpdateCost(ctx sdk.Context, pair asset.Pair, cost sd
 			// end up being paid up by the perp EF anyway.
 		}
 	}
+	return shortfall, nil
+}
+
+// deleverageMarketUpdateShortfall auto-deleverages the profitable positions of
+// the updated market for the part of the update cost the perp EF couldn't pay,
+// and errors if they can't absorb it.
+//
+// returns:
+//   - amm: the AMM of the market after deleveraging
+//   - err: error if any
+func (k Keeper) deleverageMarketUpdateShortfall(ctx sdk.Context, pair asset.Pair, shortfall sdk.Int) (amm v2types.AMM, err error) {
+	if shortfall.IsPositive() {
+		uncovered, err := k.autoDeleverage(ctx, pair, v2types.Direction_DIRECTION_UNSPECIFIED, shortfall)
+		if err != nil {
+			return v2types.AMM{}, err
+		}
+		if uncovered.IsPositive() {
+			return v2types.AMM{}, types.ErrNotEnoughFundToPayAction.Wrapf(
+				"not enough fund in perp ef nor profitable positions to pay for the market update, short of %s%s",
+				uncovered, pair.QuoteDenom(),
+			)
+		}
+	}
+
+	return k.AMMs.Get(ctx, pair)
+}
+
+// checkMarketNotSettled errors if the market of the pair is settled, in which
+// case its AMM is frozen.
+func (k Keeper) checkMarketNotSettled(ctx sdk.Context, pair asset.Pair) error {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	if market.Settled {
+		return v2types.ErrMarketSettled.Wrapf("pair: %s", pair)
+	}
+
 	return nil
 }
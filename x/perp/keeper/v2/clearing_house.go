@@ -34,6 +34,21 @@ func (k Keeper) OpenPosition(
 	quoteAssetAmt sdk.Int,
 	leverage sdk.Dec,
 	baseAmtLimit sdk.Dec,
+) (positionResp *v2types.PositionResp, err error) {
+	return k.openPosition(ctx, pair, dir, traderAddr, quoteAssetAmt, leverage, baseAmtLimit, false)
+}
+
+// openPosition opens a position like OpenPosition, charging the maker fee of
+// the trader's fee tier if isMaker is set.
+func (k Keeper) openPosition(
+	ctx sdk.Context,
+	pair asset.Pair,
+	dir v2types.Direction,
+	traderAddr sdk.AccAddress,
+	quoteAssetAmt sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Dec,
+	isMaker bool,
 ) (positionResp *v2types.PositionResp, err error) {
 	market, err := k.Markets.Get(ctx, pair)
 	if err != nil {
@@ -95,7 +110,12 @@ func (k Keeper) OpenPosition(
 		}
 	}
 
-	if err = k.afterPositionUpdate(ctx, market, *updatedAMM, traderAddr, *positionResp); err != nil {
+	longOI, shortOI := k.OpenInterest(ctx, pair)
+	if err = checkPositionLimits(market, amm, *updatedAMM, longOI, shortOI, position, *positionResp.Position); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterPositionUpdate(ctx, market, *updatedAMM, traderAddr, *positionResp, isMaker); err != nil {
 		return nil, err
 	}
 
@@ -501,6 +521,61 @@ func checkOpenPositionRequirements(market v2types.Market, quoteAssetAmt sdk.Int,
 	return nil
 }
 
+// checkPositionLimits checks that opening a position keeps the market and
+// the trader within the risk limits of the market. A limit set to zero is not
+// enforced, and a position change that moves a value over its limit back
+// towards it is allowed.
+//
+// - Checks that the long and short open interests are below the max open interest.
+// - Checks that the open notional of the position is below the max trader notional.
+// - Checks that the bias of the amm is below the max bias.
+//
+// args:
+// - market: the market where the position is opened
+// - amm: the amm reserves before the position change
+// - updatedAMM: the amm reserves after the position change
+// - longOI: the total size of the long positions before the change
+// - shortOI: the total size of the short positions before the change
+// - position: the position before the change
+// - updatedPosition: the position after the change
+//
+// returns:
+// - error: if any of the limits is exceeded
+func checkPositionLimits(
+	market v2types.Market,
+	amm v2types.AMM,
+	updatedAMM v2types.AMM,
+	longOI sdk.Dec,
+	shortOI sdk.Dec,
+	position v2types.Position,
+	updatedPosition v2types.Position,
+) error {
+	if maxOI := market.MaxOpenInterest; !maxOI.IsNil() && maxOI.IsPositive() {
+		updatedLongOI, updatedShortOI := openInterestAfter(longOI, shortOI, position.Size_, updatedPosition.Size_)
+		if updatedLongOI.GT(maxOI) && updatedLongOI.GT(longOI) {
+			return v2types.ErrOpenInterestCapExceeded.Wrapf("long open interest %s over %s", updatedLongOI, maxOI)
+		}
+		if updatedShortOI.GT(maxOI) && updatedShortOI.GT(shortOI) {
+			return v2types.ErrOpenInterestCapExceeded.Wrapf("short open interest %s over %s", updatedShortOI, maxOI)
+		}
+	}
+
+	if maxNotional := market.MaxTraderNotional; !maxNotional.IsNil() && maxNotional.IsPositive() {
+		if updatedPosition.OpenNotional.GT(maxNotional) && updatedPosition.OpenNotional.GT(position.OpenNotional) {
+			return v2types.ErrTraderNotionalCapExceeded.Wrapf("open notional %s over %s", updatedPosition.OpenNotional, maxNotional)
+		}
+	}
+
+	if maxBias := market.MaxBias; !maxBias.IsNil() && maxBias.IsPositive() {
+		bias, updatedBias := amm.Bias().Abs(), updatedAMM.Bias().Abs()
+		if updatedBias.GT(maxBias) && updatedBias.GT(bias) {
+			return v2types.ErrMaxBiasExceeded.Wrapf("bias %s over %s", updatedBias, maxBias)
+		}
+	}
+
+	return nil
+}
+
 // afterPositionUpdate is called when a position has been updated.
 func (k Keeper) afterPositionUpdate(
 	ctx sdk.Context,
@@ -508,6 +583,7 @@ func (k Keeper) afterPositionUpdate(
 	amm v2types.AMM,
 	traderAddr sdk.AccAddress,
 	positionResp v2types.PositionResp,
+	isMaker bool,
 ) (err error) {
 	// check bad debt
 	if !positionResp.BadDebt.IsZero() {
@@ -519,7 +595,10 @@ func (k Keeper) afterPositionUpdate(
 		return err
 	}
 
-	if !positionResp.Position.Size_.IsZero() {
+	// the margin of a cross-margin trader is checked on the whole account,
+	// once the position is stored
+	isCrossMargin := k.isCrossMargin(ctx, traderAddr)
+	if !isCrossMargin && !positionResp.Position.Size_.IsZero() {
 		spotNotional, err := PositionNotionalSpot(amm, *positionResp.Position)
 		if err != nil {
 			return err
@@ -551,13 +630,29 @@ func (k Keeper) afterPositionUpdate(
 		}
 	}
 
-	transferredFee, err := k.transferFee(ctx, market.Pair, traderAddr, positionResp.ExchangedNotionalValue)
+	feeTier, exchangeFeeRatio := k.exchangeFeeRatio(ctx, market, traderAddr, isMaker)
+	transferredFee, makerRebate, err := k.transferFee(ctx, market.Pair, traderAddr, positionResp.ExchangedNotionalValue, exchangeFeeRatio)
 	if err != nil {
 		return err
 	}
+	k.recordTraderVolume(ctx, traderAddr, positionResp.ExchangedNotionalValue)
 
 	if !positionResp.Position.Size_.IsZero() {
-		k.Positions.Insert(ctx, collections.Join(market.Pair, traderAddr), *positionResp.Position)
+		previous, _ := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+		positionResp.Position.CarryAccounting(
+			previous,
+			positionResp.RealizedPnl,
+			positionResp.FundingPayment,
+			transferredFee.Sub(makerRebate).ToDec(),
+			ctx.BlockTime().UnixMilli(),
+		)
+		k.SetPosition(ctx, traderAddr, *positionResp.Position)
+	}
+
+	if isCrossMargin {
+		if err = k.checkCrossMarginHealthy(ctx, traderAddr, market.Pair.QuoteDenom()); err != nil {
+			return err
+		}
 	}
 
 	// calculate positionNotional (it's different depends on long or short side)
@@ -585,32 +680,82 @@ func (k Keeper) afterPositionUpdate(
 		FundingPayment:     positionResp.FundingPayment,
 		BlockHeight:        ctx.BlockHeight(),
 		BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+		FeeTier:            feeTier,
+		MakerRebate:        sdk.NewCoin(market.Pair.QuoteDenom(), makerRebate),
 	})
 }
 
-// transfers the fee to the exchange fee pool
+// transfers the fee to the exchange fee pool, less the insurance fund cut of
+// the exchange fee, and to the ecosystem fund. A negative exchange fee ratio
+// pays the trader a rebate from the exchange fee pool instead, capped at the
+// balance of the pool. The exchange fee of a referred trader is discounted,
+// and its referral share is paid to their referrer.
 //
 // args:
 // - ctx: the cosmos-sdk context
 // - pair: the trading pair
 // - trader: the trader's address
 // - positionNotional: the position's notional value
+// - exchangeFeeRatio: the exchange fee ratio of the trader's fee tier
 //
 // returns:
 // - fees: the fees to be transferred
+// - rebate: the rebate paid to the trader
 // - err: error if any
 func (k Keeper) transferFee(
 	ctx sdk.Context,
 	pair asset.Pair,
 	trader sdk.AccAddress,
 	positionNotional sdk.Dec,
-) (fees sdk.Int, err error) {
+	exchangeFeeRatio sdk.Dec,
+) (fees sdk.Int, rebate sdk.Int, err error) {
 	m, err := k.Markets.Get(ctx, pair)
 	if err != nil {
-		return sdk.Int{}, err
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	exchangeFee := exchangeFeeRatio.Mul(positionNotional).RoundInt()
+	rebate = sdk.ZeroInt()
+	if exchangeFee.IsNegative() {
+		feePoolBalance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(v2types.FeePoolModuleAccount), pair.QuoteDenom()).Amount
+		rebate = sdk.MinInt(exchangeFee.Neg(), feePoolBalance)
+		if rebate.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+				ctx,
+				/* from */ v2types.FeePoolModuleAccount,
+				/* to */ trader,
+				/* coins */ sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), rebate)),
+			); err != nil {
+				return sdk.Int{}, sdk.Int{}, err
+			}
+		}
+		exchangeFee = sdk.ZeroInt()
+	}
+
+	discount, referrerFee, err := k.payReferralFee(ctx, pair, trader, exchangeFee)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	exchangeFee = exchangeFee.Sub(discount)
+
+	feeToInsuranceFund := k.insuranceFundCut(ctx, exchangeFee.Sub(referrerFee))
+	if feeToInsuranceFund.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ trader,
+			/* to */ v2types.InsuranceFundModuleAccount,
+			/* coins */ sdk.NewCoins(
+				sdk.NewCoin(
+					pair.QuoteDenom(),
+					feeToInsuranceFund,
+				),
+			),
+		); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
 	}
 
-	feeToExchangeFeePool := m.ExchangeFeeRatio.Mul(positionNotional).RoundInt()
+	feeToExchangeFeePool := exchangeFee.Sub(referrerFee).Sub(feeToInsuranceFund)
 	if feeToExchangeFeePool.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -623,7 +768,7 @@ func (k Keeper) transferFee(
 				),
 			),
 		); err != nil {
-			return sdk.Int{}, err
+			return sdk.Int{}, sdk.Int{}, err
 		}
 	}
 
@@ -640,11 +785,11 @@ func (k Keeper) transferFee(
 				),
 			),
 		); err != nil {
-			return sdk.Int{}, err
+			return sdk.Int{}, sdk.Int{}, err
 		}
 	}
 
-	return feeToExchangeFeePool.Add(feeToEcosystemFund), nil
+	return exchangeFee.Add(feeToEcosystemFund), rebate, nil
 }
 
 // checks that the mark price of the pool does not violate the fluctuation limit
@@ -693,6 +838,19 @@ func (k Keeper) checkPriceFluctuationLimitRatio(ctx sdk.Context, market v2types.
 //   - positionResp: response object containing information about the position change
 //   - err: error if any
 func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress) (*v2types.PositionResp, error) {
+	return k.closePosition(ctx, pair, traderAddr, false, sdk.ZeroDec())
+}
+
+// closePosition closes a position like ClosePosition, charging the maker fee
+// of the trader's fee tier if isMaker is set. A non-zero quoteAssetAmountLimit
+// bounds the quote assets exchanged, see checkCloseQuoteLimit.
+func (k Keeper) closePosition(
+	ctx sdk.Context,
+	pair asset.Pair,
+	traderAddr sdk.AccAddress,
+	isMaker bool,
+	quoteAssetAmountLimit sdk.Dec,
+) (*v2types.PositionResp, error) {
 	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 	if err != nil {
 		return nil, err
@@ -703,6 +861,10 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		return nil, v2types.ErrPairNotFound
 	}
 
+	if market.Settled {
+		return nil, v2types.ErrMarketSettled
+	}
+
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
 		return nil, err
@@ -713,14 +875,87 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		market,
 		amm,
 		position,
-		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+		quoteAssetAmountLimit,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if positionResp.BadDebt.IsPositive() {
-		return nil, fmt.Errorf("underwater position")
+	if err = k.coverCloseBadDebt(ctx, traderAddr, pair, positionResp); err != nil {
+		return nil, err
+	}
+
+	if err = k.afterPositionUpdate(
+		ctx,
+		market,
+		*updatedAMM,
+		traderAddr,
+		*positionResp,
+		isMaker,
+	); err != nil {
+		return nil, err
+	}
+
+	return positionResp, nil
+}
+
+// PartialClose closes sizeAmt base assets of a position, realizing the
+// corresponding share of its PnL and the funding payment. The position is
+// closed entirely if sizeAmt is greater than or equal to its size.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - pair: the pair of the position
+//   - traderAddr: the address of the trader
+//   - sizeAmt: the amount of base assets to close, must be positive
+//   - quoteAssetAmountLimit: the minimum amount of quote assets received when
+//     reducing a long, or the maximum amount paid when reducing a short. Zero
+//     disables the check.
+//
+// returns:
+//   - positionResp: response object containing information about the position change
+//   - err: error if any
+func (k Keeper) PartialClose(
+	ctx sdk.Context,
+	pair asset.Pair,
+	traderAddr sdk.AccAddress,
+	sizeAmt sdk.Dec,
+	quoteAssetAmountLimit sdk.Dec,
+) (*v2types.PositionResp, error) {
+	if !sizeAmt.IsPositive() {
+		return nil, v2types.ErrInvalidAmount.Wrapf("size amount must be positive: %s", sizeAmt)
+	}
+
+	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	if sizeAmt.GTE(position.Size_.Abs()) {
+		return k.closePosition(ctx, pair, traderAddr, false, quoteAssetAmountLimit)
+	}
+
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	if market.Settled {
+		return nil, v2types.ErrMarketSettled
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAMM, positionResp, err := k.decreasePositionBySize(ctx, market, amm, position, sizeAmt, quoteAssetAmountLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.coverCloseBadDebt(ctx, traderAddr, pair, positionResp); err != nil {
+		return nil, err
 	}
 
 	if err = k.afterPositionUpdate(
@@ -729,6 +964,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		*updatedAMM,
 		traderAddr,
 		*positionResp,
+		/* isMaker */ false,
 	); err != nil {
 		return nil, err
 	}
@@ -736,6 +972,177 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 	return positionResp, nil
 }
 
+// checkCloseQuoteLimit checks the quote assets exchanged when reducing a
+// position against the trader's limit: reducing a long must receive at least
+// limit, and reducing a short must pay at most limit. A zero limit disables the
+// check.
+func checkCloseQuoteLimit(positionSize, exchangedNotional, limit sdk.Dec) error {
+	if limit.IsZero() {
+		return nil
+	}
+
+	if positionSize.IsPositive() && exchangedNotional.LT(limit) {
+		return v2types.ErrAssetFailsUserLimit.Wrapf(
+			"quote received (%s) is less than selected limit (%s)", exchangedNotional, limit)
+	}
+
+	if positionSize.IsNegative() && exchangedNotional.GT(limit) {
+		return v2types.ErrAssetFailsUserLimit.Wrapf(
+			"quote paid (%s) is greater than selected limit (%s)", exchangedNotional, limit)
+	}
+
+	return nil
+}
+
+// Decreases a position by sizeAmt base assets, in the same way decreasePosition
+// does by an amount of notional. sizeAmt must be lower than the position size.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - market: the perp market
+//   - amm: the amm reserves
+//   - currentPosition: the current position
+//   - sizeAmt: the amount of base assets to close
+//   - quoteAssetAmountLimit: the quote limit, see checkCloseQuoteLimit
+//
+// returns:
+//   - updatedAMM: the updated AMM reserves
+//   - positionResp: updated position information
+//   - err: error
+func (k Keeper) decreasePositionBySize(
+	ctx sdk.Context,
+	market v2types.Market,
+	amm v2types.AMM,
+	currentPosition v2types.Position,
+	sizeAmt sdk.Dec,
+	quoteAssetAmountLimit sdk.Dec,
+) (updatedAMM *v2types.AMM, positionResp *v2types.PositionResp, err error) {
+	var dir v2types.Direction
+	if currentPosition.Size_.IsPositive() {
+		dir = v2types.Direction_SHORT
+		positionResp = &v2types.PositionResp{ExchangedPositionSize: sizeAmt.Neg()}
+	} else {
+		dir = v2types.Direction_LONG
+		positionResp = &v2types.PositionResp{ExchangedPositionSize: sizeAmt}
+	}
+	positionResp.MarginToVault = sdk.ZeroDec()
+
+	currentPositionNotional, err := PositionNotionalSpot(amm, currentPosition)
+	if err != nil {
+		return nil, nil, err
+	}
+	currentUnrealizedPnl := UnrealizedPnl(currentPosition, currentPositionNotional)
+
+	updatedAMM, exchangedNotional, err := k.SwapBaseAsset(
+		ctx,
+		market,
+		amm,
+		dir,
+		sizeAmt,
+		/* quoteAssetLimit */ sdk.ZeroDec(),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkCloseQuoteLimit(currentPosition.Size_, exchangedNotional, quoteAssetAmountLimit); err != nil {
+		return nil, nil, err
+	}
+
+	positionResp.RealizedPnl = currentUnrealizedPnl.Mul(sizeAmt).Quo(currentPosition.Size_.Abs())
+
+	fundingPayment := FundingPayment(currentPosition, market.LatestCumulativePremiumFraction)
+	remainingMargin := currentPosition.Margin.Add(positionResp.RealizedPnl).Sub(fundingPayment)
+
+	positionResp.BadDebt = sdk.MinDec(sdk.ZeroDec(), remainingMargin).Abs()
+	positionResp.FundingPayment = fundingPayment
+	positionResp.UnrealizedPnlAfter = currentUnrealizedPnl.Sub(positionResp.RealizedPnl)
+	positionResp.ExchangedNotionalValue = exchangedNotional
+	positionResp.PositionNotional = currentPositionNotional.Sub(exchangedNotional)
+
+	var remainOpenNotional sdk.Dec
+	if currentPosition.Size_.IsPositive() {
+		remainOpenNotional = positionResp.PositionNotional.Sub(positionResp.UnrealizedPnlAfter)
+	} else {
+		remainOpenNotional = positionResp.PositionNotional.Add(positionResp.UnrealizedPnlAfter)
+	}
+
+	if remainOpenNotional.IsNegative() {
+		return nil, nil, fmt.Errorf("value of open notional < 0")
+	}
+
+	positionResp.Position = &v2types.Position{
+		TraderAddress:                   currentPosition.TraderAddress,
+		Pair:                            currentPosition.Pair,
+		Size_:                           currentPosition.Size_.Add(positionResp.ExchangedPositionSize),
+		Margin:                          sdk.MaxDec(sdk.ZeroDec(), remainingMargin),
+		OpenNotional:                    remainOpenNotional,
+		LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+		LastUpdatedBlockNumber:          ctx.BlockHeight(),
+	}
+
+	return updatedAMM, positionResp, nil
+}
+
+// coverCloseBadDebt errors if closing the position left bad debt, unless the
+// trader has a cross-margin account whose collateral can cover it.
+func (k Keeper) coverCloseBadDebt(ctx sdk.Context, traderAddr sdk.AccAddress, pair asset.Pair, positionResp *v2types.PositionResp) error {
+	if !positionResp.BadDebt.IsPositive() {
+		return nil
+	}
+
+	if !k.isCrossMargin(ctx, traderAddr) {
+		return fmt.Errorf("underwater position")
+	}
+
+	// the collateral of a cross-margin account covers the loss
+	if err := k.coverBadDebtWithCollateral(ctx, traderAddr, pair.QuoteDenom(), positionResp.BadDebt); err != nil {
+		return err
+	}
+	positionResp.BadDebt = sdk.ZeroDec()
+
+	return nil
+}
+
+// checkReduceOnly errors if opening a position of notional value in direction
+// dir would not strictly reduce or close the position of the trader: that is
+// if the trader has no position, if dir is the side of the position, or if the
+// notional value is greater than the position notional, flipping its side.
+func (k Keeper) checkReduceOnly(
+	ctx sdk.Context,
+	pair asset.Pair,
+	dir v2types.Direction,
+	traderAddr sdk.AccAddress,
+	notional sdk.Dec,
+) error {
+	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil || position.Size_.IsZero() {
+		return v2types.ErrReduceOnly.Wrapf("no position to reduce on %s", pair)
+	}
+
+	if (position.Size_.IsPositive() && dir == v2types.Direction_LONG) ||
+		(position.Size_.IsNegative() && dir == v2types.Direction_SHORT) {
+		return v2types.ErrReduceOnly.Wrapf("%s would increase a position of size %s", dir, position.Size_)
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return v2types.ErrPairNotFound
+	}
+
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return err
+	}
+
+	if notional.GT(positionNotional) {
+		return v2types.ErrReduceOnly.Wrapf(
+			"notional %s is greater than the position notional %s and would flip the position", notional, positionNotional)
+	}
+
+	return nil
+}
+
 // Closes a position and realizes PnL and funding payments.
 // Does not error out if there is bad debt, that is for callers to decide.
 //
@@ -808,12 +1215,16 @@ func (k Keeper) closePositionEntirely(
 		amm,
 		sideToTake,
 		currentPosition.Size_.Abs(),
-		quoteAssetAmountLimit,
+		/* quoteAssetLimit */ sdk.ZeroDec(),
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if err = checkCloseQuoteLimit(currentPosition.Size_, exchangedNotionalValue, quoteAssetAmountLimit); err != nil {
+		return nil, nil, err
+	}
+
 	positionResp.ExchangedNotionalValue = exchangedNotionalValue
 	positionResp.Position = &v2types.Position{
 		TraderAddress:                   currentPosition.TraderAddress,
@@ -825,7 +1236,7 @@ func (k Keeper) closePositionEntirely(
 		LastUpdatedBlockNumber:          ctx.BlockHeight(),
 	}
 
-	err = k.Positions.Delete(ctx, collections.Join(currentPosition.Pair, trader))
+	err = k.DeletePosition(ctx, currentPosition.Pair, trader)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
 	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
 	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
@@ -85,6 +86,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(20)),
 					BlockHeight:        1,
 					BlockTimeMs:        startBlockTime.UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -136,6 +138,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(20)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -188,6 +191,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(10)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -240,6 +244,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(60)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -298,6 +303,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(95_238_096)),
 					BlockHeight:        1,
 					BlockTimeMs:        startBlockTime.UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -358,6 +364,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(20)),
 					BlockHeight:        1,
 					BlockTimeMs:        startBlockTime.UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -409,6 +416,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(20)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -461,6 +469,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(10)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -513,6 +522,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(60)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -571,6 +581,7 @@ func TestOpenPosition(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.NUSD, sdk.NewInt(95_238_096)),
 					BlockHeight:        1,
 					BlockTimeMs:        startBlockTime.UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 				}),
 			),
 
@@ -811,7 +822,7 @@ func TestOpenPositionError(t *testing.T) {
 
 			if tc.initialPosition != nil {
 				tc.initialPosition.TraderAddress = traderAddr.String()
-				app.PerpKeeperV2.Positions.Insert(ctx, collections.Join(tc.initialPosition.Pair, traderAddr), *tc.initialPosition)
+				app.PerpKeeperV2.SetPosition(ctx, traderAddr, *tc.initialPosition)
 			}
 
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(time.Second * 5))
@@ -977,7 +988,7 @@ func TestClosePosition(t *testing.T) {
 				Amm:         *amm,
 				TimestampMs: ctx.BlockTime().UnixMilli(),
 			})
-			app.PerpKeeperV2.Positions.Insert(ctx, collections.Join(tc.initialPosition.Pair, traderAddr), tc.initialPosition)
+			app.PerpKeeperV2.SetPosition(ctx, traderAddr, tc.initialPosition)
 			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e18))))
 			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e18))))
 
@@ -1023,6 +1034,7 @@ func TestClosePosition(t *testing.T) {
 				TransactionFee:     sdk.NewInt64Coin(denoms.NUSD, 0),
 				BlockHeight:        ctx.BlockHeight(),
 				BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+				MakerRebate:        sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()),
 			})
 		})
 	}
@@ -1223,3 +1235,230 @@ func TestUpdateSwapInvariant(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestPartialClose(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1100))))
+
+	_, err := app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(100), sdk.ZeroDec())
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("reducing a long fails if it receives less than the quote limit")
+	_, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(400), sdk.NewDec(500))
+	require.ErrorIs(t, err, v2types.ErrAssetFailsUserLimit)
+	_, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(1e6), sdk.NewDec(2000))
+	require.ErrorIs(t, err, v2types.ErrAssetFailsUserLimit)
+
+	t.Log("close 400 of the ~1000 base assets of the long")
+	resp, err := app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(400), sdk.NewDec(300))
+	require.NoError(t, err)
+	require.True(t, resp.ExchangedNotionalValue.GTE(sdk.NewDec(300)))
+	require.Equal(t, sdk.NewDec(-400), resp.ExchangedPositionSize)
+	require.True(t, resp.Position.Size_.IsPositive())
+	require.True(t, resp.MarginToVault.IsZero())
+	require.True(t, resp.FundingPayment.IsZero())
+
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	require.Equal(t, *resp.Position, position)
+
+	t.Log("closing more than the position size closes it entirely")
+	resp, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(1e6), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, position.Size_.Neg(), resp.ExchangedPositionSize)
+	require.True(t, resp.MarginToVault.IsNegative())
+
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	t.Log("reducing a short fails if it pays more than the quote limit")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_SHORT, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(400), sdk.NewDec(300))
+	require.ErrorIs(t, err, v2types.ErrAssetFailsUserLimit)
+	resp, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, sdk.NewDec(400), sdk.NewDec(500))
+	require.NoError(t, err)
+	require.True(t, resp.ExchangedNotionalValue.LTE(sdk.NewDec(500)))
+}
+
+func TestOpenPositionReduceOnly(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1100))))
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	reduceOnly := func(side v2types.Direction, quoteAmt int64) (*v2types.MsgOpenPositionResponse, error) {
+		return msgServer.OpenPosition(sdk.WrapSDKContext(ctx), &v2types.MsgOpenPosition{
+			Sender:               alice.String(),
+			Pair:                 pair,
+			Side:                 side,
+			QuoteAssetAmount:     sdk.NewInt(quoteAmt),
+			Leverage:             sdk.OneDec(),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			ReduceOnly:           true,
+		})
+	}
+
+	_, err := reduceOnly(v2types.Direction_LONG, 100)
+	require.ErrorIs(t, err, v2types.ErrReduceOnly, "there is no position to reduce")
+
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	_, err = reduceOnly(v2types.Direction_LONG, 100)
+	require.ErrorIs(t, err, v2types.ErrReduceOnly, "the long can't be increased")
+
+	_, err = reduceOnly(v2types.Direction_SHORT, 2000)
+	require.ErrorIs(t, err, v2types.ErrReduceOnly, "the long can't be flipped")
+
+	resp, err := reduceOnly(v2types.Direction_SHORT, 400)
+	require.NoError(t, err)
+	require.True(t, resp.Position.Size_.IsPositive())
+	require.True(t, resp.ExchangedPositionSize.IsNegative())
+	require.False(t, resp.RealizedPnl.IsNil())
+	require.False(t, resp.FundingPayment.IsNil())
+}
+
+func TestOpenPositionLimits(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	dave := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+	require.NoError(t, err)
+	market.
+		WithMaxOpenInterest(sdk.NewDec(5000)).
+		WithMaxTraderNotional(sdk.NewDec(3000)).
+		WithMaxBias(sdk.NewDec(4000))
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+
+	for _, trader := range []sdk.AccAddress{alice, bob, carol, dave} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10_000))))
+	}
+
+	openPosition := func(ctx sdk.Context, trader sdk.AccAddress, dir v2types.Direction, quoteAmt int64) error {
+		_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, dir, trader, sdk.NewInt(quoteAmt), sdk.OneDec(), sdk.ZeroDec())
+		return err
+	}
+	// a failed tx is reverted, which the cached context stands for
+	openPositionFails := func(trader sdk.AccAddress, dir v2types.Direction, quoteAmt int64) error {
+		cachedCtx, _ := ctx.CacheContext()
+		return openPosition(cachedCtx, trader, dir, quoteAmt)
+	}
+
+	require.NoError(t, openPosition(ctx, alice, v2types.Direction_LONG, 2000))
+
+	t.Log("alice can't grow her long past the max trader notional")
+	require.ErrorIs(t, openPositionFails(alice, v2types.Direction_LONG, 2000), v2types.ErrTraderNotionalCapExceeded)
+
+	t.Log("bob's long would push the bias past the max bias")
+	require.ErrorIs(t, openPositionFails(bob, v2types.Direction_LONG, 2500), v2types.ErrMaxBiasExceeded)
+
+	t.Log("carol's short brings the bias back down, making room for bob")
+	require.NoError(t, openPosition(ctx, carol, v2types.Direction_SHORT, 2500))
+	require.NoError(t, openPosition(ctx, bob, v2types.Direction_LONG, 2500))
+
+	t.Log("dave's long would push the total long past the max open interest")
+	require.ErrorIs(t, openPositionFails(dave, v2types.Direction_LONG, 1000), v2types.ErrOpenInterestCapExceeded)
+
+	t.Log("positions can always be reduced, which makes room for new ones")
+	require.NoError(t, openPosition(ctx, alice, v2types.Direction_SHORT, 1000))
+	require.NoError(t, openPosition(ctx, dave, v2types.Direction_LONG, 500))
+
+	t.Log("closed positions no longer count towards the open interest")
+	require.ErrorIs(t, openPositionFails(dave, v2types.Direction_LONG, 1500), v2types.ErrOpenInterestCapExceeded)
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	require.NoError(t, openPosition(ctx, dave, v2types.Direction_LONG, 1500))
+
+	t.Log("the limits are exposed through the market query")
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).Market(sdk.WrapSDKContext(ctx), &v2types.QueryMarketRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5000), resp.Market.MaxOpenInterest)
+	require.Equal(t, sdk.NewDec(3000), resp.Market.MaxTraderNotional)
+	require.Equal(t, sdk.NewDec(4000), resp.Market.MaxBias)
+}
+
+func TestPositionAccounting(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e6))))
+	openTime := ctx.BlockTime()
+
+	getPosition := func() v2types.Position {
+		resp, err := keeper.NewQuerier(app.PerpKeeperV2).QueryPosition(sdk.WrapSDKContext(ctx), &v2types.QueryPositionRequest{Pair: pair, Trader: alice.String()})
+		require.NoError(t, err)
+		return resp.Position
+	}
+
+	t.Log("opening the position starts its accounting")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	feesPaid := positionChangedEvent(t, ctx).TransactionFee.Amount.ToDec()
+	position := getPosition()
+	require.Equal(t, sdk.ZeroDec(), position.RealizedPnl)
+	require.Equal(t, sdk.ZeroDec(), position.FundingPaid)
+	require.Equal(t, feesPaid, position.FeesPaid)
+	require.Equal(t, openTime.UnixMilli(), position.EntryTimeMs)
+
+	t.Log("increasing the position adds its fees, the entry time is kept")
+	ctx = ctx.WithBlockTime(openTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	feesPaid = feesPaid.Add(positionChangedEvent(t, ctx).TransactionFee.Amount.ToDec())
+	position = getPosition()
+	require.Equal(t, feesPaid, position.FeesPaid)
+	require.Equal(t, openTime.UnixMilli(), position.EntryTimeMs)
+
+	t.Log("adding margin settles the funding payment")
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+	require.NoError(t, err)
+	market.LatestCumulativePremiumFraction = sdk.MustNewDecFromStr("0.001")
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+	fundingPaid := keeper.FundingPayment(position, market.LatestCumulativePremiumFraction)
+	require.True(t, fundingPaid.IsPositive())
+	_, err = app.PerpKeeperV2.AddMargin(ctx, pair, alice, sdk.NewInt64Coin(denoms.NUSD, 100))
+	require.NoError(t, err)
+	require.Equal(t, fundingPaid, getPosition().FundingPaid)
+
+	t.Log("reducing the position realizes PnL")
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e6))))
+	require.NoError(t, app.PerpKeeperV2.EditPriceMultiplier(ctx, pair, sdk.MustNewDecFromStr("1.05")))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	resp, err := app.PerpKeeperV2.PartialClose(ctx, pair, alice, position.Size_.QuoInt64(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	feesPaid = feesPaid.Add(positionChangedEvent(t, ctx).TransactionFee.Amount.ToDec())
+	position = getPosition()
+	require.True(t, resp.RealizedPnl.IsPositive())
+	require.Equal(t, resp.RealizedPnl, position.RealizedPnl)
+	require.Equal(t, fundingPaid, position.FundingPaid)
+	require.Equal(t, feesPaid, position.FeesPaid)
+	require.Equal(t, openTime.UnixMilli(), position.EntryTimeMs)
+
+	t.Log("a full close resets the accounting")
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	reopenTime := openTime.Add(2 * time.Hour)
+	ctx = ctx.WithBlockTime(reopenTime).WithEventManager(sdk.NewEventManager())
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_SHORT, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	position = getPosition()
+	require.Equal(t, sdk.ZeroDec(), position.RealizedPnl)
+	require.Equal(t, sdk.ZeroDec(), position.FundingPaid)
+	require.Equal(t, positionChangedEvent(t, ctx).TransactionFee.Amount.ToDec(), position.FeesPaid)
+	require.Equal(t, reopenTime.UnixMilli(), position.EntryTimeMs)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// SetCollateralAsset accepts an asset as cross-margin collateral, or updates
+// the haircut and spot pool of an asset already accepted. The asset backs the
+// positions quoted in the quote denom of its oracle pair, which must be the
+// quote denom of a market, and its spot pool must trade it for that denom.
+func (k Keeper) SetCollateralAsset(ctx sdk.Context, collateralAsset v2types.CollateralAsset) error {
+	if err := collateralAsset.Validate(); err != nil {
+		return err
+	}
+
+	if _, found := k.marketQuotedIn(ctx, collateralAsset.QuoteDenom()); !found {
+		return v2types.ErrPairNotFound.Wrapf("no market is quoted in %s", collateralAsset.QuoteDenom())
+	}
+
+	// quote denoms are already collateral, at face value
+	if _, found := k.marketQuotedIn(ctx, collateralAsset.Denom); found {
+		return v2types.ErrCollateralNotAccepted.Wrapf("%s is the quote denom of a market", collateralAsset.Denom)
+	}
+
+	pool, err := k.SpotKeeper.FetchPool(ctx, collateralAsset.SpotPoolId)
+	if err != nil {
+		return err
+	}
+	if !pool.AreTokensInDenomInPoolAssets(sdk.NewCoins(
+		sdk.NewInt64Coin(collateralAsset.Denom, 1),
+		sdk.NewInt64Coin(collateralAsset.QuoteDenom(), 1),
+	)) {
+		return v2types.ErrCollateralNotAccepted.Wrapf(
+			"spot pool %d doesn't trade %s for %s", collateralAsset.SpotPoolId, collateralAsset.Denom, collateralAsset.QuoteDenom())
+	}
+
+	k.CollateralAssets.Insert(ctx, collateralAsset.Denom, collateralAsset)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.CollateralAssetUpdatedEvent{
+		CollateralAsset: collateralAsset,
+	})
+}
+
+// collateralQuoteDenom returns the quote denom of the positions backed by
+// collateral in denom: the quote denom of its oracle pair for an accepted
+// asset, denom itself otherwise.
+func (k Keeper) collateralQuoteDenom(ctx sdk.Context, denom string) string {
+	collateralAsset, err := k.CollateralAssets.Get(ctx, denom)
+	if err != nil {
+		return denom
+	}
+	return collateralAsset.QuoteDenom()
+}
+
+// collateralValue returns the value in denom of the collateral of the account
+// backing the positions quoted in denom, quote denom aside: each asset counts
+// at its oracle price less its haircut. Assets without an oracle price count
+// for nothing.
+func (k Keeper) collateralValue(ctx sdk.Context, account v2types.CrossMarginAccount, denom string) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range account.Collateral {
+		collateralAsset, err := k.CollateralAssets.Get(ctx, coin.Denom)
+		if err != nil || collateralAsset.QuoteDenom() != denom {
+			continue
+		}
+
+		price, err := k.OracleKeeper.GetExchangeRate(ctx, collateralAsset.OraclePair)
+		if err != nil || !price.IsPositive() {
+			continue
+		}
+
+		value = value.Add(coin.Amount.ToDec().Mul(price).Mul(sdk.OneDec().Sub(collateralAsset.Haircut)))
+	}
+	return value
+}
+
+// sellCollateral sells the collateral of the cross-margin account of the
+// trader backing the positions quoted in denom through x/spot, and credits the
+// account with the proceeds in denom. An asset must sell for at least the value
+// it counts for in the account equity, its oracle price less its haircut, so a
+// thin or manipulated pool can't drain it. An asset that can't be sold, e.g.
+// for lack of liquidity or of an oracle price, is left in the account.
+//
+// returns:
+//   - unsold: the collateral backing denom that couldn't be sold
+//   - err: error if any
+func (k Keeper) sellCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, denom string) (unsold sdk.Coins, err error) {
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return nil, v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	// the collateral sits in the vault, which sells it
+	vaultAddr := k.AccountKeeper.GetModuleAddress(v2types.VaultModuleAccount)
+	for _, coin := range account.Collateral {
+		collateralAsset, err := k.CollateralAssets.Get(ctx, coin.Denom)
+		if err != nil || collateralAsset.QuoteDenom() != denom {
+			continue
+		}
+
+		price, err := k.OracleKeeper.GetExchangeRate(ctx, collateralAsset.OraclePair)
+		if err != nil || !price.IsPositive() {
+			k.Logger(ctx).Error("failed to sell collateral without an oracle price", "trader", traderAddr, "collateral", coin, "error", err)
+			unsold = unsold.Add(coin)
+			continue
+		}
+		minProceeds := coin.Amount.ToDec().Mul(price).Mul(sdk.OneDec().Sub(collateralAsset.Haircut)).Ceil().TruncateInt()
+
+		cachedCtx, commit := ctx.CacheContext()
+		proceeds, err := k.SpotKeeper.SwapExactAmountIn(cachedCtx, vaultAddr, collateralAsset.SpotPoolId, coin, denom)
+		if err == nil && proceeds.Amount.LT(minProceeds) {
+			err = v2types.ErrAssetFailsUserLimit.Wrapf("proceeds %s are below %s%s", proceeds, minProceeds, denom)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("failed to sell collateral", "trader", traderAddr, "collateral", coin, "error", err)
+			unsold = unsold.Add(coin)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		account.Collateral = account.Collateral.Sub(sdk.NewCoins(coin)).Add(proceeds)
+		if err = ctx.EventManager().EmitTypedEvent(&v2types.CollateralSoldEvent{
+			TraderAddress: traderAddr.String(),
+			Collateral:    coin,
+			Proceeds:      proceeds,
+			SpotPoolId:    collateralAsset.SpotPoolId,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+	return unsold, nil
+}
+
+// seizeCollateral moves the collateral of the cross-margin account of the
+// trader that couldn't be sold from the vault to the ecosystem fund, so the
+// trader doesn't keep it once their positions are closed and their shortfall
+// is realized as bad debt.
+func (k Keeper) seizeCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coins) error {
+	if collateral.IsZero() {
+		return nil
+	}
+
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx,
+		/* from */ v2types.VaultModuleAccount,
+		/* to */ v2types.PerpEFModuleAccount,
+		collateral,
+	); err != nil {
+		return err
+	}
+	account.Collateral = account.Collateral.Sub(collateral)
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	for _, coin := range collateral {
+		if err = ctx.EventManager().EmitTypedEvent(&v2types.CollateralSeizedEvent{
+			TraderAddress: traderAddr.String(),
+			Collateral:    coin,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// newNibiPool creates a unibi:unusd spot pool holding poolAssets.
+func newNibiPool(t *testing.T, app *app.NibiruApp, ctx sdk.Context, poolAssets sdk.Coins) uint64 {
+	app.SpotKeeper.SetParams(ctx, spottypes.NewParams(1, sdk.NewCoins(), []string{denoms.NIBI, denoms.NUSD}))
+	poolCreator := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolCreator, poolAssets))
+	poolID, err := app.SpotKeeper.NewPool(ctx, poolCreator, spottypes.PoolParams{
+		SwapFee:  sdk.ZeroDec(),
+		ExitFee:  sdk.ZeroDec(),
+		PoolType: spottypes.PoolType_BALANCER,
+		A:        sdk.ZeroInt(),
+	}, []spottypes.PoolAsset{
+		{Token: poolAssets[0], Weight: sdk.OneInt()},
+		{Token: poolAssets[1], Weight: sdk.OneInt()},
+	})
+	require.NoError(t, err)
+	return poolID
+}
+
+func TestCrossMarginMultiCollateral(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	nibi := asset.NewPair(denoms.NIBI, denoms.NUSD)
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 100)
+	app.OracleKeeper.SetPrice(ctx, nibi, sdk.NewDec(2))
+
+	// a unibi:unusd spot pool at a price of 2
+	poolID := newNibiPool(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1e6), sdk.NewInt64Coin(denoms.NUSD, 2e6)))
+
+	t.Log("unibi is only accepted once governance registers it")
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 600))))
+	_, err := app.PerpKeeperV2.AddCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+	require.ErrorIs(t, err, v2types.ErrCollateralNotAccepted)
+
+	collateralAsset := v2types.CollateralAsset{
+		Denom:      denoms.NIBI,
+		OraclePair: nibi,
+		Haircut:    sdk.MustNewDecFromStr("0.25"),
+		SpotPoolId: poolID + 1,
+	}
+	require.Error(t, app.PerpKeeperV2.SetCollateralAsset(ctx, collateralAsset), "unknown spot pool")
+	collateralAsset.SpotPoolId = poolID
+	require.NoError(t, app.PerpKeeperV2.SetCollateralAsset(ctx, collateralAsset))
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.CollateralAssetUpdatedEvent{CollateralAsset: collateralAsset})
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).CollateralAssets(sdk.WrapSDKContext(ctx), &v2types.QueryCollateralAssetsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []v2types.CollateralAsset{collateralAsset}, resp.CollateralAssets)
+
+	t.Log("600 unibi at a price of 2 less a haircut of 25% count for 900 unusd")
+	_, err = app.PerpKeeperV2.AddCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+	require.NoError(t, err)
+	accountMargin, err := app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), accountMargin.Equity)
+
+	// the btc long lost ~1001
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	accountMargin, err = app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, accountMargin.Equity.GT(accountMargin.MaintenanceMargin))
+
+	t.Log("unibi can't be removed below the maintenance margin")
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = app.PerpKeeperV2.RemoveCrossMarginCollateral(cachedCtx, alice, sdk.NewInt64Coin(denoms.NIBI, 100))
+	require.ErrorIs(t, err, v2types.ErrMarginRatioTooLow)
+	account, err := app.PerpKeeperV2.RemoveCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 590), sdk.NewInt64Coin(denoms.NUSD, 100)), account.Collateral)
+
+	t.Log("a drop of the unibi price pushes the account below its maintenance margin")
+	app.OracleKeeper.SetPrice(ctx, nibi, sdk.MustNewDecFromStr("1.8"))
+	accountMargin, err = app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, accountMargin.Equity.LT(accountMargin.MaintenanceMargin))
+
+	liquidations, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, liquidations[0].Success)
+
+	t.Log("the unibi is sold through the spot pool and consumed with the unusd")
+	pool, err := app.SpotKeeper.FetchPool(ctx, poolID)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1e6+590), pool.PoolBalances().AmountOf(denoms.NIBI))
+	proceeds := sdk.NewCoin(denoms.NUSD, sdk.NewInt(2e6).Sub(pool.PoolBalances().AmountOf(denoms.NUSD)))
+	require.True(t, proceeds.Amount.IsPositive())
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.CollateralSoldEvent{
+		TraderAddress: alice.String(),
+		Collateral:    sdk.NewInt64Coin(denoms.NIBI, 590),
+		Proceeds:      proceeds,
+		SpotPoolId:    poolID,
+	})
+
+	account, err = app.PerpKeeperV2.CrossMarginAccounts.Get(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, account.Collateral.IsZero())
+	require.True(t, liquidations[0].PerpEfFee.Amount.IsPositive(), "the remaining equity goes to the ecosystem fund")
+}
+
+func TestSellCollateralBelowOraclePrice(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	nibi := asset.NewPair(denoms.NIBI, denoms.NUSD)
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 100)
+	app.OracleKeeper.SetPrice(ctx, nibi, sdk.NewDec(2))
+
+	// the spot pool trades unibi at 1, below the oracle price less the haircut
+	poolAssets := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1e6), sdk.NewInt64Coin(denoms.NUSD, 1e6))
+	poolID := newNibiPool(t, app, ctx, poolAssets)
+	require.NoError(t, app.PerpKeeperV2.SetCollateralAsset(ctx, v2types.CollateralAsset{
+		Denom:      denoms.NIBI,
+		OraclePair: nibi,
+		Haircut:    sdk.MustNewDecFromStr("0.25"),
+		SpotPoolId: poolID,
+	}))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 600))))
+	_, err := app.PerpKeeperV2.AddCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+	require.NoError(t, err)
+
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	app.OracleKeeper.SetPrice(ctx, nibi, sdk.MustNewDecFromStr("1.8"))
+
+	liquidations, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, liquidations[0].Success)
+
+	t.Log("the unibi can't be sold for 1.35 unusd each, it is seized into the ecosystem fund")
+	testutil.RequireNotHasTypedEvent(t, ctx, &v2types.CollateralSoldEvent{})
+	pool, err := app.SpotKeeper.FetchPool(ctx, poolID)
+	require.NoError(t, err)
+	require.Equal(t, poolAssets, pool.PoolBalances())
+	requireCollateralSeized(t, app, ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+}
+
+func TestSeizeCollateralWithoutOraclePrice(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	nibi := asset.NewPair(denoms.NIBI, denoms.NUSD)
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 100)
+	app.OracleKeeper.SetPrice(ctx, nibi, sdk.NewDec(2))
+
+	poolID := newNibiPool(t, app, ctx, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1e6), sdk.NewInt64Coin(denoms.NUSD, 2e6)))
+	require.NoError(t, app.PerpKeeperV2.SetCollateralAsset(ctx, v2types.CollateralAsset{
+		Denom:      denoms.NIBI,
+		OraclePair: nibi,
+		Haircut:    sdk.MustNewDecFromStr("0.25"),
+		SpotPoolId: poolID,
+	}))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 600))))
+	_, err := app.PerpKeeperV2.AddCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+	require.NoError(t, err)
+
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	require.NoError(t, app.OracleKeeper.ExchangeRates.Delete(ctx, nibi))
+
+	liquidations, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, liquidations[0].Success)
+
+	t.Log("the sale fails without an oracle price, the unibi is seized before the bad debt is realized")
+	testutil.RequireNotHasTypedEvent(t, ctx, &v2types.CollateralSoldEvent{})
+	requireCollateralSeized(t, app, ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 600))
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(btc, alice))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+// requireCollateralSeized checks the collateral left the cross-margin account
+// of the trader for the ecosystem fund.
+func requireCollateralSeized(t *testing.T, app *app.NibiruApp, ctx sdk.Context, trader sdk.AccAddress, collateral sdk.Coin) {
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.CollateralSeizedEvent{
+		TraderAddress: trader.String(),
+		Collateral:    collateral,
+	})
+	account, err := app.PerpKeeperV2.CrossMarginAccounts.Get(ctx, trader)
+	require.NoError(t, err)
+	require.True(t, account.Collateral.AmountOf(collateral.Denom).IsZero())
+	require.Equal(t, collateral, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), collateral.Denom))
+	require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.VaultModuleAccount), collateral.Denom).IsZero())
+}
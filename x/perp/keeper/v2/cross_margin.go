@@ -0,0 +1,397 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// EnableCrossMargin opens an empty cross-margin account for the trader. From
+// then on, the positions of the trader quoted in the same denom share the
+// collateral of the account and are liquidated together.
+func (k Keeper) EnableCrossMargin(ctx sdk.Context, traderAddr sdk.AccAddress) error {
+	if k.isCrossMargin(ctx, traderAddr) {
+		return v2types.ErrCrossMarginAlreadyEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	account := v2types.CrossMarginAccount{
+		TraderAddress: traderAddr.String(),
+		Collateral:    sdk.NewCoins(),
+	}
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountUpdatedEvent{
+		Account: account,
+	})
+}
+
+// DisableCrossMargin closes the cross-margin account of the trader and refunds
+// its collateral. Every position of the trader must be above the maintenance
+// margin ratio of its market on its own.
+func (k Keeper) DisableCrossMargin(ctx sdk.Context, traderAddr sdk.AccAddress) (refunded sdk.Coins, err error) {
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return nil, v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if market.Settled {
+			continue
+		}
+
+		position, err := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+		if err != nil {
+			continue
+		}
+
+		positionNotional, err := k.maxPositionNotional(ctx, market, position)
+		if err != nil {
+			return nil, err
+		}
+
+		if MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction).LT(market.MaintenanceMarginRatio) {
+			return nil, v2types.ErrMarginRatioTooLow.Wrapf("position on %s can't stand on its own margin", market.Pair)
+		}
+	}
+
+	for _, coin := range account.Collateral {
+		if err = k.withdrawCollateral(ctx, traderAddr, coin); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.CrossMarginAccounts.Delete(ctx, traderAddr); err != nil {
+		return nil, err
+	}
+
+	refunded = account.Collateral
+	account.Collateral = sdk.NewCoins()
+	return refunded, ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountUpdatedEvent{
+		Account: account,
+	})
+}
+
+// AddCrossMarginCollateral deposits collateral from the trader into its
+// cross-margin account. The collateral must be the quote denom of a market or
+// an asset accepted as collateral.
+func (k Keeper) AddCrossMarginCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coin) (account v2types.CrossMarginAccount, err error) {
+	account, err = k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return account, v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	if _, found := k.marketQuotedIn(ctx, collateral.Denom); !found {
+		if _, err = k.CollateralAssets.Get(ctx, collateral.Denom); err != nil {
+			return account, v2types.ErrCollateralNotAccepted.Wrapf("no market is quoted in %s", collateral.Denom)
+		}
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx, traderAddr, v2types.VaultModuleAccount, sdk.NewCoins(collateral),
+	); err != nil {
+		return account, err
+	}
+
+	account.Collateral = account.Collateral.Add(collateral)
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	return account, ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountUpdatedEvent{
+		Account: account,
+	})
+}
+
+// RemoveCrossMarginCollateral withdraws collateral from the cross-margin
+// account of the trader. Fails if the account would fall below its
+// maintenance margin.
+func (k Keeper) RemoveCrossMarginCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coin) (account v2types.CrossMarginAccount, err error) {
+	account, err = k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return account, v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	newCollateral, isNegative := account.Collateral.SafeSub(sdk.NewCoins(collateral))
+	if isNegative {
+		return account, v2types.ErrInvalidAmount.Wrapf("cannot remove %s from a collateral of %s", collateral, account.Collateral)
+	}
+
+	account.Collateral = newCollateral
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	if err = k.withdrawCollateral(ctx, traderAddr, collateral); err != nil {
+		return account, err
+	}
+
+	if err = k.checkCrossMarginHealthy(ctx, traderAddr, k.collateralQuoteDenom(ctx, collateral.Denom)); err != nil {
+		return account, err
+	}
+
+	return account, ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountUpdatedEvent{
+		Account: account,
+	})
+}
+
+// CrossMarginAccountMargin aggregates the collateral of the cross-margin
+// account of the trader and its positions quoted in denom. The collateral in
+// denom counts at face value, the assets backing denom at their oracle price
+// less their haircut. Positions of settled markets are left out, they are
+// settled on their own.
+func (k Keeper) CrossMarginAccountMargin(ctx sdk.Context, traderAddr sdk.AccAddress, denom string) (v2types.AccountMargin, error) {
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return v2types.AccountMargin{}, v2types.ErrCrossMarginNotEnabled.Wrapf("trader: %s", traderAddr)
+	}
+
+	accountMargin := v2types.AccountMargin{
+		Denom:             denom,
+		Equity:            account.Collateral.AmountOf(denom).ToDec().Add(k.collateralValue(ctx, account, denom)),
+		PositionNotional:  sdk.ZeroDec(),
+		MaintenanceMargin: sdk.ZeroDec(),
+		MarginRatio:       sdk.ZeroDec(),
+	}
+
+	positions, err := k.crossMarginPositions(ctx, traderAddr, denom)
+	if err != nil {
+		return v2types.AccountMargin{}, err
+	}
+
+	for _, p := range positions {
+		positionNotional, err := k.maxPositionNotional(ctx, p.market, p.position)
+		if err != nil {
+			return v2types.AccountMargin{}, err
+		}
+
+		accountMargin.Equity = accountMargin.Equity.
+			Add(p.position.Margin).
+			Add(UnrealizedPnl(p.position, positionNotional)).
+			Sub(FundingPayment(p.position, p.market.LatestCumulativePremiumFraction))
+		accountMargin.PositionNotional = accountMargin.PositionNotional.Add(positionNotional)
+		accountMargin.MaintenanceMargin = accountMargin.MaintenanceMargin.Add(positionNotional.Mul(p.market.MaintenanceMarginRatio))
+	}
+
+	if accountMargin.PositionNotional.IsPositive() {
+		accountMargin.MarginRatio = accountMargin.Equity.Quo(accountMargin.PositionNotional)
+	}
+
+	return accountMargin, nil
+}
+
+// liquidateCrossMarginAccount closes every position of the cross-margin
+// account quoted in denom once the account equity is below its maintenance
+// margin. The collateral backing denom is first sold through x/spot, and what
+// can't be sold is seized into the ecosystem fund. The quote denom collateral
+// of the account and the margin of the positions are then pooled:
+// the liquidation fee is paid out of them, the rest goes to the ecosystem fund
+// and any shortfall is realized as bad debt.
+func (k Keeper) liquidateCrossMarginAccount(
+	ctx sdk.Context,
+	liquidator sdk.AccAddress,
+	traderAddr sdk.AccAddress,
+	denom string,
+) (liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin, err error) {
+	accountMargin, err := k.CrossMarginAccountMargin(ctx, traderAddr, denom)
+	if err != nil {
+		return
+	}
+
+	positions, err := k.crossMarginPositions(ctx, traderAddr, denom)
+	if err != nil {
+		return
+	}
+
+	if len(positions) == 0 || accountMargin.Equity.GTE(accountMargin.MaintenanceMargin) {
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrPositionHealthy.Wrapf("cross-margin account of %s", traderAddr)
+	}
+
+	unsold, err := k.sellCollateral(ctx, traderAddr, denom)
+	if err != nil {
+		return
+	}
+	if err = k.seizeCollateral(ctx, traderAddr, unsold); err != nil {
+		return
+	}
+
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return
+	}
+	collateral := account.Collateral.AmountOf(denom)
+
+	// the collateral and the margins are already in the vault
+	remainingMargin := collateral.ToDec()
+	feeToLiquidator := sdk.ZeroDec()
+	pairs := make([]asset.Pair, 0, len(positions))
+	for _, p := range positions {
+		_, positionResp, err := k.closePositionEntirely(ctx, p.market, p.amm, p.position, sdk.ZeroDec())
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+
+		remainingMargin = remainingMargin.Sub(positionResp.MarginToVault).Sub(positionResp.BadDebt)
+		feeToLiquidator = feeToLiquidator.Add(p.market.LiquidationFeeRatio.Mul(positionResp.ExchangedNotionalValue).QuoInt64(2))
+		pairs = append(pairs, p.market.Pair)
+	}
+	equity := remainingMargin
+
+	badDebt := sdk.ZeroDec()
+	feeToPerpEcosystemFund := sdk.ZeroDec()
+	if feeToLiquidator.GT(remainingMargin) {
+		// a negative pooled margin is a shortfall of the account
+		badDebt = feeToLiquidator.Sub(remainingMargin)
+	} else {
+		feeToPerpEcosystemFund = remainingMargin.Sub(feeToLiquidator)
+	}
+
+	market := positions[0].market
+	if badDebt.IsPositive() {
+		// the account may have held both sides of the market
+		if err = k.realizeBadDebt(ctx, market, badDebt.RoundInt(), v2types.Direction_DIRECTION_UNSPECIFIED); err != nil {
+			return
+		}
+	}
+
+	if err = k.distributeLiquidateRewards(ctx, market, v2types.LiquidateResp{
+		BadDebt:                badDebt.RoundInt(),
+		FeeToLiquidator:        feeToLiquidator.RoundInt(),
+		FeeToPerpEcosystemFund: feeToPerpEcosystemFund.RoundInt(),
+		Liquidator:             liquidator.String(),
+	}); err != nil {
+		return
+	}
+
+	account.Collateral = account.Collateral.Sub(sdk.NewCoins(sdk.NewCoin(denom, collateral)))
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	liquidatorFee = sdk.NewCoin(denom, feeToLiquidator.RoundInt())
+	ecosystemFundFee = sdk.NewCoin(denom, feeToPerpEcosystemFund.RoundInt())
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountLiquidatedEvent{
+		TraderAddress:      traderAddr.String(),
+		LiquidatorAddress:  liquidator.String(),
+		Pairs:              pairs,
+		Equity:             sdk.NewCoin(denom, sdk.MaxDec(equity, sdk.ZeroDec()).RoundInt()),
+		FeeToLiquidator:    liquidatorFee,
+		FeeToEcosystemFund: ecosystemFundFee,
+		BadDebt:            sdk.NewCoin(denom, badDebt.RoundInt()),
+	})
+
+	return liquidatorFee, ecosystemFundFee, nil
+}
+
+// coverBadDebtWithCollateral pays the bad debt of a position closed by a
+// cross-margin trader out of the account collateral, which already sits in
+// the vault. Fails if the collateral is not enough.
+func (k Keeper) coverBadDebtWithCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, denom string, badDebt sdk.Dec) error {
+	account, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return err
+	}
+
+	newCollateral, isNegative := account.Collateral.SafeSub(sdk.NewCoins(sdk.NewCoin(denom, badDebt.Ceil().RoundInt())))
+	if isNegative {
+		return v2types.ErrMarginRatioTooLow.Wrapf("collateral of %s can't cover a bad debt of %s%s", account.Collateral, badDebt, denom)
+	}
+
+	account.Collateral = newCollateral
+	k.CrossMarginAccounts.Insert(ctx, traderAddr, account)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.CrossMarginAccountUpdatedEvent{
+		Account: account,
+	})
+}
+
+// checkCrossMarginHealthy errors if the cross-margin account of the trader is
+// below its maintenance margin in denom.
+func (k Keeper) checkCrossMarginHealthy(ctx sdk.Context, traderAddr sdk.AccAddress, denom string) error {
+	accountMargin, err := k.CrossMarginAccountMargin(ctx, traderAddr, denom)
+	if err != nil {
+		return err
+	}
+
+	if accountMargin.Equity.LT(accountMargin.MaintenanceMargin) {
+		return v2types.ErrMarginRatioTooLow.Wrapf(
+			"account equity %s is below its maintenance margin %s", accountMargin.Equity, accountMargin.MaintenanceMargin)
+	}
+
+	return nil
+}
+
+func (k Keeper) isCrossMargin(ctx sdk.Context, traderAddr sdk.AccAddress) bool {
+	_, err := k.CrossMarginAccounts.Get(ctx, traderAddr)
+	return err == nil
+}
+
+type crossMarginPosition struct {
+	market   v2types.Market
+	amm      v2types.AMM
+	position v2types.Position
+}
+
+// crossMarginPositions returns the positions of the trader on the live markets
+// quoted in denom.
+func (k Keeper) crossMarginPositions(ctx sdk.Context, traderAddr sdk.AccAddress, denom string) ([]crossMarginPosition, error) {
+	var positions []crossMarginPosition
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if market.Settled || market.Pair.QuoteDenom() != denom {
+			continue
+		}
+
+		position, err := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+		if err != nil || position.Size_.IsZero() {
+			continue
+		}
+
+		amm, err := k.AMMs.Get(ctx, market.Pair)
+		if err != nil {
+			return nil, v2types.ErrPairNotFound.Wrapf("pair: %s", market.Pair)
+		}
+
+		positions = append(positions, crossMarginPosition{
+			market:   market,
+			amm:      amm,
+			position: position,
+		})
+	}
+	return positions, nil
+}
+
+// maxPositionNotional returns the greatest of the spot and TWAP notionals of
+// the position, the one used to check its margin ratio.
+func (k Keeper) maxPositionNotional(ctx sdk.Context, market v2types.Market, position v2types.Position) (sdk.Dec, error) {
+	amm, err := k.AMMs.Get(ctx, market.Pair)
+	if err != nil {
+		return sdk.Dec{}, v2types.ErrPairNotFound.Wrapf("pair: %s", market.Pair)
+	}
+
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return sdk.MaxDec(spotNotional, twapNotional), nil
+}
+
+// marketQuotedIn returns the first market quoted in denom.
+func (k Keeper) marketQuotedIn(ctx sdk.Context, denom string) (v2types.Market, bool) {
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if market.Pair.QuoteDenom() == denom {
+			return market, true
+		}
+	}
+	return v2types.Market{}, false
+}
+
+// withdrawCollateral sends collateral back from the vault to the trader,
+// drawing on the ecosystem fund through Withdraw if the vault runs short.
+func (k Keeper) withdrawCollateral(ctx sdk.Context, traderAddr sdk.AccAddress, collateral sdk.Coin) error {
+	market, found := k.marketQuotedIn(ctx, collateral.Denom)
+	if !found {
+		return k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx, v2types.VaultModuleAccount, traderAddr, sdk.NewCoins(collateral),
+		)
+	}
+	return k.Withdraw(ctx, market, traderAddr, collateral.Amount)
+}
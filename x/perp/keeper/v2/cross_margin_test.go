@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// setupCrossMarginMarkets creates a btc and an eth market at a price of 1, and
+// a cross-margin account for the trader with the given collateral.
+func setupCrossMarginMarkets(t *testing.T, trader sdk.AccAddress, collateral int64) (*app.NibiruApp, sdk.Context) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	for _, pair := range []asset.Pair{
+		asset.NewPair(denoms.BTC, denoms.NUSD),
+		asset.NewPair(denoms.ETH, denoms.NUSD),
+	} {
+		market := *mock.TestMarket()
+		market.Pair = pair
+		amm := *mock.TestAMM(sdk.NewDec(1e6), sdk.OneDec())
+		amm.Pair = pair
+		app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+		app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, ctx.BlockTime()), v2types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: ctx.BlockTime().UnixMilli(),
+		})
+	}
+
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, collateral+10))))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e4))))
+
+	require.NoError(t, app.PerpKeeperV2.EnableCrossMargin(ctx, trader))
+	require.ErrorIs(t, app.PerpKeeperV2.EnableCrossMargin(ctx, trader), v2types.ErrCrossMarginAlreadyEnabled)
+	_, err := app.PerpKeeperV2.AddCrossMarginCollateral(ctx, trader, sdk.NewInt64Coin(denoms.NUSD, collateral))
+	require.NoError(t, err)
+
+	return app, ctx
+}
+
+// insertPosition stores a position with the given margin and moves its margin
+// to the vault.
+func insertPosition(t *testing.T, app *app.NibiruApp, ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, size, openNotional, margin int64) {
+	app.PerpKeeperV2.SetPosition(ctx, trader, v2types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(size),
+		Margin:                          sdk.NewDec(margin),
+		OpenNotional:                    sdk.NewDec(openNotional),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+	require.NoError(t, err)
+	if size > 0 {
+		amm.TotalLong = amm.TotalLong.Add(sdk.NewDec(size))
+	} else {
+		amm.TotalShort = amm.TotalShort.Add(sdk.NewDec(-size))
+	}
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, margin))))
+}
+
+func TestCrossMarginLiquidation(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 1000)
+
+	// the btc long lost ~1001 and is underwater on its own, the eth short is
+	// about even
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	insertPosition(t, app, ctx, eth, alice, -1000, 1000, 100)
+
+	accountMargin, err := app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, accountMargin.Equity.GT(accountMargin.MaintenanceMargin))
+
+	t.Log("the account collateral keeps the btc long alive")
+	_, err = app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.ErrorContains(t, err, v2types.ErrAllLiquidationsFailed.Error())
+
+	t.Log("collateral can't be removed below the maintenance margin")
+	// a failed tx is reverted, which the cached context stands for
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = app.PerpKeeperV2.RemoveCrossMarginCollateral(cachedCtx, alice, sdk.NewInt64Coin(denoms.NUSD, 100))
+	require.ErrorIs(t, err, v2types.ErrMarginRatioTooLow)
+	account, err := app.PerpKeeperV2.RemoveCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NUSD, 50))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 950)), account.Collateral)
+
+	t.Log("cross margin can't be disabled while the btc long is underwater")
+	cachedCtx, _ = ctx.CacheContext()
+	_, err = app.PerpKeeperV2.DisableCrossMargin(cachedCtx, alice)
+	require.ErrorIs(t, err, v2types.ErrMarginRatioTooLow)
+
+	t.Log("a funding payment pushes the account below its maintenance margin")
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, btc)
+	require.NoError(t, err)
+	market.LatestCumulativePremiumFraction = sdk.MustNewDecFromStr("0.1")
+	app.PerpKeeperV2.Markets.Insert(ctx, btc, market)
+
+	accountMargin, err = app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, accountMargin.Equity.LT(accountMargin.MaintenanceMargin))
+	require.True(t, accountMargin.Equity.IsPositive())
+
+	efBefore := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD)
+	resp, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, resp[0].Success)
+
+	t.Log("both legs are closed and the collateral is consumed")
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(btc, alice))
+	require.Error(t, err)
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(eth, alice))
+	require.Error(t, err)
+
+	account, err = app.PerpKeeperV2.CrossMarginAccounts.Get(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, account.Collateral.IsZero())
+
+	efAfter := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD)
+	require.Equal(t, efBefore.Amount.Add(resp[0].PerpEfFee.Amount), efAfter.Amount)
+	require.True(t, resp[0].PerpEfFee.Amount.IsPositive(), "the remaining equity goes to the ecosystem fund")
+
+	t.Log("the shortfall of an underwater account is realized as bad debt")
+	app, ctx = setupCrossMarginMarkets(t, alice, 500)
+	// the btc long lost ~1001, ~401 more than its margin and the collateral
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+
+	accountMargin, err = app.PerpKeeperV2.CrossMarginAccountMargin(ctx, alice, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, accountMargin.Equity.IsNegative())
+
+	efBefore = app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD)
+	resp, err = app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, resp[0].Success)
+
+	// the ecosystem fund pays the shortfall and the liquidator fee into the vault
+	efAfter = app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD)
+	require.Equal(t, efBefore.Amount.SubRaw(401), efAfter.Amount)
+	msg, broken := keeper.VaultSolvencyInvariant(app.PerpKeeperV2)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestCrossMarginCollateralCoversLosses(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 1000)
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+
+	t.Log("the underwater long is closed, its loss is paid by the collateral")
+	resp, err := app.PerpKeeperV2.ClosePosition(ctx, btc, alice)
+	require.NoError(t, err)
+	require.True(t, resp.BadDebt.IsZero())
+
+	account, err := app.PerpKeeperV2.CrossMarginAccounts.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 99)), account.Collateral)
+
+	t.Log("disabling cross margin refunds the collateral left")
+	balanceBefore := app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD)
+	refunded, err := app.PerpKeeperV2.DisableCrossMargin(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, account.Collateral, refunded)
+	require.Equal(t, balanceBefore.AddAmount(sdk.NewInt(99)), app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD))
+
+	_, err = app.PerpKeeperV2.CrossMarginAccounts.Get(ctx, alice)
+	require.Error(t, err)
+	_, err = app.PerpKeeperV2.AddCrossMarginCollateral(ctx, alice, sdk.NewInt64Coin(denoms.NUSD, 1))
+	require.ErrorIs(t, err, v2types.ErrCrossMarginNotEnabled)
+}
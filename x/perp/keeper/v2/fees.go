@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// exchangeFeeRatio returns the exchange fee ratio the trader pays on the
+// market: the ratio of the highest fee tier their rolling quote volume
+// qualifies for, or the exchange fee ratio of the market below the first tier.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - market: the market traded on
+//   - trader: the trader's address
+//   - isMaker: whether the trade executes a maker order
+//
+// returns:
+//   - feeTier: the 1-based index of the fee tier, 0 if no tier applies
+//   - ratio: the exchange fee ratio, negative for a maker rebate
+func (k Keeper) exchangeFeeRatio(
+	ctx sdk.Context, market v2types.Market, trader sdk.AccAddress, isMaker bool,
+) (feeTier uint64, ratio sdk.Dec) {
+	ratio = market.ExchangeFeeRatio
+
+	tiers := k.GetParams(ctx).FeeTiers
+	if len(tiers) == 0 {
+		return 0, ratio
+	}
+
+	volume := k.TraderVolume(ctx, trader)
+	for i, tier := range tiers {
+		if volume.LT(tier.MinVolume) {
+			break
+		}
+
+		feeTier = uint64(i + 1)
+		if isMaker {
+			ratio = tier.MakerFeeRatio
+		} else {
+			ratio = tier.TakerFeeRatio
+		}
+	}
+
+	return feeTier, ratio
+}
+
+// TraderVolume returns the quote volume traded by the trader over the fee tier
+// window, which ends on the current day.
+func (k Keeper) TraderVolume(ctx sdk.Context, trader sdk.AccAddress) sdk.Dec {
+	volume := sdk.ZeroDec()
+	volumes := k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.
+		Prefix(trader).
+		StartInclusive(firstVolumeDay(ctx)),
+	).Values()
+	for _, v := range volumes {
+		volume = volume.Add(v.Volume)
+	}
+	return volume
+}
+
+// recordTraderVolume adds the notional to the volume of the trader on the
+// current day, and prunes their volumes which fell out of the fee tier window.
+func (k Keeper) recordTraderVolume(ctx sdk.Context, trader sdk.AccAddress, notional sdk.Dec) {
+	if notional.IsZero() {
+		return
+	}
+
+	stale := k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.
+		Prefix(trader).
+		EndExclusive(firstVolumeDay(ctx)),
+	).Keys()
+	for _, key := range stale {
+		_ = k.TraderVolumes.Delete(ctx, key)
+	}
+
+	today := v2types.VolumeDay(ctx.BlockTime())
+	key := collections.Join(trader, today)
+	volume := k.TraderVolumes.GetOr(ctx, key, v2types.TraderVolume{
+		TraderAddress: trader.String(),
+		Day:           today,
+		Volume:        sdk.ZeroDec(),
+	})
+	volume.Volume = volume.Volume.Add(notional.Abs())
+	k.TraderVolumes.Insert(ctx, key, volume)
+}
+
+// firstVolumeDay returns the first day of the fee tier window.
+func firstVolumeDay(ctx sdk.Context) uint64 {
+	today := v2types.VolumeDay(ctx.BlockTime())
+	if today < v2types.TraderVolumeWindowDays-1 {
+		return 0
+	}
+	return today - (v2types.TraderVolumeWindowDays - 1)
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// positionChangedEvent returns the last PositionChangedEvent emitted on ctx.
+func positionChangedEvent(t *testing.T, ctx sdk.Context) *v2types.PositionChangedEvent {
+	var event *v2types.PositionChangedEvent
+	for _, abciEvent := range ctx.EventManager().Events() {
+		if abciEvent.Type != proto.MessageName(&v2types.PositionChangedEvent{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event{Type: abciEvent.Type, Attributes: abciEvent.Attributes})
+		require.NoError(t, err)
+		event = typedEvent.(*v2types.PositionChangedEvent)
+	}
+	require.NotNil(t, event)
+	return event
+}
+
+func TestFeeTiers(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.FeeTiers = []v2types.FeeTier{
+		{
+			MinVolume:     sdk.NewDec(10_000),
+			TakerFeeRatio: sdk.MustNewDecFromStr("0.0005"),
+			MakerFeeRatio: sdk.MustNewDecFromStr("0.0002"),
+		},
+		{
+			MinVolume:     sdk.NewDec(20_000),
+			TakerFeeRatio: sdk.MustNewDecFromStr("0.0002"),
+			MakerFeeRatio: sdk.MustNewDecFromStr("-0.0001"),
+		},
+	}
+	require.NoError(t, params.Validate())
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e6))))
+
+	feePoolBalance := func() int64 {
+		return app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.FeePoolModuleAccount), denoms.NUSD).Amount.Int64()
+	}
+
+	t.Log("below the first tier, alice pays the exchange fee ratio of the market")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	event := positionChangedEvent(t, ctx)
+	require.EqualValues(t, 0, event.FeeTier)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 20), event.TransactionFee)
+	require.EqualValues(t, 10, feePoolBalance())
+	require.Equal(t, sdk.NewDec(10_000), app.PerpKeeperV2.TraderVolume(ctx, alice))
+
+	t.Log("her volume reaches the first tier, she pays its taker fee")
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	event = positionChangedEvent(t, ctx)
+	require.EqualValues(t, 1, event.FeeTier)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 15), event.TransactionFee)
+	require.EqualValues(t, 15, feePoolBalance())
+
+	t.Log("in the second tier, her limit order executed a block later earns a maker rebate")
+	_, err = app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.95"), 1000))
+	require.NoError(t, err)
+	ctx = nextBlock(ctx).WithEventManager(sdk.NewEventManager())
+	setPriceMultiplier(t, app, ctx, pair, sdk.MustNewDecFromStr("0.93"))
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	require.Empty(t, app.PerpKeeperV2.Orders.Iterate(ctx, collections.Range[uint64]{}).Keys())
+	event = positionChangedEvent(t, ctx)
+	require.EqualValues(t, 2, event.FeeTier)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 10), event.TransactionFee)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 1), event.MakerRebate)
+	require.EqualValues(t, 14, feePoolBalance())
+
+	t.Log("the volume older than the window no longer counts and is pruned")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(v2types.TraderVolumeWindowDays * 24 * time.Hour))
+	require.True(t, app.PerpKeeperV2.TraderVolume(ctx, alice).IsZero())
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, positionChangedEvent(t, ctx).FeeTier)
+	volumes := app.PerpKeeperV2.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.Prefix(alice)).Values()
+	require.Len(t, volumes, 1)
+	require.Equal(t, v2types.VolumeDay(ctx.BlockTime()), volumes[0].Day)
+}
+
+func TestMakerRebateCappedByFeePool(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.FeeTiers = []v2types.FeeTier{{
+		MinVolume:     sdk.ZeroDec(),
+		TakerFeeRatio: sdk.MustNewDecFromStr("0.001"),
+		MakerFeeRatio: sdk.MustNewDecFromStr("-0.001"),
+	}}
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1020))))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.FeePoolModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 4))))
+
+	_, err := app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.95"), 1000))
+	require.NoError(t, err)
+	ctx = nextBlock(ctx)
+	setPriceMultiplier(t, app, ctx, pair, sdk.MustNewDecFromStr("0.93"))
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+
+	event := positionChangedEvent(t, ctx)
+	require.EqualValues(t, 1, event.FeeTier)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 4), event.MakerRebate)
+	require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.FeePoolModuleAccount), denoms.NUSD).IsZero())
+}
+
+func TestMakerFeeRequiresRestingOrder(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.FeeTiers = []v2types.FeeTier{{
+		MinVolume:     sdk.ZeroDec(),
+		TakerFeeRatio: sdk.MustNewDecFromStr("0.001"),
+		MakerFeeRatio: sdk.MustNewDecFromStr("-0.001"),
+	}}
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e6))))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.FeePoolModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 100))))
+
+	t.Log("a limit order triggered at the mark price can't be placed")
+	_, err := app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("1.1"), 1000))
+	require.ErrorIs(t, err, v2types.ErrOrderMarketable)
+
+	t.Log("a take profit triggered at the mark price can't be placed")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(1000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.PlaceOrder(ctx, v2types.MsgPlaceOrder{
+		Sender:       alice.String(),
+		Pair:         pair,
+		OrderType:    v2types.OrderType_TAKE_PROFIT,
+		TriggerPrice: sdk.MustNewDecFromStr("0.9"),
+	}.NewOrder())
+	require.ErrorIs(t, err, v2types.ErrOrderMarketable)
+
+	t.Log("a limit order triggered in the block it was placed in pays the taker fee")
+	_, err = app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.95"), 1000))
+	require.NoError(t, err)
+	setPriceMultiplier(t, app, ctx, pair, sdk.MustNewDecFromStr("0.93"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+
+	event := positionChangedEvent(t, ctx)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 20), event.TransactionFee)
+	require.True(t, event.MakerRebate.IsZero())
+}
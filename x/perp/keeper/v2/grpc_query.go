@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -140,3 +144,409 @@ func (q queryServer) ModuleAccounts(
 
 	return &v2types.QueryModuleAccountsResponse{Accounts: moduleAccountsWithBalances}, nil
 }
+
+func (q queryServer) Markets(
+	goCtx context.Context, req *v2types.QueryMarketsRequest,
+) (*v2types.QueryMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	marketStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), marketsNamespace.Prefix())
+
+	var markets []v2types.Market
+	pageRes, err := query.Paginate(
+		marketStore,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var market v2types.Market
+			if err := q.k.cdc.Unmarshal(value, &market); err != nil {
+				return err
+			}
+			markets = append(markets, market)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryMarketsResponse{
+		Markets:    markets,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) Market(
+	goCtx context.Context, req *v2types.QueryMarketRequest,
+) (*v2types.QueryMarketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	market, err := q.k.Markets.Get(sdk.UnwrapSDKContext(goCtx), req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v2types.QueryMarketResponse{Market: market}, nil
+}
+
+func (q queryServer) AMMs(
+	goCtx context.Context, req *v2types.QueryAMMsRequest,
+) (*v2types.QueryAMMsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ammStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), ammsNamespace.Prefix())
+
+	var amms []v2types.AMMWithMetrics
+	pageRes, err := query.Paginate(
+		ammStore,
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var amm v2types.AMM
+			if err := q.k.cdc.Unmarshal(value, &amm); err != nil {
+				return err
+			}
+			ammWithMetrics, err := ammMetrics(amm)
+			if err != nil {
+				return err
+			}
+			amms = append(amms, ammWithMetrics)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryAMMsResponse{
+		Amms:       amms,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) AMM(
+	goCtx context.Context, req *v2types.QueryAMMRequest,
+) (*v2types.QueryAMMResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	amm, err := q.k.AMMs.Get(sdk.UnwrapSDKContext(goCtx), req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	ammWithMetrics, err := ammMetrics(amm)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryAMMResponse{Amm: ammWithMetrics}, nil
+}
+
+// ammMetrics computes the values derived from the reserves of an AMM.
+func ammMetrics(amm v2types.AMM) (v2types.AMMWithMetrics, error) {
+	sqrtDepth, err := amm.ComputeSqrtDepth()
+	if err != nil {
+		return v2types.AMMWithMetrics{}, err
+	}
+
+	marketValue, err := amm.GetMarketValue()
+	if err != nil {
+		return v2types.AMMWithMetrics{}, err
+	}
+
+	return v2types.AMMWithMetrics{
+		Amm:               amm,
+		MarkPrice:         amm.MarkPrice(),
+		Bias:              amm.Bias(),
+		ComputedSqrtDepth: sqrtDepth,
+		MarketValue:       marketValue,
+	}, nil
+}
+
+func (q queryServer) ReserveSnapshots(
+	goCtx context.Context, req *v2types.QueryReserveSnapshotsRequest,
+) (*v2types.QueryReserveSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.EndTimeMs != 0 && req.EndTimeMs < req.StartTimeMs {
+		return nil, status.Error(codes.InvalidArgument, "end time must not be before start time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rng := collections.PairRange[asset.Pair, time.Time]{}.
+		Prefix(req.Pair).
+		StartInclusive(time.UnixMilli(req.StartTimeMs)).
+		Descending()
+	if req.EndTimeMs != 0 {
+		rng = rng.EndInclusive(time.UnixMilli(req.EndTimeMs))
+	}
+
+	iter := q.k.ReserveSnapshots.Iterate(ctx, rng)
+	defer iter.Close()
+
+	var snapshots []v2types.ReserveSnapshot
+	for ; iter.Valid(); iter.Next() {
+		if req.Limit != 0 && uint64(len(snapshots)) >= req.Limit {
+			break
+		}
+		snapshots = append(snapshots, iter.Value())
+	}
+
+	return &v2types.QueryReserveSnapshotsResponse{ReserveSnapshots: snapshots}, nil
+}
+
+func (q queryServer) Orders(
+	goCtx context.Context, req *v2types.QueryOrdersRequest,
+) (*v2types.QueryOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Trader); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Pair != "" {
+		if _, err := asset.TryNewPair(req.Pair); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	orders := []v2types.Order{}
+	for _, order := range q.k.Orders.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		if order.TraderAddress != req.Trader {
+			continue
+		}
+		if req.Pair != "" && order.Pair.String() != req.Pair {
+			continue
+		}
+		orders = append(orders, order)
+	}
+
+	return &v2types.QueryOrdersResponse{Orders: orders}, nil
+}
+
+func (q queryServer) Order(
+	goCtx context.Context, req *v2types.QueryOrderRequest,
+) (*v2types.QueryOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	order, err := q.k.Orders.Get(sdk.UnwrapSDKContext(goCtx), req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v2types.QueryOrderResponse{Order: order}, nil
+}
+
+func (q queryServer) CrossMarginAccount(
+	goCtx context.Context, req *v2types.QueryCrossMarginAccountRequest,
+) (*v2types.QueryCrossMarginAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	account, err := q.k.CrossMarginAccounts.Get(ctx, traderAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// the denoms the account holds collateral or positions in
+	denoms := make(map[string]struct{})
+	for _, coin := range account.Collateral {
+		denoms[q.k.collateralQuoteDenom(ctx, coin.Denom)] = struct{}{}
+	}
+	for _, market := range q.k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if _, err := q.k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr)); err == nil {
+			denoms[market.Pair.QuoteDenom()] = struct{}{}
+		}
+	}
+	sortedDenoms := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sortedDenoms = append(sortedDenoms, denom)
+	}
+	sort.Strings(sortedDenoms)
+
+	margins := make([]v2types.AccountMargin, 0, len(sortedDenoms))
+	for _, denom := range sortedDenoms {
+		accountMargin, err := q.k.CrossMarginAccountMargin(ctx, traderAddr, denom)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		margins = append(margins, accountMargin)
+	}
+
+	return &v2types.QueryCrossMarginAccountResponse{
+		Account: account,
+		Margins: margins,
+	}, nil
+}
+
+func (q queryServer) InsuranceFund(
+	goCtx context.Context, req *v2types.QueryInsuranceFundRequest,
+) (*v2types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	balance := sdk.NewCoin(req.Denom, q.k.insuranceFundBalance(ctx, req.Denom))
+	shareSupply := q.k.BankKeeper.GetSupply(ctx, v2types.InsuranceFundShareDenom(req.Denom))
+
+	// shares are minted one for one while there are none
+	sharePrice := sdk.OneDec()
+	if shareSupply.IsPositive() {
+		sharePrice = balance.Amount.ToDec().QuoInt(shareSupply.Amount)
+	}
+
+	return &v2types.QueryInsuranceFundResponse{
+		Balance:     balance,
+		ShareSupply: shareSupply,
+		SharePrice:  sharePrice,
+	}, nil
+}
+
+func (q queryServer) InsuranceFundWithdrawals(
+	goCtx context.Context, req *v2types.QueryInsuranceFundWithdrawalsRequest,
+) (*v2types.QueryInsuranceFundWithdrawalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	stakerAddr, err := sdk.AccAddressFromBech32(req.Staker)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	withdrawals := []v2types.InsuranceFundWithdrawal{}
+	iter := q.k.InsuranceFundWithdrawals.Iterate(sdk.UnwrapSDKContext(goCtx), collections.Range[collections.Pair[time.Time, sdk.AccAddress]]{})
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if iter.Key().K2().Equals(stakerAddr) {
+			withdrawals = append(withdrawals, iter.Value())
+		}
+	}
+
+	return &v2types.QueryInsuranceFundWithdrawalsResponse{Withdrawals: withdrawals}, nil
+}
+
+func (q queryServer) ReferralCode(
+	goCtx context.Context, req *v2types.QueryReferralCodeRequest,
+) (*v2types.QueryReferralCodeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := v2types.ValidateReferralCode(req.Code); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	referralCode, err := q.k.ReferralCodes.Get(sdk.UnwrapSDKContext(goCtx), req.Code)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v2types.QueryReferralCodeResponse{ReferralCode: referralCode}, nil
+}
+
+func (q queryServer) ReferralEarnings(
+	goCtx context.Context, req *v2types.QueryReferralEarningsRequest,
+) (*v2types.QueryReferralEarningsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	referrerAddr, err := sdk.AccAddressFromBech32(req.Referrer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	earnings := q.k.ReferralEarnings.GetOr(sdk.UnwrapSDKContext(goCtx), referrerAddr, v2types.ReferralEarnings{}).Earnings
+	if earnings == nil {
+		earnings = sdk.NewCoins()
+	}
+
+	return &v2types.QueryReferralEarningsResponse{Earnings: earnings}, nil
+}
+
+func (q queryServer) ADLRank(
+	goCtx context.Context, req *v2types.QueryADLRankRequest,
+) (*v2types.QueryADLRankResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rank, queueLength, score, err := q.k.ADLRank(sdk.UnwrapSDKContext(goCtx), req.Pair, traderAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v2types.QueryADLRankResponse{
+		Rank:        rank,
+		QueueLength: queueLength,
+		Score:       score,
+	}, nil
+}
+
+func (q queryServer) FundingRates(
+	goCtx context.Context, req *v2types.QueryFundingRatesRequest,
+) (*v2types.QueryFundingRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Pair.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rng := collections.PairRange[asset.Pair, uint64]{}.
+		Prefix(req.Pair).
+		StartInclusive(req.StartEpoch)
+	if req.EndEpoch != 0 {
+		if req.EndEpoch < req.StartEpoch {
+			return nil, status.Error(codes.InvalidArgument, "end epoch must be >= start epoch")
+		}
+		rng = rng.EndInclusive(req.EndEpoch)
+	}
+
+	fundingRates := q.k.FundingRates.Iterate(sdk.UnwrapSDKContext(goCtx), rng).Values()
+
+	return &v2types.QueryFundingRatesResponse{FundingRates: fundingRates}, nil
+}
+
+func (q queryServer) CollateralAssets(
+	goCtx context.Context, req *v2types.QueryCollateralAssetsRequest,
+) (*v2types.QueryCollateralAssetsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	collateralAssets := q.k.CollateralAssets.Iterate(sdk.UnwrapSDKContext(goCtx), collections.Range[string]{}).Values()
+
+	return &v2types.QueryCollateralAssetsResponse{CollateralAssets: collateralAssets}, nil
+}
@@ -2,14 +2,21 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
 
@@ -310,3 +317,152 @@ func TestQueryPositions(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestQueryMarketsAndAMMs(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	pairs := []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+		asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	}
+	for _, pair := range pairs {
+		market := mock.TestMarket()
+		market.Pair = pair
+		amm := mock.TestAMM(sdk.NewDec(1e6), sdk.NewDec(2))
+		amm.Pair = pair
+		amm.TotalLong = sdk.NewDec(100)
+		amm.TotalShort = sdk.NewDec(40)
+		app.PerpKeeperV2.Markets.Insert(ctx, pair, *market)
+		app.PerpKeeperV2.AMMs.Insert(ctx, pair, *amm)
+	}
+
+	t.Run("markets are paginated", func(t *testing.T) {
+		resp, err := queryServer.Markets(goCtx, &v2types.QueryMarketsRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Markets, 2)
+		require.EqualValues(t, 3, resp.Pagination.Total)
+
+		resp, err = queryServer.Markets(goCtx, &v2types.QueryMarketsRequest{
+			Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Markets, 1)
+	})
+
+	t.Run("single market", func(t *testing.T) {
+		resp, err := queryServer.Market(goCtx, &v2types.QueryMarketRequest{Pair: pairs[0]})
+		require.NoError(t, err)
+		require.Equal(t, pairs[0], resp.Market.Pair)
+
+		_, err = queryServer.Market(goCtx, &v2types.QueryMarketRequest{Pair: asset.Registry.Pair(denoms.OSMO, denoms.NUSD)})
+		require.Error(t, err)
+	})
+
+	t.Run("amms are paginated and carry metrics", func(t *testing.T) {
+		resp, err := queryServer.AMMs(goCtx, &v2types.QueryAMMsRequest{
+			Pagination: &query.PageRequest{Limit: 5},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Amms, 3)
+		for _, amm := range resp.Amms {
+			require.Equal(t, sdk.NewDec(2), amm.MarkPrice)
+			require.Equal(t, sdk.NewDec(60), amm.Bias)
+			require.Equal(t, sdk.NewDec(1e6), amm.ComputedSqrtDepth)
+			require.True(t, amm.MarketValue.IsPositive())
+		}
+	})
+
+	t.Run("single amm", func(t *testing.T) {
+		resp, err := queryServer.AMM(goCtx, &v2types.QueryAMMRequest{Pair: pairs[1]})
+		require.NoError(t, err)
+		require.Equal(t, pairs[1], resp.Amm.Amm.Pair)
+		require.Equal(t, sdk.NewDec(2), resp.Amm.MarkPrice)
+
+		_, err = queryServer.AMM(goCtx, &v2types.QueryAMMRequest{Pair: asset.Registry.Pair(denoms.OSMO, denoms.NUSD)})
+		require.Error(t, err)
+	})
+}
+
+func TestQueryReserveSnapshots(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	goCtx := sdk.WrapSDKContext(ctx)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	otherPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	start := time.UnixMilli(1_000_000)
+	for i := 0; i < 5; i++ {
+		ts := start.Add(time.Duration(i) * time.Second)
+		for _, p := range []asset.Pair{pair, otherPair} {
+			amm := mock.TestAMMDefault()
+			amm.Pair = p
+			app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(p, ts), v2types.ReserveSnapshot{
+				Amm:         *amm,
+				TimestampMs: ts.UnixMilli(),
+			})
+		}
+	}
+
+	tests := []struct {
+		name        string
+		req         *v2types.QueryReserveSnapshotsRequest
+		expectedTss []int64
+		expectErr   bool
+	}{
+		{
+			name:        "all snapshots of the pair",
+			req:         &v2types.QueryReserveSnapshotsRequest{Pair: pair},
+			expectedTss: []int64{1_004_000, 1_003_000, 1_002_000, 1_001_000, 1_000_000},
+		},
+		{
+			name: "bounded range",
+			req: &v2types.QueryReserveSnapshotsRequest{
+				Pair:        pair,
+				StartTimeMs: 1_001_000,
+				EndTimeMs:   1_003_000,
+			},
+			expectedTss: []int64{1_003_000, 1_002_000, 1_001_000},
+		},
+		{
+			name: "limit returns the most recent snapshots",
+			req: &v2types.QueryReserveSnapshotsRequest{
+				Pair:  pair,
+				Limit: 2,
+			},
+			expectedTss: []int64{1_004_000, 1_003_000},
+		},
+		{
+			name: "end before start",
+			req: &v2types.QueryReserveSnapshotsRequest{
+				Pair:        pair,
+				StartTimeMs: 1_003_000,
+				EndTimeMs:   1_001_000,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := queryServer.ReserveSnapshots(goCtx, tc.req)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			var tss []int64
+			for _, snapshot := range resp.ReserveSnapshots {
+				require.Equal(t, pair, snapshot.Amm.Pair)
+				tss = append(tss, snapshot.TimestampMs)
+			}
+			require.Equal(t, tc.expectedTss, tss)
+		})
+	}
+}
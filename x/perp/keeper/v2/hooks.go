@@ -16,10 +16,10 @@ import (
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 }
 
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
 		if !market.Enabled || epochIdentifier != market.FundingRateEpochId {
-			return
+			continue
 		}
 
 		indexTWAP, err := k.OracleKeeper.GetExchangeRateTwap(ctx, market.Pair)
@@ -45,11 +45,22 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 		epochInfo := k.EpochKeeper.GetEpochInfo(ctx, epochIdentifier)
 		intervalsPerDay := (24 * time.Hour) / epochInfo.Duration
 		// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
-		premiumFraction := markTwap.Sub(indexTWAP).QuoInt64(int64(intervalsPerDay))
+		premiumFraction, clamped := market.PremiumFraction(markTwap, indexTWAP, int64(intervalsPerDay))
 
 		market.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction.Add(premiumFraction)
 		k.Markets.Insert(ctx, market.Pair, market)
 
+		k.FundingRates.Insert(ctx, collections.Join(market.Pair, epochNumber), v2.FundingRateRecord{
+			Pair:            market.Pair,
+			Epoch:           epochNumber,
+			MarkTwap:        markTwap,
+			IndexTwap:       indexTWAP,
+			PremiumFraction: premiumFraction,
+			FundingRate:     premiumFraction.Quo(indexTWAP),
+			Clamped:         clamped,
+			Timestamp:       ctx.BlockTime(),
+		})
+
 		_ = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
 			Pair:                      market.Pair,
 			MarkPrice:                 markTwap,
@@ -61,6 +72,8 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 			BlockTimeMs:               ctx.BlockTime().UnixMilli(),
 		})
 	}
+
+	k.RepegMarkets(ctx, epochIdentifier)
 }
 
 // ___________________________________________________________________________________________________
@@ -62,6 +62,36 @@ func TestAfterEpochEnd(t *testing.T) {
 			Then(
 				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
 			),
+
+		TC("funding rate clamped").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithMaxFundingRate(sdk.MustNewDecFromStr("0.01"))),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("5.8")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.058"))),
+				FundingRatesShouldBeEqual(pairBtcUsdc, sdk.MustNewDecFromStr("-0.058")),
+			),
+
+		TC("premium within the dampener band").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithFundingDampener(sdk.MustNewDecFromStr("0.5"))),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("1.5")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
+				FundingRatesShouldBeEqual(pairBtcUsdc, sdk.ZeroDec()),
+			),
 	}
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
@@ -0,0 +1,348 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// DepositInsuranceFund moves the staker's quote tokens to the insurance fund
+// and mints insurance fund shares priced at the current balance of the fund.
+// The first deposit of a quote denom gets one share per quote token, and locks
+// one share per quote token already in the fund plus InsuranceFundLockedShares
+// in the fund, paid for by the staker. A deposit to a fund drained by bad debt
+// is priced as if the fund held one quote token, so the outstanding shares are
+// written off and keep a claim of at most one quote token.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - staker: the staker's address
+//   - amount: the quote tokens to deposit
+//
+// returns:
+//   - shares: the shares minted to the staker
+//   - err: error if any
+func (k Keeper) DepositInsuranceFund(ctx sdk.Context, staker sdk.AccAddress, amount sdk.Coin) (shares sdk.Coin, err error) {
+	if !amount.IsPositive() {
+		return sdk.Coin{}, v2types.ErrInvalidAmount.Wrapf("deposit must be positive: %s", amount)
+	}
+	if _, found := k.marketQuotedIn(ctx, amount.Denom); !found {
+		return sdk.Coin{}, v2types.ErrPairNotFound.Wrapf("no market quoted in %s", amount.Denom)
+	}
+
+	shareDenom := v2types.InsuranceFundShareDenom(amount.Denom)
+	supply := k.BankKeeper.GetSupply(ctx, shareDenom).Amount
+	balance := k.insuranceFundBalance(ctx, amount.Denom)
+
+	locked := sdk.NewCoin(shareDenom, sdk.ZeroInt())
+	switch {
+	case supply.IsZero():
+		if amount.Amount.LTE(sdk.NewInt(v2types.InsuranceFundLockedShares)) {
+			return sdk.Coin{}, v2types.ErrInvalidAmount.Wrapf(
+				"the first deposit must be greater than %d%s", v2types.InsuranceFundLockedShares, amount.Denom)
+		}
+		locked.Amount = balance.AddRaw(v2types.InsuranceFundLockedShares)
+		shares = sdk.NewCoin(shareDenom, amount.Amount.SubRaw(v2types.InsuranceFundLockedShares))
+	case balance.IsZero():
+		shares = sdk.NewCoin(shareDenom, amount.Amount.Mul(supply))
+	default:
+		shares = sdk.NewCoin(shareDenom, amount.Amount.Mul(supply).Quo(balance))
+	}
+	if !shares.IsPositive() {
+		return sdk.Coin{}, v2types.ErrInvalidAmount.Wrapf("deposit of %s is worth no shares", amount)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, staker, v2types.InsuranceFundModuleAccount, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.BankKeeper.MintCoins(ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(shares.Add(locked))); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.InsuranceFundModuleAccount, staker, sdk.NewCoins(shares)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shares, ctx.EventManager().EmitTypedEvent(&v2types.InsuranceFundDepositedEvent{
+		StakerAddress: staker.String(),
+		Amount:        amount,
+		Shares:        shares,
+	})
+}
+
+// WithdrawInsuranceFund escrows the staker's shares in the insurance fund until
+// the withdrawal cooldown is over. The shares keep absorbing bad debt until
+// they are redeemed by the EndBlocker, so stakers can't run ahead of a loss.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - staker: the staker's address
+//   - shares: the insurance fund shares to redeem
+//
+// returns:
+//   - unlockTime: the time at which the shares are redeemed
+//   - err: error if any
+func (k Keeper) WithdrawInsuranceFund(ctx sdk.Context, staker sdk.AccAddress, shares sdk.Coin) (unlockTime time.Time, err error) {
+	if !shares.IsPositive() {
+		return time.Time{}, v2types.ErrInvalidAmount.Wrapf("shares must be positive: %s", shares)
+	}
+	if _, err = v2types.QuoteDenomOfShares(shares.Denom); err != nil {
+		return time.Time{}, err
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, staker, v2types.InsuranceFundModuleAccount, sdk.NewCoins(shares)); err != nil {
+		return time.Time{}, err
+	}
+
+	unlockTime = ctx.BlockTime().Add(k.GetParams(ctx).InsuranceFundWithdrawalCooldown)
+	key := collections.Join(unlockTime, staker)
+	withdrawal := k.InsuranceFundWithdrawals.GetOr(ctx, key, v2types.InsuranceFundWithdrawal{
+		StakerAddress: staker.String(),
+		UnlockTime:    unlockTime,
+	})
+	withdrawal.Shares = withdrawal.Shares.Add(shares)
+	k.InsuranceFundWithdrawals.Insert(ctx, key, withdrawal)
+
+	return unlockTime, ctx.EventManager().EmitTypedEvent(&v2types.InsuranceFundWithdrawalRequestedEvent{
+		StakerAddress: staker.String(),
+		Shares:        shares,
+		UnlockTime:    unlockTime,
+	})
+}
+
+// ProcessInsuranceFundWithdrawals redeems the escrowed shares of the
+// withdrawals unlocked at the block time for their share of the insurance
+// fund. Called in the EndBlocker.
+func (k Keeper) ProcessInsuranceFundWithdrawals(ctx sdk.Context) {
+	var unlocked []collections.KeyValue[collections.Pair[time.Time, sdk.AccAddress], v2types.InsuranceFundWithdrawal]
+
+	iter := k.InsuranceFundWithdrawals.Iterate(ctx, collections.Range[collections.Pair[time.Time, sdk.AccAddress]]{})
+	for ; iter.Valid(); iter.Next() {
+		kv := iter.KeyValue()
+		if kv.Key.K1().After(ctx.BlockTime()) {
+			break
+		}
+		unlocked = append(unlocked, kv)
+	}
+	iter.Close()
+
+	for _, kv := range unlocked {
+		cachedCtx, commit := ctx.CacheContext()
+		if err := k.redeemInsuranceFundShares(cachedCtx, kv.Value); err != nil {
+			k.Logger(ctx).Error("failed to redeem insurance fund shares", "staker", kv.Value.StakerAddress, "error", err)
+			continue
+		}
+		_ = k.InsuranceFundWithdrawals.Delete(cachedCtx, kv.Key)
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+// redeemInsuranceFundShares burns the escrowed shares of the withdrawal and
+// pays the staker their share of the insurance fund.
+func (k Keeper) redeemInsuranceFundShares(ctx sdk.Context, withdrawal v2types.InsuranceFundWithdrawal) error {
+	staker, err := sdk.AccAddressFromBech32(withdrawal.StakerAddress)
+	if err != nil {
+		return err
+	}
+
+	for _, shares := range withdrawal.Shares {
+		quoteDenom, err := v2types.QuoteDenomOfShares(shares.Denom)
+		if err != nil {
+			return err
+		}
+
+		supply := k.BankKeeper.GetSupply(ctx, shares.Denom).Amount
+		amount := sdk.NewCoin(quoteDenom, k.insuranceFundBalance(ctx, quoteDenom).Mul(shares.Amount).Quo(supply))
+
+		if err = k.BankKeeper.BurnCoins(ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(shares)); err != nil {
+			return err
+		}
+		if amount.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.InsuranceFundModuleAccount, staker, sdk.NewCoins(amount)); err != nil {
+				return err
+			}
+		}
+
+		if err = ctx.EventManager().EmitTypedEvent(&v2types.InsuranceFundWithdrawnEvent{
+			StakerAddress: withdrawal.StakerAddress,
+			Shares:        shares,
+			Amount:        amount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// insuranceFundBalance returns the insurance fund balance of the quote denom.
+func (k Keeper) insuranceFundBalance(ctx sdk.Context, denom string) sdk.Int {
+	return k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(v2types.InsuranceFundModuleAccount), denom).Amount
+}
+
+// insuranceFundCut returns the part of the fee that goes to the insurance fund
+// according to the InsuranceFundFeeRatio param.
+func (k Keeper) insuranceFundCut(ctx sdk.Context, fee sdk.Int) sdk.Int {
+	ratio := k.GetParams(ctx).InsuranceFundFeeRatio
+	if ratio.IsNil() || !fee.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return ratio.MulInt(fee).TruncateInt()
+}
+
+// coverBadDebt pays the bad debt into the vault, from the insurance fund first
+// and from the ecosystem fund once the insurance fund is drained.
+//
+// returns:
+//   - uncovered: the bad debt neither fund could cover
+//   - err: error if any
+func (k Keeper) coverBadDebt(ctx sdk.Context, denom string, badDebt sdk.Int) (uncovered sdk.Int, err error) {
+	uncovered = badDebt
+	for _, fund := range []string{v2types.InsuranceFundModuleAccount, v2types.PerpEFModuleAccount} {
+		if !uncovered.IsPositive() {
+			break
+		}
+
+		balance := k.BankKeeper.GetBalance(ctx, k.AccountKeeper.GetModuleAddress(fund), denom).Amount
+		paid := sdk.MinInt(balance, uncovered)
+		if !paid.IsPositive() {
+			continue
+		}
+
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx,
+			/* from */ fund,
+			/* to */ v2types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(denom, paid)),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+		uncovered = uncovered.Sub(paid)
+	}
+
+	return uncovered, nil
+}
+
+// queueLoss adds the loss the insurance and ecosystem funds couldn't cover to
+// the pending loss of its denom, socialized by SocializeLosses.
+func (k Keeper) queueLoss(ctx sdk.Context, denom string, loss sdk.Int) {
+	pending := k.PendingLosses.GetOr(ctx, denom, v2types.PendingLoss{
+		Denom:  denom,
+		Amount: sdk.ZeroInt(),
+	})
+	pending.Amount = pending.Amount.Add(loss)
+	k.PendingLosses.Insert(ctx, denom, pending)
+}
+
+// SocializeLosses spreads the pending losses over the profitable positions of
+// the markets quoted in their denom. The pending losses share a single budget
+// of MaxPositionsScannedPerBlock positions. Called in the EndBlocker.
+func (k Keeper) SocializeLosses(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxPositionsScannedPerBlock
+	for _, pending := range k.PendingLosses.Iterate(ctx, collections.Range[string]{}).Values() {
+		if budget == 0 {
+			return
+		}
+		cachedCtx, commit := ctx.CacheContext()
+		scanned, err := k.socializeLoss(cachedCtx, pending, budget)
+		budget -= scanned
+		if err != nil {
+			k.Logger(ctx).Error("failed to socialize loss", "denom", pending.Denom, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+// socializeLoss spreads the pending loss over the profitable positions among
+// the next budget positions, pro rata to their unrealized PnL, by moving their
+// open notional against them. The haircut is capped at the total unrealized
+// PnL of those positions, the rest of the loss is left for the next blocks,
+// which resume after the last position checked.
+//
+// returns:
+//   - scanned: the number of positions checked
+//   - err: error if any
+func (k Keeper) socializeLoss(ctx sdk.Context, pending v2types.PendingLoss, budget uint64) (scanned uint64, err error) {
+	type profitablePosition struct {
+		position v2types.Position
+		pnl      sdk.Dec
+	}
+
+	positions := k.positionsAfter(ctx, pending.Cursor, budget)
+	scanned = uint64(len(positions))
+	if scanned == 0 {
+		return 0, nil
+	}
+	pending.Cursor = cursorAt(positions[len(positions)-1])
+
+	var profitable []profitablePosition
+	totalPnl := sdk.ZeroDec()
+	amms := make(map[asset.Pair]*v2types.AMM)
+	for _, position := range positions {
+		amm, ok := amms[position.Pair]
+		if !ok {
+			market, err := k.Markets.Get(ctx, position.Pair)
+			if err == nil && !market.Settled && market.Pair.QuoteDenom() == pending.Denom {
+				a, err := k.AMMs.Get(ctx, position.Pair)
+				if err != nil {
+					return scanned, err
+				}
+				amm = &a
+			}
+			amms[position.Pair] = amm
+		}
+		if amm == nil || position.Size_.IsZero() {
+			continue
+		}
+
+		notional, err := PositionNotionalSpot(*amm, position)
+		if err != nil {
+			return scanned, err
+		}
+
+		if pnl := UnrealizedPnl(position, notional); pnl.IsPositive() {
+			profitable = append(profitable, profitablePosition{position: position, pnl: pnl})
+			totalPnl = totalPnl.Add(pnl)
+		}
+	}
+
+	socialized := sdk.MinDec(pending.Amount.ToDec(), totalPnl)
+	if socialized.IsPositive() {
+		for _, p := range profitable {
+			cut := p.pnl.Mul(socialized).Quo(totalPnl)
+			if p.position.Size_.IsPositive() {
+				p.position.OpenNotional = p.position.OpenNotional.Add(cut)
+			} else {
+				p.position.OpenNotional = p.position.OpenNotional.Sub(cut)
+			}
+
+			traderAddr, err := sdk.AccAddressFromBech32(p.position.TraderAddress)
+			if err != nil {
+				return scanned, err
+			}
+			k.SetPosition(ctx, traderAddr, p.position)
+		}
+
+		if err = ctx.EventManager().EmitTypedEvent(&v2types.LossSocializedEvent{
+			Denom:          pending.Denom,
+			Loss:           pending.Amount,
+			SocializedLoss: socialized,
+		}); err != nil {
+			return scanned, err
+		}
+	}
+
+	// the socialized loss is rounded up, so a window socializing a fraction of a
+	// token still settles it instead of charging the same dust over and over
+	pending.Amount = pending.Amount.Sub(socialized.Ceil().TruncateInt())
+	if !pending.Amount.IsPositive() {
+		return scanned, k.PendingLosses.Delete(ctx, pending.Denom)
+	}
+	k.PendingLosses.Insert(ctx, pending.Denom, pending)
+	return scanned, nil
+}
@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestInsuranceFundStaking(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	shareDenom := v2types.InsuranceFundShareDenom(denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.InsuranceFundWithdrawalCooldown = time.Hour
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 2000))))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, bob, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 300))))
+
+	t.Log("there is no market quoted in unibi")
+	_, err := app.PerpKeeperV2.DepositInsuranceFund(ctx, alice, sdk.NewInt64Coin(denoms.NIBI, 10))
+	require.ErrorIs(t, err, v2types.ErrPairNotFound)
+
+	// fees collected before anyone staked
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 500))))
+
+	t.Log("the first deposit must pay for the locked shares")
+	_, err = app.PerpKeeperV2.DepositInsuranceFund(ctx, alice, sdk.NewInt64Coin(denoms.NUSD, v2types.InsuranceFundLockedShares))
+	require.ErrorIs(t, err, v2types.ErrInvalidAmount)
+
+	t.Log("the first deposit locks shares for the fund balance, so it can't claim the fees")
+	shares, err := app.PerpKeeperV2.DepositInsuranceFund(ctx, alice, sdk.NewInt64Coin(denoms.NUSD, 2000))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 1000), shares)
+	require.Equal(t, shares, app.BankKeeper.GetBalance(ctx, alice, shareDenom))
+	require.EqualValues(t, 1500, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.InsuranceFundModuleAccount), shareDenom).Amount.Int64())
+
+	t.Log("fees flow in and raise the share price")
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 500))))
+	shares, err = app.PerpKeeperV2.DepositInsuranceFund(ctx, bob, sdk.NewInt64Coin(denoms.NUSD, 300))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 250), shares)
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	fund, err := queryServer.InsuranceFund(sdk.WrapSDKContext(ctx), &v2types.QueryInsuranceFundRequest{Denom: denoms.NUSD})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 3300), fund.Balance)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 2750), fund.ShareSupply)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), fund.SharePrice)
+
+	t.Log("alice requests a withdrawal, her shares are escrowed for the cooldown")
+	unlockTime, err := app.PerpKeeperV2.WithdrawInsuranceFund(ctx, alice, sdk.NewInt64Coin(shareDenom, 500))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), unlockTime)
+	require.EqualValues(t, 500, app.BankKeeper.GetBalance(ctx, alice, shareDenom).Amount.Int64())
+
+	withdrawals, err := queryServer.InsuranceFundWithdrawals(sdk.WrapSDKContext(ctx), &v2types.QueryInsuranceFundWithdrawalsRequest{Staker: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, withdrawals.Withdrawals, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 500)), withdrawals.Withdrawals[0].Shares)
+
+	t.Log("nothing is paid out before the cooldown is over")
+	app.PerpKeeperV2.ProcessInsuranceFundWithdrawals(ctx)
+	require.True(t, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).IsZero())
+
+	t.Log("the escrowed shares are redeemed at the share price of the unlock time")
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 550))))
+	ctx = ctx.WithBlockTime(unlockTime)
+	app.PerpKeeperV2.ProcessInsuranceFundWithdrawals(ctx)
+	require.EqualValues(t, 700, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).Amount.Int64())
+	require.EqualValues(t, 2250, app.BankKeeper.GetSupply(ctx, shareDenom).Amount.Int64())
+	require.Empty(t, app.PerpKeeperV2.InsuranceFundWithdrawals.Iterate(ctx, collections.Range[collections.Pair[time.Time, sdk.AccAddress]]{}).Keys())
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.InsuranceFundWithdrawnEvent{
+		StakerAddress: alice.String(),
+		Shares:        sdk.NewInt64Coin(shareDenom, 500),
+		Amount:        sdk.NewInt64Coin(denoms.NUSD, 700),
+	})
+}
+
+func TestInsuranceFundDepositAfterDrain(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	shareDenom := v2types.InsuranceFundShareDenom(denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 2000))))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, bob, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 300))))
+
+	_, err := app.PerpKeeperV2.DepositInsuranceFund(ctx, alice, sdk.NewInt64Coin(denoms.NUSD, 2000))
+	require.NoError(t, err)
+
+	// bad debt drains the fund
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.InsuranceFundModuleAccount, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 2000))))
+
+	t.Log("the drained fund can be reseeded, the outstanding shares are written off")
+	shares, err := app.PerpKeeperV2.DepositInsuranceFund(ctx, bob, sdk.NewInt64Coin(denoms.NUSD, 300))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 300*2000), shares)
+
+	t.Log("alice's shares are redeemed for nothing")
+	unlockTime, err := app.PerpKeeperV2.WithdrawInsuranceFund(ctx, alice, sdk.NewInt64Coin(shareDenom, 1000))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(unlockTime)
+	app.PerpKeeperV2.ProcessInsuranceFundWithdrawals(ctx)
+	require.True(t, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).IsZero())
+	require.EqualValues(t, 300, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.InsuranceFundModuleAccount), denoms.NUSD).Amount.Int64())
+}
+
+func TestInsuranceFundFeeCut(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.InsuranceFundFeeRatio = sdk.MustNewDecFromStr("0.5")
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10020))))
+
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	moduleBalance := func(module string) int64 {
+		return app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(module), denoms.NUSD).Amount.Int64()
+	}
+
+	t.Log("half of the exchange fee goes to the insurance fund")
+	require.EqualValues(t, 5, moduleBalance(v2types.InsuranceFundModuleAccount))
+	require.EqualValues(t, 5, moduleBalance(v2types.FeePoolModuleAccount))
+	require.EqualValues(t, 10, moduleBalance(v2types.PerpEFModuleAccount))
+	require.True(t, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).IsZero())
+}
+
+func TestBadDebtWaterfall(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, testutil.AccAddress(), 10)
+
+	// leave 200 in the ecosystem fund and put 500 in the insurance fund
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.PerpEFModuleAccount, testutil.AccAddress(), sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e4-200))))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 500))))
+
+	// alice's btc long lost ~1000 on a margin of 100, bob's eth short is ~1000
+	// in profit
+	insertPosition(t, app, ctx, btc, alice, 1000, 2000, 100)
+	insertPosition(t, app, ctx, eth, bob, -1000, 2000, 100)
+
+	resp, err := app.PerpKeeperV2.MultiLiquidate(ctx, liquidator, []*v2types.MsgMultiLiquidate_Liquidation{
+		{Pair: btc, Trader: alice.String()},
+	})
+	require.NoError(t, err)
+	require.True(t, resp[0].Success)
+
+	t.Log("the insurance fund is drained before the ecosystem fund")
+	for _, module := range []string{v2types.InsuranceFundModuleAccount, v2types.PerpEFModuleAccount} {
+		require.True(t, app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(module), denoms.NUSD).IsZero())
+	}
+
+	t.Log("the rest of the bad debt is left for the EndBlocker")
+	pending, err := app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, pending.Amount.IsPositive())
+	testutil.RequireNotHasTypedEvent(t, ctx, &v2types.LossSocializedEvent{})
+
+	t.Log("the rest of the bad debt is taken from bob's unrealized PnL")
+	app.PerpKeeperV2.SocializeLosses(ctx)
+	_, err = app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.NUSD)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	var socialized *v2types.LossSocializedEvent
+	for _, abciEvent := range ctx.EventManager().Events() {
+		if abciEvent.Type == proto.MessageName(&v2types.LossSocializedEvent{}) {
+			typedEvent, err := sdk.ParseTypedEvent(abci.Event{Type: abciEvent.Type, Attributes: abciEvent.Attributes})
+			require.NoError(t, err)
+			socialized = typedEvent.(*v2types.LossSocializedEvent)
+		}
+	}
+	require.NotNil(t, socialized)
+	require.Equal(t, denoms.NUSD, socialized.Denom)
+	require.Equal(t, pending.Amount, socialized.Loss)
+	require.Equal(t, socialized.Loss.ToDec(), socialized.SocializedLoss)
+
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(eth, bob))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2000).Sub(socialized.SocializedLoss), position.OpenNotional)
+}
+
+func TestSocializeLossesScanBudget(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	traders := []sdk.AccAddress{testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()}
+	sort.Slice(traders, func(i, j int) bool { return traders[i].String() < traders[j].String() })
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.MaxPositionsScannedPerBlock = 1
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	// at a price of 1, every long is ~50 in profit
+	for _, trader := range traders {
+		insertPosition(t, app, ctx, pair, trader, 100, 50, 10)
+	}
+	app.PerpKeeperV2.PendingLosses.Insert(ctx, denoms.NUSD, v2types.PendingLoss{Denom: denoms.NUSD, Amount: sdk.NewInt(80)})
+
+	openNotional := func(trader sdk.AccAddress) sdk.Dec {
+		position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+		require.NoError(t, err)
+		return position.OpenNotional
+	}
+
+	t.Log("each block takes the loss from the positions it checks, up to their PnL")
+	app.PerpKeeperV2.SocializeLosses(ctx)
+	require.True(t, openNotional(traders[0]).GT(sdk.NewDec(90)))
+	require.Equal(t, sdk.NewDec(50), openNotional(traders[1]))
+
+	pending, err := app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.NUSD)
+	require.NoError(t, err)
+	require.True(t, pending.Amount.IsPositive())
+	require.Equal(t, traders[0].String(), pending.Cursor.TraderAddress)
+
+	t.Log("the next block resumes after the last position checked")
+	app.PerpKeeperV2.SocializeLosses(ctx)
+	require.Equal(t, sdk.NewDec(50).Add(pending.Amount.ToDec()), openNotional(traders[1]))
+	require.Equal(t, sdk.NewDec(50), openNotional(traders[2]))
+
+	_, err = app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.NUSD)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestSocializeLossesBudgetSharedAcrossDenoms(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	trader := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.MaxPositionsScannedPerBlock = 1
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	insertPosition(t, app, ctx, pair, trader, 100, 50, 10)
+	app.PerpKeeperV2.PendingLosses.Insert(ctx, denoms.NUSD, v2types.PendingLoss{Denom: denoms.NUSD, Amount: sdk.NewInt(10)})
+	app.PerpKeeperV2.PendingLosses.Insert(ctx, denoms.USDC, v2types.PendingLoss{Denom: denoms.USDC, Amount: sdk.NewInt(10)})
+
+	t.Log("the first pending loss spends the budget of the block")
+	app.PerpKeeperV2.SocializeLosses(ctx)
+	_, err := app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.NUSD)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	pending, err := app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.USDC)
+	require.NoError(t, err)
+	require.Nil(t, pending.Cursor)
+
+	t.Log("the next block scans for the other one")
+	app.PerpKeeperV2.SocializeLosses(ctx)
+	pending, err = app.PerpKeeperV2.PendingLosses.Get(ctx, denoms.USDC)
+	require.NoError(t, err)
+	require.Equal(t, trader.String(), pending.Cursor.TraderAddress)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// RegisterInvariants registers the perp v2 invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(v2types.ModuleName, "vault-solvency", VaultSolvencyInvariant(k))
+	ir.RegisterRoute(v2types.ModuleName, "open-interest", OpenInterestInvariant(k))
+	ir.RegisterRoute(v2types.ModuleName, "constant-product", ConstantProductInvariant(k))
+}
+
+// AllInvariants runs all the invariants of the perp v2 module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			VaultSolvencyInvariant(k),
+			OpenInterestInvariant(k),
+			ConstantProductInvariant(k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// VaultSolvencyInvariant checks that, for every quote denom, the vault and the
+// ecosystem fund hold enough to pay out the cross-margin collateral and the
+// equity of every position closed at the spot price: its margin plus
+// unrealized PnL less its funding payment. Underwater positions count for
+// nothing, positions of settled markets are left out.
+func VaultSolvencyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		owed := make(map[string]sdk.Dec)
+		owe := func(denom string, amount sdk.Dec) {
+			if _, found := owed[denom]; !found {
+				owed[denom] = sdk.ZeroDec()
+			}
+			owed[denom] = owed[denom].Add(amount)
+		}
+
+		for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if market.Settled {
+				continue
+			}
+
+			amm, err := k.AMMs.Get(ctx, market.Pair)
+			if err != nil {
+				return sdk.FormatInvariant(v2types.ModuleName, "vault-solvency", fmt.Sprintf("no amm for market %s", market.Pair)), true
+			}
+
+			positions := k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(market.Pair)).Values()
+			for _, position := range positions {
+				positionNotional, err := PositionNotionalSpot(amm, position)
+				if err != nil {
+					return sdk.FormatInvariant(v2types.ModuleName, "vault-solvency", err.Error()), true
+				}
+				equity := position.Margin.
+					Add(UnrealizedPnl(position, positionNotional)).
+					Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+				owe(market.Pair.QuoteDenom(), sdk.MaxDec(equity, sdk.ZeroDec()))
+			}
+		}
+
+		for _, account := range k.CrossMarginAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values() {
+			for _, coin := range account.Collateral {
+				owe(coin.Denom, coin.Amount.ToDec())
+			}
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+		vault := k.AccountKeeper.GetModuleAddress(v2types.VaultModuleAccount)
+		ecosystemFund := k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount)
+		denoms := make([]string, 0, len(owed))
+		for denom := range owed {
+			denoms = append(denoms, denom)
+		}
+		sort.Strings(denoms)
+		for _, denom := range denoms {
+			amount := owed[denom]
+			available := k.BankKeeper.GetBalance(ctx, vault, denom).Amount.
+				Add(k.BankKeeper.GetBalance(ctx, ecosystemFund, denom).Amount)
+			if available.ToDec().LT(amount) {
+				broken = true
+				msg += fmt.Sprintf("\tvault and ecosystem fund hold %s%s, %s%s is owed\n", available, denom, amount, denom)
+			}
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "vault-solvency", msg), broken
+	}
+}
+
+// OpenInterestInvariant checks that the open interest of every market is the
+// sum of the sizes of its long and short positions, and that the bias of its
+// AMM is their net size. The total long and total short of an AMM are
+// cumulative swap flows, e.g. closing a long adds to the total short, so only
+// their difference is compared with the positions.
+func OpenInterestInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, amm := range k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			totalLong, totalShort := sdk.ZeroDec(), sdk.ZeroDec()
+			positions := k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(amm.Pair)).Values()
+			for _, position := range positions {
+				if position.Size_.IsPositive() {
+					totalLong = totalLong.Add(position.Size_)
+				} else {
+					totalShort = totalShort.Sub(position.Size_)
+				}
+			}
+
+			longOpenInterest, shortOpenInterest := k.OpenInterest(ctx, amm.Pair)
+			if !longOpenInterest.Equal(totalLong) || !shortOpenInterest.Equal(totalShort) {
+				broken = true
+				msg += fmt.Sprintf(
+					"\t%s: open interest long %s and short %s, positions sum to %s and %s\n",
+					amm.Pair, longOpenInterest, shortOpenInterest, totalLong, totalShort)
+			}
+
+			if netSize := totalLong.Sub(totalShort); !amm.Bias().Equal(netSize) {
+				broken = true
+				msg += fmt.Sprintf("\t%s: amm bias %s, positions net to %s\n", amm.Pair, amm.Bias(), netSize)
+			}
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "open-interest", msg), broken
+	}
+}
+
+// ConstantProductInvariant checks that the reserves of every AMM are positive
+// and that their product is the square of its sqrt depth.
+func ConstantProductInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+		for _, amm := range k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if err := amm.ValidateReserves(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s: %s\n", amm.Pair, err)
+				continue
+			}
+			if err := amm.ValidateLiquidityDepth(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s: %s\n", amm.Pair, err)
+			}
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "constant-product", msg), broken
+	}
+}
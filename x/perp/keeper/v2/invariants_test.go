@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestInvariants(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := setupCrossMarginMarkets(t, alice, 100)
+	insertPosition(t, app, ctx, btc, bob, 10, 10, 5)
+
+	t.Log("a consistent state breaks no invariant")
+	_, broken := keeper.AllInvariants(app.PerpKeeperV2)(ctx)
+	require.False(t, broken)
+
+	t.Log("opening and closing positions through the amm breaks no invariant")
+	carol := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, carol, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1000))))
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, btc, v2types.Direction_LONG, carol, sdk.NewInt(100), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, btc, v2types.Direction_SHORT, bob, sdk.NewInt(10), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, btc, carol)
+	require.NoError(t, err)
+	_, broken = keeper.AllInvariants(app.PerpKeeperV2)(ctx)
+	require.False(t, broken)
+
+	t.Log("the amm bias must match the positions")
+	cachedCtx, _ := ctx.CacheContext()
+	amm, err := app.PerpKeeperV2.AMMs.Get(cachedCtx, btc)
+	require.NoError(t, err)
+	amm.TotalLong = amm.TotalLong.Add(sdk.OneDec())
+	app.PerpKeeperV2.AMMs.Insert(cachedCtx, btc, amm)
+	_, broken = keeper.OpenInterestInvariant(app.PerpKeeperV2)(cachedCtx)
+	require.True(t, broken)
+
+	t.Log("the open interest must match the positions")
+	cachedCtx, _ = ctx.CacheContext()
+	app.PerpKeeperV2.ShortOpenInterest.Insert(cachedCtx, btc, sdk.OneDec())
+	_, broken = keeper.OpenInterestInvariant(app.PerpKeeperV2)(cachedCtx)
+	require.True(t, broken)
+
+	t.Log("the reserves must multiply to the square of the sqrt depth")
+	cachedCtx, _ = ctx.CacheContext()
+	amm, err = app.PerpKeeperV2.AMMs.Get(cachedCtx, btc)
+	require.NoError(t, err)
+	amm.BaseReserve = amm.BaseReserve.MulInt64(2)
+	app.PerpKeeperV2.AMMs.Insert(cachedCtx, btc, amm)
+	_, broken = keeper.ConstantProductInvariant(app.PerpKeeperV2)(cachedCtx)
+	require.True(t, broken)
+
+	t.Log("the vault and the ecosystem fund must cover the collateral and the positions")
+	cachedCtx, _ = ctx.CacheContext()
+	for _, moduleAccount := range []string{v2types.VaultModuleAccount, v2types.PerpEFModuleAccount} {
+		balance := app.BankKeeper.GetBalance(cachedCtx, app.AccountKeeper.GetModuleAddress(moduleAccount), denoms.NUSD)
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(cachedCtx, moduleAccount, bob, sdk.NewCoins(balance.SubAmount(sdk.NewInt(50)))))
+	}
+	_, broken = keeper.VaultSolvencyInvariant(app.PerpKeeperV2)(cachedCtx)
+	require.True(t, broken)
+}
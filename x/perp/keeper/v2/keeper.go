@@ -11,11 +11,35 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
 
+// namespaces of the keeper collections
+const (
+	marketsNamespace collections.Namespace = iota
+	ammsNamespace
+	positionsNamespace
+	reserveSnapshotsNamespace
+	ordersNamespace
+	orderTriggersNamespace
+	nextOrderIDNamespace
+	crossMarginAccountsNamespace
+	insuranceFundWithdrawalsNamespace
+	traderVolumesNamespace
+	referralCodesNamespace
+	traderReferralsNamespace
+	referralEarningsNamespace
+	fundingRatesNamespace
+	collateralAssetsNamespace
+	liquidationSweepCursorNamespace
+	pendingLossesNamespace
+	longOpenInterestNamespace
+	shortOpenInterestNamespace
+)
+
 type Keeper struct {
 	cdc           codec.BinaryCodec
 	storeKey      sdk.StoreKey
@@ -25,11 +49,56 @@ type Keeper struct {
 	AccountKeeper types.AccountKeeper
 	OracleKeeper  types.OracleKeeper
 	EpochKeeper   types.EpochKeeper
+	SpotKeeper    v2types.SpotKeeper
 
 	Markets          collections.Map[asset.Pair, v2types.Market]
 	AMMs             collections.Map[asset.Pair, v2types.AMM]
 	Positions        collections.Map[collections.Pair[asset.Pair, sdk.AccAddress], v2types.Position]
 	ReserveSnapshots collections.Map[collections.Pair[asset.Pair, time.Time], v2types.ReserveSnapshot]
+
+	// Orders holds the trigger orders by id, OrderTriggers indexes them by pair
+	// and trigger book, then by trigger price, see orderBook.
+	Orders        collections.Map[uint64, v2types.Order]
+	OrderTriggers collections.KeySet[OrderTrigger]
+	NextOrderID   collections.Sequence
+
+	CrossMarginAccounts collections.Map[sdk.AccAddress, v2types.CrossMarginAccount]
+
+	// InsuranceFundWithdrawals holds the pending insurance fund withdrawals by
+	// unlock time and staker.
+	InsuranceFundWithdrawals collections.Map[collections.Pair[time.Time, sdk.AccAddress], v2types.InsuranceFundWithdrawal]
+
+	// TraderVolumes holds the daily quote volume of the traders by trader and
+	// day, over the window that sets their fee tier.
+	TraderVolumes collections.Map[collections.Pair[sdk.AccAddress, uint64], v2types.TraderVolume]
+
+	// ReferralCodes holds the referral codes by code, TraderReferrals the code
+	// each referred trader is bound to, and ReferralEarnings the unclaimed
+	// earnings of the referrers.
+	ReferralCodes    collections.Map[string, v2types.ReferralCode]
+	TraderReferrals  collections.Map[sdk.AccAddress, v2types.TraderReferral]
+	ReferralEarnings collections.Map[sdk.AccAddress, v2types.ReferralEarnings]
+
+	// FundingRates holds the funding rate history of the markets by pair and
+	// funding epoch.
+	FundingRates collections.Map[collections.Pair[asset.Pair, uint64], v2types.FundingRateRecord]
+
+	// CollateralAssets holds the assets accepted as cross-margin collateral
+	// besides the quote denoms, by denom.
+	CollateralAssets collections.Map[string, v2types.CollateralAsset]
+
+	// LiquidationSweepCursor holds the position the liquidation sweep checked
+	// last, see SweepLiquidations.
+	LiquidationSweepCursor collections.Item[v2types.PositionCursor]
+
+	// PendingLosses holds the losses left to socialize by denom, see
+	// SocializeLosses.
+	PendingLosses collections.Map[string, v2types.PendingLoss]
+
+	// LongOpenInterest and ShortOpenInterest hold the total size of the long
+	// and short positions by pair, see SetPosition.
+	LongOpenInterest  collections.Map[asset.Pair, sdk.Dec]
+	ShortOpenInterest collections.Map[asset.Pair, sdk.Dec]
 }
 
 // NewKeeper Creates a new x/perp Keeper instance.
@@ -42,6 +111,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
 	epochKeeper types.EpochKeeper,
+	spotKeeper v2types.SpotKeeper,
 ) Keeper {
 	// Ensure that the module account is set.
 	if moduleAcc := accountKeeper.GetModuleAddress(types.ModuleName); moduleAcc == nil {
@@ -50,7 +120,7 @@ func NewKeeper(
 
 	// Set param.types.'KeyTable' if it has not already been set
 	if !paramSubspace.HasKeyTable() {
-		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+		paramSubspace = paramSubspace.WithKeyTable(v2types.ParamKeyTable())
 	}
 
 	return Keeper{
@@ -61,26 +131,99 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		OracleKeeper:  oracleKeeper,
 		EpochKeeper:   epochKeeper,
+		SpotKeeper:    spotKeeper,
 		Markets: collections.NewMap(
-			storeKey, 0,
+			storeKey, marketsNamespace,
 			asset.PairKeyEncoder,
 			collections.ProtoValueEncoder[v2types.Market](cdc),
 		),
 		AMMs: collections.NewMap(
-			storeKey, 1,
+			storeKey, ammsNamespace,
 			asset.PairKeyEncoder,
 			collections.ProtoValueEncoder[v2types.AMM](cdc),
 		),
 		Positions: collections.NewMap(
-			storeKey, 2,
+			storeKey, positionsNamespace,
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
 			collections.ProtoValueEncoder[v2types.Position](cdc),
 		),
 		ReserveSnapshots: collections.NewMap(
-			storeKey, 3,
+			storeKey, reserveSnapshotsNamespace,
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder),
 			collections.ProtoValueEncoder[v2types.ReserveSnapshot](cdc),
 		),
+		Orders: collections.NewMap(
+			storeKey, ordersNamespace,
+			collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[v2types.Order](cdc),
+		),
+		OrderTriggers: collections.NewKeySet(
+			storeKey, orderTriggersNamespace,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+				collections.PairKeyEncoder(common.DecKeyEncoder, collections.Uint64KeyEncoder),
+			),
+		),
+		NextOrderID: collections.NewSequence(storeKey, nextOrderIDNamespace),
+		CrossMarginAccounts: collections.NewMap(
+			storeKey, crossMarginAccountsNamespace,
+			collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[v2types.CrossMarginAccount](cdc),
+		),
+		InsuranceFundWithdrawals: collections.NewMap(
+			storeKey, insuranceFundWithdrawalsNamespace,
+			collections.PairKeyEncoder(common.TimeKeyEncoder, collections.AccAddressKeyEncoder),
+			collections.ProtoValueEncoder[v2types.InsuranceFundWithdrawal](cdc),
+		),
+		TraderVolumes: collections.NewMap(
+			storeKey, traderVolumesNamespace,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[v2types.TraderVolume](cdc),
+		),
+		ReferralCodes: collections.NewMap(
+			storeKey, referralCodesNamespace,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[v2types.ReferralCode](cdc),
+		),
+		TraderReferrals: collections.NewMap(
+			storeKey, traderReferralsNamespace,
+			collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[v2types.TraderReferral](cdc),
+		),
+		ReferralEarnings: collections.NewMap(
+			storeKey, referralEarningsNamespace,
+			collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[v2types.ReferralEarnings](cdc),
+		),
+		FundingRates: collections.NewMap(
+			storeKey, fundingRatesNamespace,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[v2types.FundingRateRecord](cdc),
+		),
+		CollateralAssets: collections.NewMap(
+			storeKey, collateralAssetsNamespace,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[v2types.CollateralAsset](cdc),
+		),
+		LiquidationSweepCursor: collections.NewItem(
+			storeKey, liquidationSweepCursorNamespace,
+			collections.ProtoValueEncoder[v2types.PositionCursor](cdc),
+		),
+		PendingLosses: collections.NewMap(
+			storeKey, pendingLossesNamespace,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[v2types.PendingLoss](cdc),
+		),
+		LongOpenInterest: collections.NewMap(
+			storeKey, longOpenInterestNamespace,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
+		ShortOpenInterest: collections.NewMap(
+			storeKey, shortOpenInterestNamespace,
+			asset.PairKeyEncoder,
+			collections.DecValueEncoder,
+		),
 	}
 }
 
@@ -88,9 +231,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", v2types.ModuleName))
 }
 
-// GetParams get all parameters as v2types.Params
+// GetParams get all parameters as v2types.Params. Params missing from the
+// store, e.g. added after the module was initialized, keep their zero value.
 func (k Keeper) GetParams(ctx sdk.Context) (params v2types.Params) {
-	k.ParamSubspace.GetParamSet(ctx, &params)
+	k.ParamSubspace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -105,6 +249,9 @@ func (k Keeper) ChangeMarketEnabledParameter(ctx sdk.Context, pair asset.Pair, e
 	if err != nil {
 		return
 	}
+	if market.Settled {
+		return v2types.ErrMarketSettled.Wrapf("pair: %s", pair)
+	}
 	market.Enabled = enabled
 	k.Markets.Insert(ctx, pair, market)
 	return
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
@@ -47,6 +49,177 @@ func (k Keeper) MultiLiquidate(
 	return resp, nil
 }
 
+// liquidationCandidate is a position, or the positions of a cross-margin
+// account, below the maintenance margin ratio.
+type liquidationCandidate struct {
+	pair        asset.Pair
+	trader      sdk.AccAddress
+	marginRatio sdk.Dec
+}
+
+// SweepLiquidations checks up to MaxPositionsScannedPerBlock positions, picking
+// up where the previous sweep stopped, and liquidates the ones below their
+// maintenance margin ratio, lowest margin ratio first, up to
+// MaxLiquidationsPerBlock positions. The ecosystem fund is the liquidator, so
+// it receives both halves of the liquidation fee. Does nothing unless the
+// LiquidationSweepEnabled param is set. Called in the EndBlocker.
+func (k Keeper) SweepLiquidations(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.LiquidationSweepEnabled || params.MaxLiquidationsPerBlock == 0 {
+		return
+	}
+
+	candidates := k.liquidationCandidates(ctx, params.MaxPositionsScannedPerBlock)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].marginRatio.LT(candidates[j].marginRatio)
+	})
+
+	liquidator := k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount)
+	var liquidated uint64
+	for _, candidate := range candidates {
+		if liquidated >= params.MaxLiquidationsPerBlock {
+			return
+		}
+
+		// the margin ratio is checked again by liquidate since previous
+		// liquidations move the mark price
+		cachedCtx, commit := ctx.CacheContext()
+		if _, _, err := k.liquidate(cachedCtx, liquidator, candidate.pair, candidate.trader); err != nil {
+			k.Logger(ctx).Error("failed to liquidate position", "pair", candidate.pair, "trader", candidate.trader, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		liquidated++
+	}
+}
+
+// liquidationCandidates checks up to budget positions, resuming after the
+// position checked last by the previous sweep, and returns the ones below the
+// maintenance margin ratio. Positions of settled markets are skipped. A
+// cross-margin account is returned once per quote denom, with the margin ratio
+// of the account.
+func (k Keeper) liquidationCandidates(ctx sdk.Context, budget uint64) (candidates []liquidationCandidate) {
+	var cursor *v2types.PositionCursor
+	if c, err := k.LiquidationSweepCursor.Get(ctx); err == nil {
+		cursor = &c
+	}
+
+	positions := k.positionsAfter(ctx, cursor, budget)
+	if len(positions) == 0 {
+		return nil
+	}
+	k.LiquidationSweepCursor.Set(ctx, *cursorAt(positions[len(positions)-1]))
+
+	crossMarginAccountsSeen := make(map[string]bool)
+	markets := make(map[asset.Pair]*v2types.Market)
+	for _, position := range positions {
+		market, ok := markets[position.Pair]
+		if !ok {
+			if m, err := k.Markets.Get(ctx, position.Pair); err == nil && !m.Settled {
+				market = &m
+			}
+			markets[position.Pair] = market
+		}
+		if market == nil {
+			continue
+		}
+
+		if candidate, ok := k.liquidationCandidateOf(ctx, *market, position, crossMarginAccountsSeen); ok {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// positionsAfter returns up to limit positions following the cursor in key
+// order, wrapping around to the first position, so that walks resumed from the
+// last position returned visit every position in turn. A nil cursor starts
+// from the first position.
+func (k Keeper) positionsAfter(ctx sdk.Context, cursor *v2types.PositionCursor, limit uint64) (positions []v2types.Position) {
+	all := collections.Range[collections.Pair[asset.Pair, sdk.AccAddress]]{}
+	ranges := []collections.Range[collections.Pair[asset.Pair, sdk.AccAddress]]{all}
+	if cursor != nil {
+		if trader, err := sdk.AccAddressFromBech32(cursor.TraderAddress); err == nil {
+			last := collections.Join(cursor.Pair, trader)
+			ranges = []collections.Range[collections.Pair[asset.Pair, sdk.AccAddress]]{
+				all.StartExclusive(last),
+				all.EndInclusive(last),
+			}
+		}
+	}
+
+	for _, rng := range ranges {
+		iter := k.Positions.Iterate(ctx, rng)
+		for ; iter.Valid() && uint64(len(positions)) < limit; iter.Next() {
+			positions = append(positions, iter.Value())
+		}
+		iter.Close()
+	}
+
+	return positions
+}
+
+// cursorAt returns the cursor resuming a walk over the positions after the
+// position.
+func cursorAt(position v2types.Position) *v2types.PositionCursor {
+	return &v2types.PositionCursor{
+		Pair:          position.Pair,
+		TraderAddress: position.TraderAddress,
+	}
+}
+
+// liquidationCandidateOf returns the position as a liquidation candidate if it
+// is below the maintenance margin ratio, or its cross-margin account if the
+// account is below its maintenance margin and wasn't seen yet.
+func (k Keeper) liquidationCandidateOf(
+	ctx sdk.Context,
+	market v2types.Market,
+	position v2types.Position,
+	crossMarginAccountsSeen map[string]bool,
+) (candidate liquidationCandidate, ok bool) {
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return candidate, false
+	}
+
+	if k.isCrossMargin(ctx, traderAddr) {
+		accountKey := position.TraderAddress + "/" + market.Pair.QuoteDenom()
+		if crossMarginAccountsSeen[accountKey] {
+			return candidate, false
+		}
+		crossMarginAccountsSeen[accountKey] = true
+
+		accountMargin, err := k.CrossMarginAccountMargin(ctx, traderAddr, market.Pair.QuoteDenom())
+		if err != nil || accountMargin.Equity.GTE(accountMargin.MaintenanceMargin) {
+			return candidate, false
+		}
+
+		return liquidationCandidate{
+			pair:        market.Pair,
+			trader:      traderAddr,
+			marginRatio: accountMargin.MarginRatio,
+		}, true
+	}
+
+	positionNotional, err := k.maxPositionNotional(ctx, market, position)
+	if err != nil {
+		return candidate, false
+	}
+
+	marginRatio := MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+	if marginRatio.GTE(market.MaintenanceMarginRatio) {
+		return candidate, false
+	}
+
+	return liquidationCandidate{
+		pair:        market.Pair,
+		trader:      traderAddr,
+		marginRatio: marginRatio,
+	}, true
+}
+
 /*
 	liquidate allows to liquidate the trader position if the margin is below the
 
@@ -79,6 +252,10 @@ func (k Keeper) liquidate(
 		return sdk.Coin{}, sdk.Coin{}, v2types.ErrPairNotFound
 	}
 
+	if market.Settled {
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrMarketSettled
+	}
+
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
 		_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationFailedEvent{
@@ -101,6 +278,11 @@ func (k Keeper) liquidate(
 		return
 	}
 
+	// the positions of a cross-margin account are liquidated together
+	if k.isCrossMargin(ctx, trader) {
+		return k.liquidateCrossMarginAccount(ctx, liquidator, trader, pair.QuoteDenom())
+	}
+
 	spotNotional, err := PositionNotionalSpot(amm, position)
 	if err != nil {
 		return
@@ -194,12 +376,17 @@ func (k Keeper) executeFullLiquidation(
 		remainMargin = remainMargin.Sub(feeToLiquidator)
 	}
 
-	// Realize bad debt
+	// Realize bad debt, deleveraging the counterparties of the position if needed
 	if totalBadDebt.IsPositive() {
+		adlSide := v2types.Direction_SHORT
+		if position.Size_.IsNegative() {
+			adlSide = v2types.Direction_LONG
+		}
 		if err = k.realizeBadDebt(
 			ctx,
 			market,
 			totalBadDebt.RoundInt(),
+			adlSide,
 		); err != nil {
 			return v2types.LiquidateResp{}, err
 		}
@@ -281,7 +468,14 @@ func (k Keeper) executePartialLiquidation(
 	// Remove the liquidation fee from the margin of the position
 	liquidationFeeAmount := quoteAssetDelta.Mul(market.LiquidationFeeRatio)
 	positionResp.Position.Margin = positionResp.Position.Margin.Sub(liquidationFeeAmount)
-	k.Positions.Insert(ctx, collections.Join(positionResp.Position.Pair, traderAddr), *positionResp.Position)
+	positionResp.Position.CarryAccounting(
+		*currentPosition,
+		positionResp.RealizedPnl,
+		positionResp.FundingPayment,
+		liquidationFeeAmount,
+		ctx.BlockTime().UnixMilli(),
+	)
+	k.SetPosition(ctx, traderAddr, *positionResp.Position)
 
 	// Compute splits for the liquidation fee
 	feeToLiquidator := liquidationFeeAmount.QuoInt64(2)
@@ -342,8 +536,31 @@ func (k Keeper) distributeLiquidateRewards(
 	// Distribution of rewards
 	// --------------------------------------------------------------
 
-	// Transfer fee from vault to PerpEF
+	// the liquidator fee of a liquidation made by the EndBlocker sweep also goes
+	// to the PerpEF
 	feeToPerpEF := liquidateResp.FeeToPerpEcosystemFund
+	feeToLiquidator := liquidateResp.FeeToLiquidator
+	if liquidator.Equals(k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount)) {
+		feeToPerpEF = feeToPerpEF.Add(feeToLiquidator)
+		feeToLiquidator = sdk.ZeroInt()
+	}
+
+	// Transfer the insurance fund cut of the PerpEF fee from vault to the
+	// insurance fund
+	feeToInsuranceFund := k.insuranceFundCut(ctx, feeToPerpEF)
+	if feeToInsuranceFund.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ v2types.VaultModuleAccount,
+			/* to */ v2types.InsuranceFundModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(market.Pair.QuoteDenom(), feeToInsuranceFund)),
+		); err != nil {
+			return err
+		}
+		feeToPerpEF = feeToPerpEF.Sub(feeToInsuranceFund)
+	}
+
+	// Transfer fee from vault to PerpEF
 	if feeToPerpEF.IsPositive() {
 		coinToPerpEF := sdk.NewCoin(market.Pair.QuoteDenom(), feeToPerpEF)
 		if err = k.BankKeeper.SendCoinsFromModuleToModule(
@@ -357,7 +574,6 @@ func (k Keeper) distributeLiquidateRewards(
 	}
 
 	// Transfer fee from vault to liquidator
-	feeToLiquidator := liquidateResp.FeeToLiquidator
 	if feeToLiquidator.IsPositive() {
 		err = k.Withdraw(ctx, market, liquidator, feeToLiquidator)
 		if err != nil {
@@ -1,16 +1,20 @@
 package keeper_test
 
 import (
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
 	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
 	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
@@ -217,3 +221,91 @@ func TestMultiLiquidate(t *testing.T) {
 
 	NewTestSuite(t).WithTestCases(tc...).Run()
 }
+
+func TestSweepLiquidations(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1000))))
+
+	// at a price of 1, alice and bob are underwater, alice the most, and carol
+	// is healthy
+	insertPosition(t, app, ctx, pair, alice, 100, 110, 1)
+	insertPosition(t, app, ctx, pair, bob, 100, 105, 1)
+	insertPosition(t, app, ctx, pair, carol, 100, 100, 10)
+
+	hasPosition := func(trader sdk.AccAddress) bool {
+		_, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+		return err == nil
+	}
+
+	t.Log("the sweep is disabled by default")
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.True(t, hasPosition(alice))
+	require.True(t, hasPosition(bob))
+
+	params := v2types.DefaultParams()
+	params.LiquidationSweepEnabled = true
+	params.MaxLiquidationsPerBlock = 1
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	t.Log("the lowest margin ratio is liquidated first, one position per block")
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.False(t, hasPosition(alice))
+	require.True(t, hasPosition(bob))
+
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.False(t, hasPosition(bob))
+
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.True(t, hasPosition(carol))
+}
+
+func TestSweepLiquidationsScanBudget(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	traders := []sdk.AccAddress{testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()}
+	sort.Slice(traders, func(i, j int) bool { return traders[i].String() < traders[j].String() })
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1000))))
+
+	// in key order, the first trader is healthy and the others are underwater
+	insertPosition(t, app, ctx, pair, traders[0], 100, 100, 10)
+	insertPosition(t, app, ctx, pair, traders[1], 100, 110, 1)
+	insertPosition(t, app, ctx, pair, traders[2], 100, 105, 1)
+
+	params := v2types.DefaultParams()
+	params.LiquidationSweepEnabled = true
+	params.MaxPositionsScannedPerBlock = 1
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	hasPosition := func(trader sdk.AccAddress) bool {
+		_, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+		return err == nil
+	}
+
+	t.Log("each sweep checks one position, resuming after the last one checked")
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.True(t, hasPosition(traders[1]))
+	require.True(t, hasPosition(traders[2]))
+
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.False(t, hasPosition(traders[1]))
+	require.True(t, hasPosition(traders[2]))
+
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.False(t, hasPosition(traders[2]))
+	require.True(t, hasPosition(traders[0]))
+
+	t.Log("the sweep wraps around to the first position")
+	insertPosition(t, app, ctx, pair, traders[1], 100, 110, 1)
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.True(t, hasPosition(traders[1]))
+
+	app.PerpKeeperV2.SweepLiquidations(ctx)
+	require.False(t, hasPosition(traders[1]))
+	require.True(t, hasPosition(traders[0]))
+}
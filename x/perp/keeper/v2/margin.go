@@ -25,6 +25,10 @@ func (k Keeper) AddMargin(
 		return nil, types.ErrPairNotFound
 	}
 
+	if market.Settled {
+		return nil, v2types.ErrMarketSettled
+	}
+
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
 		return nil, types.ErrPairNotFound
@@ -53,9 +57,10 @@ func (k Keeper) AddMargin(
 
 	// apply funding payment and add margin
 	position.Margin = remainingMargin
+	position.CarryAccounting(position, sdk.ZeroDec(), fundingPayment, sdk.ZeroDec(), ctx.BlockTime().UnixMilli())
 	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
 	position.LastUpdatedBlockNumber = ctx.BlockHeight()
-	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.SetPosition(ctx, traderAddr, position)
 
 	positionNotional, err := PositionNotionalSpot(amm, position)
 	if err != nil {
@@ -118,6 +123,10 @@ func (k Keeper) RemoveMargin(
 		return nil, types.ErrPairNotFound
 	}
 
+	if market.Settled {
+		return nil, v2types.ErrMarketSettled
+	}
+
 	amm, err := k.AMMs.Get(ctx, pair)
 	if err != nil {
 		return nil, types.ErrPairNotFound
@@ -149,6 +158,13 @@ func (k Keeper) RemoveMargin(
 		remainingMargin = remainingMargin.Add(unrealizedPnl)
 	}
 
+	// the losses of a cross-margin trader are backed by the whole account,
+	// which is checked once the margin is removed
+	isCrossMargin := k.isCrossMargin(ctx, traderAddr)
+	if isCrossMargin {
+		remainingMargin = position.Margin.Sub(fundingPayment)
+	}
+
 	if remainingMargin.LT(marginToRemove.Amount.ToDec()) {
 		return nil, fmt.Errorf("not enough free collateral")
 	}
@@ -159,9 +175,16 @@ func (k Keeper) RemoveMargin(
 
 	// apply funding payment and remove margin
 	position.Margin = position.Margin.Sub(fundingPayment).Sub(marginToRemove.Amount.ToDec())
+	position.CarryAccounting(position, sdk.ZeroDec(), fundingPayment, sdk.ZeroDec(), ctx.BlockTime().UnixMilli())
 	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
 	position.LastUpdatedBlockNumber = ctx.BlockHeight()
-	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+	k.SetPosition(ctx, traderAddr, position)
+
+	if isCrossMargin {
+		if err = k.checkCrossMarginHealthy(ctx, traderAddr, pair.QuoteDenom()); err != nil {
+			return nil, err
+		}
+	}
 
 	if err = ctx.EventManager().EmitTypedEvent(
 		&types.PositionChangedEvent{
@@ -61,6 +61,7 @@ func TestAddMargin(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.NewInt(20)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
 				}),
 				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
 				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10)),
@@ -105,6 +106,7 @@ func TestAddMargin(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.NewInt(20)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
 				}),
 				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
 				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10)),
@@ -159,6 +161,7 @@ func TestRemoveMargin(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.NewInt(2)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
 				}),
 				BalanceEqual(alice, denoms.USDC, sdk.NewInt(500)),
 				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(1)),
@@ -203,6 +206,7 @@ func TestRemoveMargin(t *testing.T) {
 					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.NewInt(2)), // 20 bps
 					BlockHeight:        2,
 					BlockTimeMs:        startBlockTime.Add(time.Second*5).UnixNano() / 1e6,
+					MakerRebate:        sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
 				}),
 				BalanceEqual(alice, denoms.USDC, sdk.NewInt(500)),
 				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(1)),
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type ArgsCreateMarket struct {
+	Market          v2types.Market
+	SqrtDepth       sdk.Dec
+	PriceMultiplier sdk.Dec
+}
+
+// CreateMarket creates a market and the AMM backing it. The AMM starts with
+// base and quote reserves equal to the sqrt depth, so the initial mark price is
+// given by the price multiplier.
+func (k Keeper) CreateMarket(ctx sdk.Context, args ArgsCreateMarket) error {
+	market := args.Market
+	pair := market.Pair
+
+	if err := pair.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := k.Markets.Get(ctx, pair); err == nil {
+		return fmt.Errorf("market %s already exists", pair)
+	}
+
+	if _, err := k.AMMs.Get(ctx, pair); err == nil {
+		return fmt.Errorf("amm %s already exists", pair)
+	}
+
+	market.LatestCumulativePremiumFraction = sdk.ZeroDec()
+	market.PrepaidBadDebt = sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt())
+	market.Settled = false
+	market.SettlementPrice = sdk.ZeroDec()
+	zeroUnsetMarketFields(&market)
+	if err := market.Validate(); err != nil {
+		return err
+	}
+
+	amm := v2types.AMM{
+		Pair:            pair,
+		BaseReserve:     args.SqrtDepth,
+		QuoteReserve:    args.SqrtDepth,
+		SqrtDepth:       args.SqrtDepth,
+		PriceMultiplier: args.PriceMultiplier,
+		TotalLong:       sdk.ZeroDec(),
+		TotalShort:      sdk.ZeroDec(),
+	}
+	if err := amm.Validate(); err != nil {
+		return err
+	}
+
+	if err := common.TryCatch(func() {
+		k.Markets.Insert(ctx, pair, market)
+		k.AMMs.Insert(ctx, pair, amm)
+		k.ReserveSnapshots.Insert(
+			ctx,
+			collections.Join(pair, ctx.BlockTime()),
+			v2types.ReserveSnapshot{
+				Amm:         amm,
+				TimestampMs: ctx.BlockTime().UnixMilli(),
+			},
+		)
+	})(); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&v2types.MarketUpdatedEvent{
+		FinalMarket: market,
+	}); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.AmmUpdatedEvent{
+		FinalAmm: amm,
+		Cost:     sdk.ZeroInt(),
+	})
+}
+
+// EditMarket replaces the risk parameters of an existing market with the ones
+// of newMarket. The state fields of the market (latest cumulative premium
+// fraction and prepaid bad debt) are kept as they are.
+func (k Keeper) EditMarket(ctx sdk.Context, newMarket v2types.Market) error {
+	market, err := k.Markets.Get(ctx, newMarket.Pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", newMarket.Pair)
+	}
+
+	if market.Settled {
+		return v2types.ErrMarketSettled.Wrapf("pair: %s", newMarket.Pair)
+	}
+
+	market.Enabled = newMarket.Enabled
+	market.PriceFluctuationLimitRatio = newMarket.PriceFluctuationLimitRatio
+	market.MaintenanceMarginRatio = newMarket.MaintenanceMarginRatio
+	market.MaxLeverage = newMarket.MaxLeverage
+	market.ExchangeFeeRatio = newMarket.ExchangeFeeRatio
+	market.EcosystemFundFeeRatio = newMarket.EcosystemFundFeeRatio
+	market.LiquidationFeeRatio = newMarket.LiquidationFeeRatio
+	market.PartialLiquidationRatio = newMarket.PartialLiquidationRatio
+	market.FundingRateEpochId = newMarket.FundingRateEpochId
+	market.TwapLookbackWindow = newMarket.TwapLookbackWindow
+	market.RepegDivergenceThreshold = newMarket.RepegDivergenceThreshold
+	market.RepegBudgetPerEpoch = newMarket.RepegBudgetPerEpoch
+	market.MaxOpenInterest = newMarket.MaxOpenInterest
+	market.MaxTraderNotional = newMarket.MaxTraderNotional
+	market.MaxBias = newMarket.MaxBias
+	market.MaxFundingRate = newMarket.MaxFundingRate
+	market.FundingInterestRate = newMarket.FundingInterestRate
+	market.FundingDampener = newMarket.FundingDampener
+	zeroUnsetMarketFields(&market)
+
+	if err := market.Validate(); err != nil {
+		return err
+	}
+
+	k.Markets.Insert(ctx, market.Pair, market)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.MarketUpdatedEvent{
+		FinalMarket: market,
+	})
+}
+
+// zeroUnsetMarketFields sets the optional market fields left unset, which
+// disable the feature they control when zero, to zero.
+func zeroUnsetMarketFields(market *v2types.Market) {
+	if market.RepegDivergenceThreshold.IsNil() {
+		market.RepegDivergenceThreshold = sdk.ZeroDec()
+	}
+	if market.RepegBudgetPerEpoch.IsNil() {
+		market.RepegBudgetPerEpoch = sdk.ZeroInt()
+	}
+	if market.MaxOpenInterest.IsNil() {
+		market.MaxOpenInterest = sdk.ZeroDec()
+	}
+	if market.MaxTraderNotional.IsNil() {
+		market.MaxTraderNotional = sdk.ZeroDec()
+	}
+	if market.MaxBias.IsNil() {
+		market.MaxBias = sdk.ZeroDec()
+	}
+	if market.MaxFundingRate.IsNil() {
+		market.MaxFundingRate = sdk.ZeroDec()
+	}
+	if market.FundingInterestRate.IsNil() {
+		market.FundingInterestRate = sdk.ZeroDec()
+	}
+	if market.FundingDampener.IsNil() {
+		market.FundingDampener = sdk.ZeroDec()
+	}
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestCreateMarket(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	t.Run("success", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+		require.NoError(t, app.PerpKeeperV2.CreateMarket(ctx, keeper.ArgsCreateMarket{
+			Market:          *mock.TestMarket(),
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.NewDec(2),
+		}))
+
+		market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+		require.NoError(t, err)
+		require.True(t, market.LatestCumulativePremiumFraction.IsZero())
+		require.Equal(t, sdk.NewCoin(denoms.NUSD, sdk.ZeroInt()), market.PrepaidBadDebt)
+
+		amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(1e6), amm.BaseReserve)
+		require.Equal(t, sdk.NewDec(1e6), amm.QuoteReserve)
+		require.Equal(t, sdk.NewDec(2), amm.MarkPrice())
+
+		snapshots := app.PerpKeeperV2.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Values()
+		require.Len(t, snapshots, 1)
+	})
+
+	t.Run("fails if market already exists", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		args := keeper.ArgsCreateMarket{
+			Market:          *mock.TestMarket(),
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.OneDec(),
+		}
+
+		require.NoError(t, app.PerpKeeperV2.CreateMarket(ctx, args))
+		require.Error(t, app.PerpKeeperV2.CreateMarket(ctx, args))
+	})
+
+	t.Run("fails on invalid market", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		market := mock.TestMarket()
+		market.MaxLeverage = sdk.ZeroDec()
+
+		require.Error(t, app.PerpKeeperV2.CreateMarket(ctx, keeper.ArgsCreateMarket{
+			Market:          *market,
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.OneDec(),
+		}))
+	})
+
+	t.Run("fails on invalid amm", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+		require.Error(t, app.PerpKeeperV2.CreateMarket(ctx, keeper.ArgsCreateMarket{
+			Market:          *mock.TestMarket(),
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.ZeroDec(),
+		}))
+	})
+}
+
+func TestEditMarket(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	t.Run("success", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		require.NoError(t, app.PerpKeeperV2.CreateMarket(ctx, keeper.ArgsCreateMarket{
+			Market:          *mock.TestMarket(),
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.OneDec(),
+		}))
+
+		market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+		require.NoError(t, err)
+		market.LatestCumulativePremiumFraction = sdk.NewDec(5)
+		market.PrepaidBadDebt = sdk.NewInt64Coin(denoms.NUSD, 10)
+		app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+
+		newMarket := mock.TestMarket()
+		newMarket.MaxLeverage = sdk.NewDec(5)
+		newMarket.MaintenanceMarginRatio = sdk.MustNewDecFromStr("0.1")
+		require.NoError(t, app.PerpKeeperV2.EditMarket(ctx, *newMarket))
+
+		market, err = app.PerpKeeperV2.Markets.Get(ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(5), market.MaxLeverage)
+		require.Equal(t, sdk.MustNewDecFromStr("0.1"), market.MaintenanceMarginRatio)
+		require.Equal(t, sdk.NewDec(5), market.LatestCumulativePremiumFraction)
+		require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 10), market.PrepaidBadDebt)
+	})
+
+	t.Run("fails if market does not exist", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		require.ErrorIs(t, app.PerpKeeperV2.EditMarket(ctx, *mock.TestMarket()), v2types.ErrPairNotFound)
+	})
+
+	t.Run("fails on invalid market", func(t *testing.T) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		require.NoError(t, app.PerpKeeperV2.CreateMarket(ctx, keeper.ArgsCreateMarket{
+			Market:          *mock.TestMarket(),
+			SqrtDepth:       sdk.NewDec(1e6),
+			PriceMultiplier: sdk.OneDec(),
+		}))
+
+		newMarket := mock.TestMarket()
+		newMarket.MaintenanceMarginRatio = sdk.MustNewDecFromStr("0.5")
+		require.Error(t, app.PerpKeeperV2.EditMarket(ctx, *newMarket))
+	})
+}
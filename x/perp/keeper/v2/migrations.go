@@ -0,0 +1,340 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	perpammkeeper "github.com/NibiruChain/nibiru/x/perp/amm/keeper"
+	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+/*
+MigrateFromV1 moves the state of x/perp (v1) and x/perp/amm into the v2
+collections:
+  - every amm pool becomes a v2 Market and AMM, taking its fee ratios and
+    funding epoch from the v1 params and its cumulative premium fraction from
+    the v1 PairMetadata.
+  - every v1 Position is copied as is.
+  - the v1 PrepaidBadDebt of a denom is split between the markets quoted in
+    that denom, pro rata to the margin of the positions migrated to them, or
+    evenly if they hold none.
+  - every amm ReserveSnapshot becomes a v2 ReserveSnapshot.
+
+The migration fails if the bias of a pool doesn't match the positions open on
+it, if a position or some prepaid bad debt has no market to go to, or if the
+vault doesn't hold the margin of the positions. On success, the v1 stores are
+emptied and the v1 module is stopped, so that only v2 holds perp state.
+*/
+func MigrateFromV1(k Keeper, perpKeeper perpkeeper.Keeper, perpAmmKeeper perpammkeeper.Keeper) module.MigrationHandler {
+	return func(ctx sdk.Context) error {
+		if err := migrateMarkets(ctx, k, perpKeeper, perpAmmKeeper); err != nil {
+			return err
+		}
+
+		margins, err := migratePositions(ctx, k, perpKeeper)
+		if err != nil {
+			return err
+		}
+
+		if err := migratePrepaidBadDebt(ctx, k, perpKeeper, margins); err != nil {
+			return err
+		}
+
+		if err := checkVaultCoversMargins(ctx, k, margins); err != nil {
+			return err
+		}
+
+		if err := migrateReserveSnapshots(ctx, k, perpAmmKeeper); err != nil {
+			return err
+		}
+
+		return retireV1(ctx, perpKeeper, perpAmmKeeper)
+	}
+}
+
+// migrateMarkets creates a v2 Market and AMM for every amm pool.
+func migrateMarkets(ctx sdk.Context, k Keeper, perpKeeper perpkeeper.Keeper, perpAmmKeeper perpammkeeper.Keeper) error {
+	params := perpKeeper.GetParams(ctx)
+
+	for _, pool := range perpAmmKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if _, err := k.Markets.Get(ctx, pool.Pair); err == nil {
+			return fmt.Errorf("market %s already exists", pool.Pair)
+		}
+
+		metadata := perpKeeper.PairsMetadata.GetOr(ctx, pool.Pair, types.PairMetadata{
+			Pair:                            pool.Pair,
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+
+		market := v2types.Market{
+			Pair:                            pool.Pair,
+			Enabled:                         !params.Stopped,
+			PriceFluctuationLimitRatio:      pool.Config.FluctuationLimitRatio,
+			MaintenanceMarginRatio:          pool.Config.MaintenanceMarginRatio,
+			MaxLeverage:                     pool.Config.MaxLeverage,
+			LatestCumulativePremiumFraction: metadata.LatestCumulativePremiumFraction,
+			ExchangeFeeRatio:                params.FeePoolFeeRatio,
+			EcosystemFundFeeRatio:           params.EcosystemFundFeeRatio,
+			LiquidationFeeRatio:             params.LiquidationFeeRatio,
+			PartialLiquidationRatio:         params.PartialLiquidationRatio,
+			FundingRateEpochId:              params.FundingRateInterval,
+			TwapLookbackWindow:              params.TwapLookbackWindow,
+			PrepaidBadDebt:                  sdk.NewCoin(pool.Pair.QuoteDenom(), sdk.ZeroInt()),
+			SettlementPrice:                 sdk.ZeroDec(),
+			RepegDivergenceThreshold:        sdk.ZeroDec(),
+			RepegBudgetPerEpoch:             sdk.ZeroInt(),
+			MaxOpenInterest:                 sdk.ZeroDec(),
+			MaxTraderNotional:               sdk.ZeroDec(),
+			MaxBias:                         sdk.ZeroDec(),
+			MaxFundingRate:                  sdk.ZeroDec(),
+			FundingInterestRate:             sdk.ZeroDec(),
+			FundingDampener:                 sdk.ZeroDec(),
+		}
+		if err := market.Validate(); err != nil {
+			return fmt.Errorf("invalid market %s: %w", pool.Pair, err)
+		}
+
+		amm := v2types.AMM{
+			Pair:            pool.Pair,
+			BaseReserve:     pool.BaseReserve,
+			QuoteReserve:    pool.QuoteReserve,
+			SqrtDepth:       pool.SqrtDepth,
+			PriceMultiplier: pool.PegMultiplier,
+			TotalLong:       pool.TotalLong,
+			TotalShort:      pool.TotalShort,
+		}
+		if err := amm.Validate(); err != nil {
+			return fmt.Errorf("invalid amm %s: %w", pool.Pair, err)
+		}
+
+		k.Markets.Insert(ctx, market.Pair, market)
+		k.AMMs.Insert(ctx, amm.Pair, amm)
+	}
+
+	return nil
+}
+
+// migratePositions copies every v1 position into v2 and checks that the bias
+// of each AMM matches the positions open on it. The total long and total short
+// of an AMM are cumulative swap flows, closing a long adds to the total short,
+// so only their difference is checked.
+//
+// returns:
+//   - margins: the margin of the migrated positions by market
+//   - err: error if any
+func migratePositions(ctx sdk.Context, k Keeper, perpKeeper perpkeeper.Keeper) (margins map[asset.Pair]sdk.Dec, err error) {
+	margins = make(map[asset.Pair]sdk.Dec)
+	biases := make(map[asset.Pair]sdk.Dec)
+
+	for _, kv := range perpKeeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).KeyValues() {
+		pair := kv.Key.K1()
+		if _, err := k.AMMs.Get(ctx, pair); err != nil {
+			return nil, fmt.Errorf("position of %s has no market %s", kv.Key.K2(), pair)
+		}
+
+		if _, found := biases[pair]; !found {
+			biases[pair] = sdk.ZeroDec()
+			margins[pair] = sdk.ZeroDec()
+		}
+
+		position := kv.Value
+		biases[pair] = biases[pair].Add(position.Size_)
+		margins[pair] = margins[pair].Add(sdk.MaxDec(position.Margin, sdk.ZeroDec()))
+
+		k.SetPosition(ctx, kv.Key.K2(), v2types.Position{
+			TraderAddress:                   position.TraderAddress,
+			Pair:                            position.Pair,
+			Size_:                           position.Size_,
+			Margin:                          position.Margin,
+			OpenNotional:                    position.OpenNotional,
+			LatestCumulativePremiumFraction: position.LatestCumulativePremiumFraction,
+			LastUpdatedBlockNumber:          position.BlockNumber,
+			// the lifetime accounting of the v1 positions is unknown
+			RealizedPnl: sdk.ZeroDec(),
+			FundingPaid: sdk.ZeroDec(),
+			FeesPaid:    sdk.ZeroDec(),
+		})
+	}
+
+	for _, amm := range k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		bias := sdk.ZeroDec()
+		if _, found := biases[amm.Pair]; found {
+			bias = biases[amm.Pair]
+		}
+
+		if !amm.Bias().Equal(bias) {
+			return nil, fmt.Errorf("bias of %s is %s but positions sum up to %s", amm.Pair, amm.Bias(), bias)
+		}
+	}
+
+	return margins, nil
+}
+
+// migratePrepaidBadDebt splits the v1 prepaid bad debt of every denom between
+// the markets quoted in it, pro rata to their margins, or evenly if they hold
+// none. The rounding remainder goes to the last market in pair order.
+func migratePrepaidBadDebt(ctx sdk.Context, k Keeper, perpKeeper perpkeeper.Keeper, margins map[asset.Pair]sdk.Dec) error {
+	for _, badDebt := range perpKeeper.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Values() {
+		if !badDebt.Amount.IsPositive() {
+			continue
+		}
+
+		var markets []v2types.Market
+		totalMargin := sdk.ZeroDec()
+		for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if market.Pair.QuoteDenom() != badDebt.Denom {
+				continue
+			}
+			markets = append(markets, market)
+			if margin, found := margins[market.Pair]; found {
+				totalMargin = totalMargin.Add(margin)
+			}
+		}
+		if len(markets) == 0 {
+			return fmt.Errorf("no market to carry the prepaid bad debt of %s%s", badDebt.Amount, badDebt.Denom)
+		}
+
+		remaining := badDebt.Amount
+		for i, market := range markets {
+			share := remaining
+			if i < len(markets)-1 {
+				if totalMargin.IsPositive() {
+					margin := sdk.ZeroDec()
+					if m, found := margins[market.Pair]; found {
+						margin = m
+					}
+					share = badDebt.Amount.ToDec().Mul(margin).Quo(totalMargin).TruncateInt()
+				} else {
+					share = badDebt.Amount.QuoRaw(int64(len(markets)))
+				}
+			}
+			remaining = remaining.Sub(share)
+
+			market.PrepaidBadDebt = sdk.NewCoin(badDebt.Denom, share)
+			k.Markets.Insert(ctx, market.Pair, market)
+		}
+	}
+
+	return nil
+}
+
+// checkVaultCoversMargins checks that, for every quote denom, the vault holds
+// at least the margin of the positions migrated to the markets quoted in it.
+func checkVaultCoversMargins(ctx sdk.Context, k Keeper, margins map[asset.Pair]sdk.Dec) error {
+	owed := make(map[string]sdk.Dec)
+	for pair, margin := range margins {
+		denom := pair.QuoteDenom()
+		if _, found := owed[denom]; !found {
+			owed[denom] = sdk.ZeroDec()
+		}
+		owed[denom] = owed[denom].Add(margin)
+	}
+
+	denoms := make([]string, 0, len(owed))
+	for denom := range owed {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	vaultAddr := k.AccountKeeper.GetModuleAddress(v2types.VaultModuleAccount)
+	for _, denom := range denoms {
+		balance := k.BankKeeper.GetBalance(ctx, vaultAddr, denom)
+		if balance.Amount.ToDec().LT(owed[denom]) {
+			return fmt.Errorf("vault holds %s but the positions hold %s%s of margin", balance, owed[denom], denom)
+		}
+	}
+
+	return nil
+}
+
+// migrateReserveSnapshots converts the amm reserve snapshots into v2 ones. The
+// v1 snapshots only record the reserves and the peg multiplier, so the open
+// interest of the migrated snapshots is zero. Snapshots taken before the peg
+// multiplier existed get a multiplier of one.
+func migrateReserveSnapshots(ctx sdk.Context, k Keeper, perpAmmKeeper perpammkeeper.Keeper) error {
+	iter := perpAmmKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{})
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		snapshot := iter.Value()
+		if snapshot.PegMultiplier.IsNil() {
+			snapshot.PegMultiplier = sdk.OneDec()
+		}
+
+		amm := v2types.AMM{
+			Pair:            snapshot.Pair,
+			BaseReserve:     snapshot.BaseReserve,
+			QuoteReserve:    snapshot.QuoteReserve,
+			PriceMultiplier: snapshot.PegMultiplier,
+			TotalLong:       sdk.ZeroDec(),
+			TotalShort:      sdk.ZeroDec(),
+		}
+		sqrtDepth, err := amm.ComputeSqrtDepth()
+		if err != nil {
+			return fmt.Errorf("invalid reserve snapshot of %s at %d: %w", snapshot.Pair, snapshot.TimestampMs, err)
+		}
+		amm.SqrtDepth = sqrtDepth
+
+		k.ReserveSnapshots.Insert(ctx, iter.Key(), v2types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: snapshot.TimestampMs,
+		})
+	}
+
+	return nil
+}
+
+// retireV1 empties the x/perp (v1) and x/perp/amm stores and stops the v1
+// module. The store keys stay mounted as long as the v1 modules are wired in
+// the app.
+func retireV1(ctx sdk.Context, perpKeeper perpkeeper.Keeper, perpAmmKeeper perpammkeeper.Keeper) error {
+	for _, key := range perpKeeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Keys() {
+		if err := perpKeeper.Positions.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range perpKeeper.PairsMetadata.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if err := perpKeeper.PairsMetadata.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range perpKeeper.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Keys() {
+		if err := perpKeeper.PrepaidBadDebt.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range perpKeeper.Metrics.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if err := perpKeeper.Metrics.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range perpAmmKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if err := perpAmmKeeper.Pools.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range perpAmmKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Keys() {
+		if err := perpAmmKeeper.ReserveSnapshots.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	params := perpKeeper.GetParams(ctx)
+	params.Stopped = true
+	perpKeeper.SetParams(ctx, params)
+
+	return nil
+}
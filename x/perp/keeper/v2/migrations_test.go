@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func setupV1State(t *testing.T, pair asset.Pair, alice, bob sdk.AccAddress) (*app.NibiruApp, sdk.Context) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.UnixMilli(1_000_000))
+
+	app.PerpAmmKeeper.Pools.Insert(ctx, pair, perpammtypes.NewMarket(perpammtypes.ArgsNewMarket{
+		Pair:          pair,
+		BaseReserves:  sdk.NewDec(1e6),
+		QuoteReserves: sdk.NewDec(2e6),
+		Config:        perpammtypes.DefaultMarketConfig(),
+		// closed positions count in both totals, only the bias is open
+		TotalLong:     sdk.NewDec(150),
+		TotalShort:    sdk.NewDec(90),
+		PegMultiplier: sdk.NewDec(3),
+	}))
+	app.PerpAmmKeeper.ReserveSnapshots.Insert(ctx, collections.Join(pair, ctx.BlockTime()), perpammtypes.ReserveSnapshot{
+		Pair:          pair,
+		BaseReserve:   sdk.NewDec(1e6),
+		QuoteReserve:  sdk.NewDec(2e6),
+		TimestampMs:   ctx.BlockTime().UnixMilli(),
+		PegMultiplier: sdk.NewDec(3),
+	})
+
+	app.PerpKeeper.PairsMetadata.Insert(ctx, pair, types.PairMetadata{
+		Pair:                            pair,
+		LatestCumulativePremiumFraction: sdk.NewDec(5),
+	})
+	app.PerpKeeper.PrepaidBadDebt.Insert(ctx, denoms.NUSD, types.PrepaidBadDebt{
+		Denom:  denoms.NUSD,
+		Amount: sdk.NewInt(7),
+	})
+
+	app.PerpKeeper.Positions.Insert(ctx, collections.Join(pair, alice), types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(10),
+		OpenNotional:                    sdk.NewDec(600),
+		LatestCumulativePremiumFraction: sdk.NewDec(1),
+		BlockNumber:                     2,
+	})
+	app.PerpKeeper.Positions.Insert(ctx, collections.Join(pair, bob), types.Position{
+		TraderAddress:                   bob.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(-40),
+		Margin:                          sdk.NewDec(20),
+		OpenNotional:                    sdk.NewDec(240),
+		LatestCumulativePremiumFraction: sdk.NewDec(2),
+		BlockNumber:                     3,
+	})
+
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount,
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 30))))
+
+	return app, ctx
+}
+
+func TestMigrateFromV1(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	t.Run("success", func(t *testing.T) {
+		app, ctx := setupV1State(t, pair, alice, bob)
+		require.NoError(t, keeper.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx))
+
+		market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(5), market.LatestCumulativePremiumFraction)
+		require.Equal(t, sdk.NewCoin(denoms.NUSD, sdk.NewInt(7)), market.PrepaidBadDebt)
+		require.Equal(t, perpammtypes.DefaultMarketConfig().MaxLeverage, market.MaxLeverage)
+
+		amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(6), amm.MarkPrice())
+		require.Equal(t, sdk.NewDec(150), amm.TotalLong)
+		require.Equal(t, sdk.NewDec(90), amm.TotalShort)
+
+		position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, bob))
+		require.NoError(t, err)
+		require.Equal(t, v2types.Position{
+			TraderAddress:                   bob.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(-40),
+			Margin:                          sdk.NewDec(20),
+			OpenNotional:                    sdk.NewDec(240),
+			LatestCumulativePremiumFraction: sdk.NewDec(2),
+			LastUpdatedBlockNumber:          3,
+			RealizedPnl:                     sdk.ZeroDec(),
+			FundingPaid:                     sdk.ZeroDec(),
+			FeesPaid:                        sdk.ZeroDec(),
+		}, position)
+
+		snapshot, err := app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pair, ctx.BlockTime()))
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(6), snapshot.Amm.MarkPrice())
+
+		// the v1 state is gone
+		require.Empty(t, app.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Keys())
+		require.Empty(t, app.PerpKeeper.PairsMetadata.Iterate(ctx, collections.Range[asset.Pair]{}).Keys())
+		require.Empty(t, app.PerpKeeper.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Keys())
+		require.Empty(t, app.PerpAmmKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys())
+		require.Empty(t, app.PerpAmmKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Keys())
+		require.True(t, app.PerpKeeper.GetParams(ctx).Stopped)
+	})
+
+	t.Run("fails if the bias doesn't match the positions", func(t *testing.T) {
+		app, ctx := setupV1State(t, pair, alice, bob)
+		require.NoError(t, app.PerpKeeper.Positions.Delete(ctx, collections.Join(pair, bob)))
+
+		require.ErrorContains(t, keeper.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx), "bias")
+	})
+
+	t.Run("fails if the vault doesn't cover the margins", func(t *testing.T) {
+		app, ctx := setupV1State(t, pair, alice, bob)
+		vault := app.AccountKeeper.GetModuleAddress(v2types.VaultModuleAccount)
+		require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, v2types.VaultModuleAccount, alice,
+			sdk.NewCoins(app.BankKeeper.GetBalance(ctx, vault, denoms.NUSD).SubAmount(sdk.NewInt(29)))))
+
+		require.ErrorContains(t, keeper.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx), "vault holds 29")
+	})
+
+	t.Run("splits the prepaid bad debt pro rata to the margins", func(t *testing.T) {
+		app, ctx := setupV1State(t, pair, alice, bob)
+		otherPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+		app.PerpAmmKeeper.Pools.Insert(ctx, otherPair, perpammtypes.NewMarket(perpammtypes.ArgsNewMarket{
+			Pair:          otherPair,
+			BaseReserves:  sdk.NewDec(1e6),
+			QuoteReserves: sdk.NewDec(1e6),
+			Config:        perpammtypes.DefaultMarketConfig(),
+			TotalLong:     sdk.NewDec(5),
+			TotalShort:    sdk.ZeroDec(),
+			PegMultiplier: sdk.OneDec(),
+		}))
+		app.PerpKeeper.Positions.Insert(ctx, collections.Join(otherPair, alice), types.Position{
+			TraderAddress:                   alice.String(),
+			Pair:                            otherPair,
+			Size_:                           sdk.NewDec(5),
+			Margin:                          sdk.NewDec(40),
+			OpenNotional:                    sdk.NewDec(5),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		})
+		require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 40))))
+
+		require.NoError(t, keeper.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx))
+
+		// 7 split 30:40, the remainder goes to the last market
+		market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoin(denoms.NUSD, sdk.NewInt(3)), market.PrepaidBadDebt)
+		otherMarket, err := app.PerpKeeperV2.Markets.Get(ctx, otherPair)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoin(denoms.NUSD, sdk.NewInt(4)), otherMarket.PrepaidBadDebt)
+	})
+
+	t.Run("fails if a position has no market", func(t *testing.T) {
+		app, ctx := setupV1State(t, pair, alice, bob)
+		otherPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+		app.PerpKeeper.Positions.Insert(ctx, collections.Join(otherPair, alice), types.Position{
+			TraderAddress: alice.String(),
+			Pair:          otherPair,
+			Size_:         sdk.NewDec(1),
+		})
+
+		require.ErrorContains(t, keeper.MigrateFromV1(app.PerpKeeperV2, app.PerpKeeper, app.PerpAmmKeeper)(ctx), "has no market")
+	})
+}
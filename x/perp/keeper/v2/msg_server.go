@@ -36,10 +36,17 @@ func (m msgServer) AddMargin(ctx context.Context, msg *v2types.MsgAddMargin,
 
 func (m msgServer) OpenPosition(goCtx context.Context, req *v2types.MsgOpenPosition,
 ) (response *v2types.MsgOpenPositionResponse, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	traderAddr := sdk.MustAccAddressFromBech32(req.Sender)
 
+	if req.ReduceOnly {
+		if err = m.k.checkReduceOnly(ctx, req.Pair, req.Side, traderAddr, req.Leverage.MulInt(req.QuoteAssetAmount)); err != nil {
+			return nil, err
+		}
+	}
+
 	positionResp, err := m.k.OpenPosition(
-		sdk.UnwrapSDKContext(goCtx),
+		ctx,
 		req.Pair,
 		req.Side,
 		traderAddr,
@@ -81,6 +88,32 @@ func (m msgServer) ClosePosition(goCtx context.Context, position *v2types.MsgClo
 	}, nil
 }
 
+func (m msgServer) PartialClose(goCtx context.Context, msg *v2types.MsgPartialClose) (*v2types.MsgPartialCloseResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	quoteAssetAmountLimit := sdk.ZeroDec()
+	if !msg.QuoteAssetAmountLimit.IsNil() {
+		quoteAssetAmountLimit = msg.QuoteAssetAmountLimit.ToDec()
+	}
+
+	resp, err := m.k.PartialClose(ctx, msg.Pair, traderAddr, msg.SizeAmount, quoteAssetAmountLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgPartialCloseResponse{
+		Position:               resp.Position,
+		ExchangedNotionalValue: resp.ExchangedNotionalValue,
+		ExchangedPositionSize:  resp.ExchangedPositionSize,
+		FundingPayment:         resp.FundingPayment,
+		RealizedPnl:            resp.RealizedPnl,
+		UnrealizedPnlAfter:     resp.UnrealizedPnlAfter,
+		MarginToTrader:         resp.MarginToVault.Neg(),
+		PositionNotional:       resp.PositionNotional,
+	}, nil
+}
+
 func (m msgServer) MultiLiquidate(goCtx context.Context, req *v2types.MsgMultiLiquidate) (*v2types.MsgMultiLiquidateResponse, error) {
 	resp, err := m.k.MultiLiquidate(sdk.UnwrapSDKContext(goCtx), sdk.MustAccAddressFromBech32(req.Sender), req.Liquidations)
 	if err != nil {
@@ -102,3 +135,131 @@ func (m msgServer) DonateToEcosystemFund(ctx context.Context, msg *v2types.MsgDo
 
 	return &v2types.MsgDonateToEcosystemFundResponse{}, nil
 }
+
+func (m msgServer) SettlePosition(goCtx context.Context, msg *v2types.MsgSettlePosition) (*v2types.MsgSettlePositionResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return m.k.SettlePosition(sdk.UnwrapSDKContext(goCtx), msg.Pair, traderAddr)
+}
+
+func (m msgServer) PlaceOrder(goCtx context.Context, msg *v2types.MsgPlaceOrder) (*v2types.MsgPlaceOrderResponse, error) {
+	orderID, err := m.k.PlaceOrder(sdk.UnwrapSDKContext(goCtx), msg.NewOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgPlaceOrderResponse{OrderId: orderID}, nil
+}
+
+func (m msgServer) CancelOrder(goCtx context.Context, msg *v2types.MsgCancelOrder) (*v2types.MsgCancelOrderResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := m.k.CancelOrder(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.OrderId); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgCancelOrderResponse{}, nil
+}
+
+func (m msgServer) ReplaceOrder(goCtx context.Context, msg *v2types.MsgReplaceOrder) (*v2types.MsgReplaceOrderResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	order, err := m.k.ReplaceOrder(
+		sdk.UnwrapSDKContext(goCtx),
+		traderAddr,
+		msg.OrderId,
+		msg.TriggerPrice,
+		msg.QuoteAssetAmount,
+		msg.Leverage,
+		msg.BaseAssetAmountLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgReplaceOrderResponse{Order: order}, nil
+}
+
+func (m msgServer) EnableCrossMargin(goCtx context.Context, msg *v2types.MsgEnableCrossMargin) (*v2types.MsgEnableCrossMarginResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := m.k.EnableCrossMargin(sdk.UnwrapSDKContext(goCtx), traderAddr); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgEnableCrossMarginResponse{}, nil
+}
+
+func (m msgServer) DisableCrossMargin(goCtx context.Context, msg *v2types.MsgDisableCrossMargin) (*v2types.MsgDisableCrossMarginResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	refunded, err := m.k.DisableCrossMargin(sdk.UnwrapSDKContext(goCtx), traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgDisableCrossMarginResponse{RefundedCollateral: refunded}, nil
+}
+
+func (m msgServer) AddCrossMarginCollateral(goCtx context.Context, msg *v2types.MsgAddCrossMarginCollateral) (*v2types.MsgAddCrossMarginCollateralResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	account, err := m.k.AddCrossMarginCollateral(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.Collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgAddCrossMarginCollateralResponse{Account: account}, nil
+}
+
+func (m msgServer) RemoveCrossMarginCollateral(goCtx context.Context, msg *v2types.MsgRemoveCrossMarginCollateral) (*v2types.MsgRemoveCrossMarginCollateralResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	account, err := m.k.RemoveCrossMarginCollateral(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.Collateral)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgRemoveCrossMarginCollateralResponse{Account: account}, nil
+}
+
+func (m msgServer) DepositInsuranceFund(goCtx context.Context, msg *v2types.MsgDepositInsuranceFund) (*v2types.MsgDepositInsuranceFundResponse, error) {
+	stakerAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	shares, err := m.k.DepositInsuranceFund(sdk.UnwrapSDKContext(goCtx), stakerAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgDepositInsuranceFundResponse{Shares: shares}, nil
+}
+
+func (m msgServer) WithdrawInsuranceFund(goCtx context.Context, msg *v2types.MsgWithdrawInsuranceFund) (*v2types.MsgWithdrawInsuranceFundResponse, error) {
+	stakerAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	unlockTime, err := m.k.WithdrawInsuranceFund(sdk.UnwrapSDKContext(goCtx), stakerAddr, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgWithdrawInsuranceFundResponse{UnlockTime: unlockTime}, nil
+}
+
+func (m msgServer) RegisterReferralCode(goCtx context.Context, msg *v2types.MsgRegisterReferralCode) (*v2types.MsgRegisterReferralCodeResponse, error) {
+	referrerAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := m.k.RegisterReferralCode(sdk.UnwrapSDKContext(goCtx), referrerAddr, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgRegisterReferralCodeResponse{}, nil
+}
+
+func (m msgServer) SetReferrer(goCtx context.Context, msg *v2types.MsgSetReferrer) (*v2types.MsgSetReferrerResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := m.k.SetReferrer(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgSetReferrerResponse{}, nil
+}
+
+func (m msgServer) ClaimReferralEarnings(goCtx context.Context, msg *v2types.MsgClaimReferralEarnings) (*v2types.MsgClaimReferralEarningsResponse, error) {
+	referrerAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	earnings, err := m.k.ClaimReferralEarnings(sdk.UnwrapSDKContext(goCtx), referrerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgClaimReferralEarningsResponse{Earnings: earnings}, nil
+}
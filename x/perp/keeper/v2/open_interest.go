@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// OpenInterest returns the total size of the long positions and the total size
+// of the short positions of the market, in base assets.
+func (k Keeper) OpenInterest(ctx sdk.Context, pair asset.Pair) (long, short sdk.Dec) {
+	return k.LongOpenInterest.GetOr(ctx, pair, sdk.ZeroDec()), k.ShortOpenInterest.GetOr(ctx, pair, sdk.ZeroDec())
+}
+
+// SetPosition stores the position and moves the open interest of its market by
+// the change of its size. Every position write goes through SetPosition or
+// DeletePosition.
+func (k Keeper) SetPosition(ctx sdk.Context, traderAddr sdk.AccAddress, position v2types.Position) {
+	key := collections.Join(position.Pair, traderAddr)
+	previousSize := sdk.ZeroDec()
+	if previous, err := k.Positions.Get(ctx, key); err == nil {
+		previousSize = previous.Size_
+	}
+
+	k.moveOpenInterest(ctx, position.Pair, previousSize, position.Size_)
+	k.Positions.Insert(ctx, key, position)
+}
+
+// DeletePosition deletes the position and removes its size from the open
+// interest of its market.
+func (k Keeper) DeletePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress) error {
+	key := collections.Join(pair, traderAddr)
+	position, err := k.Positions.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	k.moveOpenInterest(ctx, pair, position.Size_, sdk.ZeroDec())
+	return k.Positions.Delete(ctx, key)
+}
+
+// moveOpenInterest replaces a position size in the open interest of the
+// market.
+func (k Keeper) moveOpenInterest(ctx sdk.Context, pair asset.Pair, previousSize, size sdk.Dec) {
+	if previousSize.Equal(size) {
+		return
+	}
+
+	long, short := k.OpenInterest(ctx, pair)
+	long, short = openInterestAfter(long, short, previousSize, size)
+	k.LongOpenInterest.Insert(ctx, pair, long)
+	k.ShortOpenInterest.Insert(ctx, pair, short)
+}
+
+// openInterestAfter returns the open interest once a position of previousSize
+// is resized to size.
+func openInterestAfter(long, short, previousSize, size sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if previousSize.IsPositive() {
+		long = long.Sub(previousSize)
+	} else {
+		short = short.Add(previousSize)
+	}
+
+	if size.IsPositive() {
+		long = long.Add(size)
+	} else {
+		short = short.Sub(size)
+	}
+
+	return long, short
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestOpenInterest(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	for _, trader := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10_000))))
+	}
+
+	requireOpenInterest := func(long, short sdk.Dec) {
+		actualLong, actualShort := app.PerpKeeperV2.OpenInterest(ctx, pair)
+		require.Equal(t, long.String(), actualLong.String())
+		require.Equal(t, short.String(), actualShort.String())
+	}
+	positionSize := func(trader sdk.AccAddress) sdk.Dec {
+		position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+		require.NoError(t, err)
+		return position.Size_
+	}
+
+	requireOpenInterest(sdk.ZeroDec(), sdk.ZeroDec())
+
+	t.Log("opening positions adds their sizes")
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(1000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_SHORT, bob, sdk.NewInt(500), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterest(positionSize(alice), positionSize(bob).Neg())
+
+	t.Log("reducing a position removes the reduced size")
+	_, err = app.PerpKeeperV2.PartialClose(ctx, pair, alice, positionSize(alice).QuoInt64(2), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterest(positionSize(alice), positionSize(bob).Neg())
+
+	t.Log("flipping a position moves it to the other side")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, bob, sdk.NewInt(1000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	requireOpenInterest(positionSize(alice).Add(positionSize(bob)), sdk.ZeroDec())
+
+	t.Log("closing positions removes their sizes")
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	requireOpenInterest(sdk.ZeroDec(), sdk.ZeroDec())
+}
@@ -0,0 +1,464 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// PlaceOrder stores a trigger order to be executed by the EndBlocker. The
+// margin of LIMIT and STOP_MARKET orders is escrowed in the vault, TAKE_PROFIT
+// and STOP_LOSS orders require the trader to have a position on the pair and
+// escrow the ClosingOrderDeposit param.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - order: the order to place, its id and block height are overwritten
+//
+// returns:
+//   - orderID: the id of the new order
+//   - err: error
+func (k Keeper) PlaceOrder(ctx sdk.Context, order v2types.Order) (orderID uint64, err error) {
+	if err = order.Validate(); err != nil {
+		return 0, err
+	}
+
+	market, err := k.Markets.Get(ctx, order.Pair)
+	if err != nil {
+		return 0, v2types.ErrPairNotFound.Wrapf("pair: %s", order.Pair)
+	}
+
+	if market.Settled {
+		return 0, v2types.ErrMarketSettled.Wrapf("pair: %s", order.Pair)
+	}
+
+	if !market.Enabled {
+		return 0, v2types.ErrMarketNotEnabled
+	}
+
+	order.Deposit = sdk.ZeroInt()
+	traderAddr := sdk.MustAccAddressFromBech32(order.TraderAddress)
+	if order.IsOpenOrder() {
+		if err = checkOpenPositionRequirements(market, order.QuoteAssetAmount, order.Leverage); err != nil {
+			return 0, err
+		}
+
+		if err = k.checkOrderNotMarketable(ctx, order); err != nil {
+			return 0, err
+		}
+
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			traderAddr,
+			v2types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(order.Pair.QuoteDenom(), order.QuoteAssetAmount)),
+		); err != nil {
+			return 0, err
+		}
+	} else {
+		position, err := k.Positions.Get(ctx, collections.Join(order.Pair, traderAddr))
+		if err != nil || position.Size_.IsZero() {
+			return 0, v2types.ErrPositionZero.Wrapf("%s orders need a position to close", order.OrderType)
+		}
+		order.Side = positionSide(position.Size_)
+
+		if err = k.checkOrderNotMarketable(ctx, order); err != nil {
+			return 0, err
+		}
+
+		if deposit := k.GetParams(ctx).ClosingOrderDeposit; !deposit.IsNil() && deposit.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromAccountToModule(
+				ctx,
+				traderAddr,
+				v2types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewCoin(order.Pair.QuoteDenom(), deposit)),
+			); err != nil {
+				return 0, err
+			}
+			order.Deposit = deposit
+		}
+	}
+
+	order.Id = k.NextOrderID.Next(ctx)
+	order.BlockHeight = ctx.BlockHeight()
+	k.insertOrder(ctx, order)
+
+	return order.Id, ctx.EventManager().EmitTypedEvent(&v2types.OrderPlacedEvent{
+		Order: order,
+	})
+}
+
+// CancelOrder removes an order of the trader and refunds its escrowed margin or
+// deposit.
+func (k Keeper) CancelOrder(ctx sdk.Context, traderAddr sdk.AccAddress, orderID uint64) error {
+	order, err := k.Orders.Get(ctx, orderID)
+	if err != nil {
+		return v2types.ErrOrderNotFound.Wrapf("order id: %d", orderID)
+	}
+
+	if order.TraderAddress != traderAddr.String() {
+		return v2types.ErrUnauthorized.Wrapf("order %d is not owned by %s", orderID, traderAddr)
+	}
+
+	return k.cancelOrder(ctx, order, "cancelled by trader")
+}
+
+// ReplaceOrder changes the trigger price and the amounts of an order of the
+// trader, keeping its id. The difference in escrowed margin is taken from or
+// refunded to the trader. The amounts of TAKE_PROFIT and STOP_LOSS orders are
+// ignored.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - traderAddr: the owner of the order
+//   - orderID: the id of the order
+//   - triggerPrice: the new trigger price
+//   - quoteAssetAmt: the new margin of the position to open
+//   - leverage: the new leverage of the position to open
+//   - baseAmtLimit: the new slippage protection of the position to open
+//
+// returns:
+//   - order: the replaced order
+//   - err: error
+func (k Keeper) ReplaceOrder(
+	ctx sdk.Context,
+	traderAddr sdk.AccAddress,
+	orderID uint64,
+	triggerPrice sdk.Dec,
+	quoteAssetAmt sdk.Int,
+	leverage sdk.Dec,
+	baseAmtLimit sdk.Int,
+) (order v2types.Order, err error) {
+	oldOrder, err := k.Orders.Get(ctx, orderID)
+	if err != nil {
+		return order, v2types.ErrOrderNotFound.Wrapf("order id: %d", orderID)
+	}
+
+	if oldOrder.TraderAddress != traderAddr.String() {
+		return order, v2types.ErrUnauthorized.Wrapf("order %d is not owned by %s", orderID, traderAddr)
+	}
+
+	market, err := k.Markets.Get(ctx, oldOrder.Pair)
+	if err != nil {
+		return order, v2types.ErrPairNotFound.Wrapf("pair: %s", oldOrder.Pair)
+	}
+
+	if market.Settled {
+		return order, v2types.ErrMarketSettled.Wrapf("pair: %s", oldOrder.Pair)
+	}
+
+	order = oldOrder
+	order.TriggerPrice = triggerPrice
+	order.BlockHeight = ctx.BlockHeight()
+	if order.IsOpenOrder() {
+		order.QuoteAssetAmount = quoteAssetAmt
+		order.Leverage = leverage
+		order.BaseAssetAmountLimit = baseAmtLimit
+	}
+
+	if err = order.Validate(); err != nil {
+		return order, err
+	}
+
+	if err = k.checkOrderNotMarketable(ctx, order); err != nil {
+		return order, err
+	}
+
+	if order.IsOpenOrder() {
+		if err = checkOpenPositionRequirements(market, order.QuoteAssetAmount, order.Leverage); err != nil {
+			return order, err
+		}
+
+		if diff := order.QuoteAssetAmount.Sub(oldOrder.QuoteAssetAmount); diff.IsPositive() {
+			if err = k.BankKeeper.SendCoinsFromAccountToModule(
+				ctx,
+				traderAddr,
+				v2types.VaultModuleAccount,
+				sdk.NewCoins(sdk.NewCoin(order.Pair.QuoteDenom(), diff)),
+			); err != nil {
+				return order, err
+			}
+		} else if err = k.Withdraw(ctx, market, traderAddr, diff.Neg()); err != nil {
+			return order, err
+		}
+	}
+
+	k.removeOrder(ctx, oldOrder)
+	k.insertOrder(ctx, order)
+
+	return order, ctx.EventManager().EmitTypedEvent(&v2types.OrderPlacedEvent{
+		Order: order,
+	})
+}
+
+// ExecuteOrders executes the triggered orders of every enabled market. Each
+// trigger book of a market is walked from its most triggered price and the
+// walk stops at the first order that is not triggered, so untriggered orders
+// cost nothing. At most MaxOrdersExecutedPerBlock orders are processed in a
+// block, the others are left for the next blocks. An order that fails to
+// execute is cancelled and its margin or deposit refunded. Called in the
+// EndBlocker.
+func (k Keeper) ExecuteOrders(ctx sdk.Context) {
+	budget := k.GetParams(ctx).MaxOrdersExecutedPerBlock
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if !market.Enabled || market.Settled {
+			continue
+		}
+
+		indexTWAP, indexErr := k.OracleKeeper.GetExchangeRateTwap(ctx, market.Pair)
+
+		// the mark price is read again for every order since executed orders
+		// move it
+		priceOf := func(source v2types.TriggerPriceSource) (sdk.Dec, bool) {
+			if source == v2types.TriggerPriceSource_INDEX_TWAP {
+				return indexTWAP, indexErr == nil
+			}
+			amm, err := k.AMMs.Get(ctx, market.Pair)
+			if err != nil {
+				k.Logger(ctx).Error("failed to get amm", "pair", market.Pair, "error", err)
+				return sdk.Dec{}, false
+			}
+			return amm.MarkPrice(), true
+		}
+
+		for _, source := range []v2types.TriggerPriceSource{
+			v2types.TriggerPriceSource_MARK_PRICE,
+			v2types.TriggerPriceSource_INDEX_TWAP,
+		} {
+			for _, onRise := range []bool{true, false} {
+				price, ok := priceOf(source)
+				if !ok {
+					continue
+				}
+
+				// the triggered orders are collected first since executing orders
+				// writes to the store
+				for _, orderID := range k.triggeredOrders(ctx, market.Pair, orderBook(source, onRise), onRise, price, budget) {
+					budget--
+
+					order, err := k.Orders.Get(ctx, orderID)
+					if err != nil {
+						continue
+					}
+					if price, ok = priceOf(source); !ok {
+						continue
+					}
+					k.executeOrder(ctx, order, price)
+				}
+			}
+		}
+	}
+}
+
+// triggeredOrders returns the ids of at most limit orders of the trigger book
+// that are triggered at the given price, walking the book from its most
+// triggered price.
+func (k Keeper) triggeredOrders(
+	ctx sdk.Context, pair asset.Pair, book uint64, onRise bool, price sdk.Dec, limit uint64,
+) (orderIDs []uint64) {
+	rng := collections.PairRange[collections.Pair[asset.Pair, uint64], collections.Pair[sdk.Dec, uint64]]{}.
+		Prefix(collections.Join(pair, book))
+	if !onRise {
+		rng = rng.Descending()
+	}
+
+	iter := k.OrderTriggers.Iterate(ctx, rng)
+	defer iter.Close()
+
+	for ; iter.Valid() && uint64(len(orderIDs)) < limit; iter.Next() {
+		trigger := iter.Key().K2()
+		if (onRise && trigger.K1().GT(price)) || (!onRise && trigger.K1().LT(price)) {
+			break
+		}
+		orderIDs = append(orderIDs, trigger.K2())
+	}
+
+	return orderIDs
+}
+
+// executeOrder executes the order if it is triggered at the given price. A
+// TAKE_PROFIT or STOP_LOSS order whose position is gone or changed side is
+// cancelled.
+func (k Keeper) executeOrder(ctx sdk.Context, order v2types.Order, price sdk.Dec) {
+	traderAddr := sdk.MustAccAddressFromBech32(order.TraderAddress)
+
+	if !order.IsOpenOrder() {
+		position, err := k.Positions.Get(ctx, collections.Join(order.Pair, traderAddr))
+		if err != nil || position.Size_.IsZero() {
+			k.cancelOrderOrLog(ctx, order, "no position to close")
+			return
+		}
+		if positionSide(position.Size_) != order.Side {
+			k.cancelOrderOrLog(ctx, order, "position changed side")
+			return
+		}
+	}
+
+	if !order.IsTriggered(price) {
+		return
+	}
+
+	cachedCtx, commit := ctx.CacheContext()
+	if err := k.fillOrder(cachedCtx, order, traderAddr); err != nil {
+		k.cancelOrderOrLog(ctx, order, err.Error())
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+	if err := ctx.EventManager().EmitTypedEvent(&v2types.OrderExecutedEvent{
+		Order:              order,
+		TriggerSourcePrice: price,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit order executed event", "order id", order.Id, "error", err)
+	}
+}
+
+// fillOrder removes the order and opens or closes the position of the trader.
+// A maker order pays the maker fee only if it rested for a block, an order
+// placed in the block that moves the price through its trigger pays the taker
+// fee.
+func (k Keeper) fillOrder(ctx sdk.Context, order v2types.Order, traderAddr sdk.AccAddress) error {
+	k.removeOrder(ctx, order)
+	isMaker := order.IsMakerOrder() && order.BlockHeight < ctx.BlockHeight()
+
+	market, err := k.Markets.Get(ctx, order.Pair)
+	if err != nil {
+		return err
+	}
+
+	if !order.IsOpenOrder() {
+		if err = k.Withdraw(ctx, market, traderAddr, order.Deposit); err != nil {
+			return err
+		}
+		_, err = k.closePosition(ctx, order.Pair, traderAddr, isMaker, sdk.ZeroDec())
+		return err
+	}
+
+	// the escrowed margin goes back to the trader, who pays it again to the
+	// vault when the position is opened
+	if err = k.Withdraw(ctx, market, traderAddr, order.QuoteAssetAmount); err != nil {
+		return err
+	}
+
+	_, err = k.openPosition(
+		ctx,
+		order.Pair,
+		order.Side,
+		traderAddr,
+		order.QuoteAssetAmount,
+		order.Leverage,
+		order.BaseAssetAmountLimit.ToDec(),
+		isMaker,
+	)
+	return err
+}
+
+// checkOrderNotMarketable returns an error if the order is a maker order that
+// is triggered at the current price of its price source, since it would be
+// executed right away like a market order.
+func (k Keeper) checkOrderNotMarketable(ctx sdk.Context, order v2types.Order) error {
+	if !order.IsMakerOrder() {
+		return nil
+	}
+
+	var price sdk.Dec
+	if order.PriceSource == v2types.TriggerPriceSource_INDEX_TWAP {
+		indexTWAP, err := k.OracleKeeper.GetExchangeRateTwap(ctx, order.Pair)
+		if err != nil {
+			return err
+		}
+		price = indexTWAP
+	} else {
+		amm, err := k.AMMs.Get(ctx, order.Pair)
+		if err != nil {
+			return err
+		}
+		price = amm.MarkPrice()
+	}
+
+	if order.IsTriggered(price) {
+		return v2types.ErrOrderMarketable.Wrapf(
+			"%s order triggered at %s is already triggered at %s", order.OrderType, order.TriggerPrice, price)
+	}
+
+	return nil
+}
+
+// cancelOrder removes the order, refunds its escrowed margin or deposit and
+// emits an OrderCancelledEvent.
+func (k Keeper) cancelOrder(ctx sdk.Context, order v2types.Order, reason string) error {
+	refund := order.Deposit
+	if order.IsOpenOrder() {
+		refund = order.QuoteAssetAmount
+	}
+
+	if !refund.IsNil() && refund.IsPositive() {
+		market, err := k.Markets.Get(ctx, order.Pair)
+		if err != nil {
+			return v2types.ErrPairNotFound.Wrapf("pair: %s", order.Pair)
+		}
+
+		if err = k.Withdraw(ctx, market, sdk.MustAccAddressFromBech32(order.TraderAddress), refund); err != nil {
+			return err
+		}
+	}
+
+	k.removeOrder(ctx, order)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.OrderCancelledEvent{
+		Order:  order,
+		Reason: reason,
+	})
+}
+
+func (k Keeper) cancelOrderOrLog(ctx sdk.Context, order v2types.Order, reason string) {
+	if err := k.cancelOrder(ctx, order, reason); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to cancel order %d", order.Id), "error", err)
+	}
+}
+
+func (k Keeper) insertOrder(ctx sdk.Context, order v2types.Order) {
+	k.Orders.Insert(ctx, order.Id, order)
+	k.OrderTriggers.Insert(ctx, NewOrderTrigger(order))
+}
+
+func (k Keeper) removeOrder(ctx sdk.Context, order v2types.Order) {
+	_ = k.Orders.Delete(ctx, order.Id)
+	k.OrderTriggers.Delete(ctx, NewOrderTrigger(order))
+}
+
+// OrderTrigger is the key of an order in the OrderTriggers index: its pair and
+// trigger book, then its trigger price and id.
+type OrderTrigger = collections.Pair[collections.Pair[asset.Pair, uint64], collections.Pair[sdk.Dec, uint64]]
+
+// NewOrderTrigger returns the key of the order in the OrderTriggers index.
+func NewOrderTrigger(order v2types.Order) OrderTrigger {
+	return collections.Join(
+		collections.Join(order.Pair, orderBook(order.PriceSource, order.TriggersOnRise())),
+		collections.Join(order.TriggerPrice, order.Id),
+	)
+}
+
+// orderBook returns the trigger book of the orders evaluated against the price
+// source and triggered when the price rises, or falls, to their trigger price.
+// Within a book, every order above, or below, the current price is triggered.
+func orderBook(source v2types.TriggerPriceSource, onRise bool) uint64 {
+	book := uint64(source) * 2
+	if onRise {
+		book++
+	}
+	return book
+}
+
+// positionSide returns the side of a position of the given non-zero size.
+func positionSide(size sdk.Dec) v2types.Direction {
+	if size.IsNegative() {
+		return v2types.Direction_SHORT
+	}
+	return v2types.Direction_LONG
+}
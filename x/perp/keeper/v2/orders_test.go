@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func limitOrder(trader sdk.AccAddress, pair asset.Pair, side v2types.Direction, triggerPrice sdk.Dec, quoteAmt int64) v2types.Order {
+	return v2types.MsgPlaceOrder{
+		Sender:               trader.String(),
+		Pair:                 pair,
+		OrderType:            v2types.OrderType_LIMIT,
+		Side:                 side,
+		TriggerPrice:         triggerPrice,
+		PriceSource:          v2types.TriggerPriceSource_MARK_PRICE,
+		QuoteAssetAmount:     sdk.NewInt(quoteAmt),
+		Leverage:             sdk.NewDec(10),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+	}.NewOrder()
+}
+
+func setupOrdersMarket(pair asset.Pair) (*app.NibiruApp, sdk.Context) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	amm := *mock.TestAMM(sdk.NewDec(1e12), sdk.OneDec())
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, *mock.TestMarket())
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+	app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, ctx.BlockTime()), v2types.ReserveSnapshot{
+		Amm:         amm,
+		TimestampMs: ctx.BlockTime().UnixMilli(),
+	})
+	return app, ctx
+}
+
+// setPriceMultiplier moves the mark price of the market to the given multiple
+// of its reserve price.
+func setPriceMultiplier(t *testing.T, app *app.NibiruApp, ctx sdk.Context, pair asset.Pair, priceMultiplier sdk.Dec) {
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+	require.NoError(t, err)
+	amm.PriceMultiplier = priceMultiplier
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+}
+
+// nextBlock returns ctx one block later.
+func nextBlock(ctx sdk.Context) sdk.Context {
+	return ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(5 * time.Second))
+}
+
+func TestOrders(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1020))))
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, bob, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 500))))
+
+	balance := func(addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denoms.NUSD).Amount.Int64()
+	}
+
+	t.Log("alice places a limit long below the mark price, her margin is escrowed")
+	aliceOrderID, err := app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.9"), 1000))
+	require.NoError(t, err)
+	require.EqualValues(t, 1, aliceOrderID)
+	require.EqualValues(t, 20, balance(alice))
+
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, aliceOrderID)
+	require.NoError(t, err, "the order must not be triggered at the mark price of 1")
+
+	t.Log("bob places a limit short, he can't cancel alice's order")
+	bobOrderID, err := app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(bob, pair, v2types.Direction_SHORT, sdk.NewDec(2), 500))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, balance(bob))
+	require.ErrorIs(t, app.PerpKeeperV2.CancelOrder(ctx, bob, aliceOrderID), v2types.ErrUnauthorized)
+
+	resp, err := keeper.NewQuerier(app.PerpKeeperV2).Orders(sdk.WrapSDKContext(ctx), &v2types.QueryOrdersRequest{Trader: bob.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 1)
+	require.Equal(t, bobOrderID, resp.Orders[0].Id)
+
+	t.Log("bob cancels his order and gets his margin back")
+	require.NoError(t, app.PerpKeeperV2.CancelOrder(ctx, bob, bobOrderID))
+	require.EqualValues(t, 500, balance(bob))
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, bobOrderID)
+	require.Error(t, err)
+	require.ErrorIs(t, app.PerpKeeperV2.CancelOrder(ctx, bob, bobOrderID), v2types.ErrOrderNotFound)
+
+	t.Log("alice can't move her trigger above the mark price, the order would be executed right away")
+	_, err = app.PerpKeeperV2.ReplaceOrder(ctx, alice, aliceOrderID, sdk.MustNewDecFromStr("1.1"), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroInt())
+	require.ErrorIs(t, err, v2types.ErrOrderMarketable)
+
+	t.Log("alice moves her trigger closer to the mark price, which later drops below it and executes the order")
+	order, err := app.PerpKeeperV2.ReplaceOrder(ctx, alice, aliceOrderID, sdk.MustNewDecFromStr("0.95"), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroInt())
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.95"), order.TriggerPrice)
+	require.Len(t, app.PerpKeeperV2.OrderTriggers.Iterate(ctx, collections.Range[keeper.OrderTrigger]{}).Keys(), 1)
+
+	ctx = nextBlock(ctx)
+	setPriceMultiplier(t, app, ctx, pair, sdk.MustNewDecFromStr("0.9"))
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, aliceOrderID)
+	require.Error(t, err)
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+	require.True(t, position.Size_.IsPositive())
+	require.Equal(t, sdk.NewDec(1000), position.Margin)
+	require.EqualValues(t, 0, balance(alice), "the escrow and the fees are paid")
+
+	t.Log("alice places a take profit and a stop loss on her long, escrowing their deposits")
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.ClosingOrderDeposit = sdk.NewInt(10)
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 20))))
+
+	closeOrder := func(orderType v2types.OrderType, triggerPrice sdk.Dec) v2types.Order {
+		return v2types.MsgPlaceOrder{
+			Sender:       alice.String(),
+			Pair:         pair,
+			OrderType:    orderType,
+			TriggerPrice: triggerPrice,
+		}.NewOrder()
+	}
+	takeProfitID, err := app.PerpKeeperV2.PlaceOrder(ctx, closeOrder(v2types.OrderType_TAKE_PROFIT, sdk.NewDec(2)))
+	require.NoError(t, err)
+	stopLossID, err := app.PerpKeeperV2.PlaceOrder(ctx, closeOrder(v2types.OrderType_STOP_LOSS, sdk.MustNewDecFromStr("0.5")))
+	require.NoError(t, err)
+	require.EqualValues(t, 0, balance(alice))
+	takeProfit, err := app.PerpKeeperV2.Orders.Get(ctx, takeProfitID)
+	require.NoError(t, err)
+	require.Equal(t, v2types.Direction_LONG, takeProfit.Side)
+	require.Equal(t, sdk.NewInt(10), takeProfit.Deposit)
+
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.NoError(t, err)
+
+	t.Log("the stop loss is moved above the mark price and closes the position")
+	_, err = app.PerpKeeperV2.ReplaceOrder(ctx, alice, stopLossID, sdk.NewDec(2), sdk.Int{}, sdk.Dec{}, sdk.Int{})
+	require.NoError(t, err)
+
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.Error(t, err)
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, stopLossID)
+	require.Error(t, err)
+
+	t.Log("the take profit is left untriggered, alice cancels it and gets her deposit back")
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, takeProfitID)
+	require.NoError(t, err)
+	balanceBefore := balance(alice)
+	require.NoError(t, app.PerpKeeperV2.CancelOrder(ctx, alice, takeProfitID))
+	require.EqualValues(t, balanceBefore+10, balance(alice))
+	require.Empty(t, app.PerpKeeperV2.OrderTriggers.Iterate(ctx, collections.Range[keeper.OrderTrigger]{}).Keys())
+}
+
+func TestOrderFailingExecutionIsCancelled(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1020))))
+
+	// the base amount limit can't be met, so the position can't be opened
+	order := limitOrder(alice, pair, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.9"), 1000)
+	order.BaseAssetAmountLimit = sdk.NewInt(1e9)
+	orderID, err := app.PerpKeeperV2.PlaceOrder(ctx, order)
+	require.NoError(t, err)
+
+	ctx = nextBlock(ctx)
+	setPriceMultiplier(t, app, ctx, pair, sdk.MustNewDecFromStr("0.8"))
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+
+	_, err = app.PerpKeeperV2.Orders.Get(ctx, orderID)
+	require.Error(t, err)
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.Error(t, err)
+	require.Equal(t, sdk.NewInt(1020), app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).Amount)
+}
+
+func TestExecuteOrdersStopsAtUntriggeredOrders(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10_000))))
+
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.MaxOrdersExecutedPerBlock = 2
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	// limit longs are placed below a mark price of 2, once it drops to 1 the
+	// ones below 1 are not triggered and the ones above are
+	setPriceMultiplier(t, app, ctx, pair, sdk.NewDec(2))
+	var untriggered, triggered []uint64
+	for i := int64(1); i <= 5; i++ {
+		orderID, err := app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.NewDecWithPrec(90-i, 2), 10))
+		require.NoError(t, err)
+		untriggered = append(untriggered, orderID)
+
+		orderID, err = app.PerpKeeperV2.PlaceOrder(ctx, limitOrder(alice, pair, v2types.Direction_LONG, sdk.NewDecWithPrec(110+i, 2), 10))
+		require.NoError(t, err)
+		triggered = append(triggered, orderID)
+	}
+
+	pending := func(orderIDs []uint64) (n int) {
+		for _, orderID := range orderIDs {
+			if _, err := app.PerpKeeperV2.Orders.Get(ctx, orderID); err == nil {
+				n++
+			}
+		}
+		return n
+	}
+
+	t.Log("the highest triggers are executed first, two per block")
+	setPriceMultiplier(t, app, ctx, pair, sdk.OneDec())
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	require.Equal(t, 3, pending(triggered))
+	_, err := app.PerpKeeperV2.Orders.Get(ctx, triggered[4])
+	require.Error(t, err)
+
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	app.PerpKeeperV2.ExecuteOrders(ctx)
+	require.Equal(t, 0, pending(triggered))
+	require.Equal(t, 5, pending(untriggered))
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// RegisterReferralCode registers a referral code owned by the referrer, who
+// earns a share of the exchange fees of the traders bound to it.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - referrer: the referrer's address
+//   - code: the referral code
+//
+// returns:
+//   - err: error if any
+func (k Keeper) RegisterReferralCode(ctx sdk.Context, referrer sdk.AccAddress, code string) error {
+	if err := v2types.ValidateReferralCode(code); err != nil {
+		return err
+	}
+	if _, err := k.ReferralCodes.Get(ctx, code); err == nil {
+		return v2types.ErrReferralCodeTaken.Wrap(code)
+	}
+
+	k.ReferralCodes.Insert(ctx, code, v2types.ReferralCode{
+		Code:            code,
+		ReferrerAddress: referrer.String(),
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.ReferralCodeRegisteredEvent{
+		Code:            code,
+		ReferrerAddress: referrer.String(),
+	})
+}
+
+// SetReferrer binds the trader to a referral code. A trader is bound once and
+// for all.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - trader: the trader's address
+//   - code: the referral code
+//
+// returns:
+//   - err: error if any
+func (k Keeper) SetReferrer(ctx sdk.Context, trader sdk.AccAddress, code string) error {
+	referralCode, err := k.ReferralCodes.Get(ctx, code)
+	if err != nil {
+		return v2types.ErrReferralCodeNotFound.Wrap(code)
+	}
+	if referralCode.ReferrerAddress == trader.String() {
+		return v2types.ErrSelfReferral.Wrap(code)
+	}
+	if referral, err := k.TraderReferrals.Get(ctx, trader); err == nil {
+		return v2types.ErrReferrerAlreadySet.Wrapf("%s is bound to %s", trader, referral.Code)
+	}
+
+	k.TraderReferrals.Insert(ctx, trader, v2types.TraderReferral{
+		TraderAddress: trader.String(),
+		Code:          code,
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.ReferrerSetEvent{
+		TraderAddress:   trader.String(),
+		Code:            code,
+		ReferrerAddress: referralCode.ReferrerAddress,
+	})
+}
+
+// ClaimReferralEarnings pays the referrer their unclaimed referral earnings
+// from the referral pool.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - referrer: the referrer's address
+//
+// returns:
+//   - earnings: the earnings paid out
+//   - err: error if any
+func (k Keeper) ClaimReferralEarnings(ctx sdk.Context, referrer sdk.AccAddress) (earnings sdk.Coins, err error) {
+	referralEarnings, err := k.ReferralEarnings.Get(ctx, referrer)
+	if err != nil || referralEarnings.Earnings.IsZero() {
+		return nil, v2types.ErrNoReferralEarnings.Wrap(referrer.String())
+	}
+
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		/* from */ v2types.ReferralPoolModuleAccount,
+		/* to */ referrer,
+		/* coins */ referralEarnings.Earnings,
+	); err != nil {
+		return nil, err
+	}
+	_ = k.ReferralEarnings.Delete(ctx, referrer)
+
+	return referralEarnings.Earnings, ctx.EventManager().EmitTypedEvent(&v2types.ReferralEarningsClaimedEvent{
+		ReferrerAddress: referrer.String(),
+		Earnings:        referralEarnings.Earnings,
+	})
+}
+
+// payReferralFee splits the exchange fee of a referred trader according to
+// the referral params. The referrer fee is moved from the trader to the
+// referral pool and added to the earnings of the referrer. The discount is
+// capped at the exchange fee left after the referrer fee, so the split never
+// pays out more than the fee, whatever the params.
+//
+// returns:
+//   - discount: the part of the exchange fee waived for the trader
+//   - referrerFee: the part of the exchange fee paid to the referrer
+//   - err: error if any
+func (k Keeper) payReferralFee(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, exchangeFee sdk.Int,
+) (discount sdk.Int, referrerFee sdk.Int, err error) {
+	discount, referrerFee = sdk.ZeroInt(), sdk.ZeroInt()
+	if !exchangeFee.IsPositive() {
+		return discount, referrerFee, nil
+	}
+
+	referral, err := k.TraderReferrals.Get(ctx, trader)
+	if err != nil {
+		return discount, referrerFee, nil
+	}
+	referralCode, err := k.ReferralCodes.Get(ctx, referral.Code)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	referrer, err := sdk.AccAddressFromBech32(referralCode.ReferrerAddress)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// params added after genesis are nil until set
+	params := k.GetParams(ctx)
+	if !params.ReferralDiscountRatio.IsNil() {
+		discount = params.ReferralDiscountRatio.MulInt(exchangeFee).TruncateInt()
+	}
+	if !params.ReferralFeeRatio.IsNil() {
+		referrerFee = params.ReferralFeeRatio.MulInt(exchangeFee).TruncateInt()
+	}
+	referrerFee = sdk.MinInt(referrerFee, exchangeFee)
+	discount = sdk.MinInt(discount, exchangeFee.Sub(referrerFee))
+
+	if referrerFee.IsPositive() {
+		fee := sdk.NewCoin(pair.QuoteDenom(), referrerFee)
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ trader,
+			/* to */ v2types.ReferralPoolModuleAccount,
+			/* coins */ sdk.NewCoins(fee),
+		); err != nil {
+			return sdk.Int{}, sdk.Int{}, err
+		}
+
+		earnings := k.ReferralEarnings.GetOr(ctx, referrer, v2types.ReferralEarnings{
+			ReferrerAddress: referrer.String(),
+		})
+		earnings.Earnings = earnings.Earnings.Add(fee)
+		k.ReferralEarnings.Insert(ctx, referrer, earnings)
+	}
+
+	return discount, referrerFee, ctx.EventManager().EmitTypedEvent(&v2types.ReferralFeePaidEvent{
+		TraderAddress:   trader.String(),
+		ReferrerAddress: referrer.String(),
+		ReferrerFee:     sdk.NewCoin(pair.QuoteDenom(), referrerFee),
+		Discount:        sdk.NewCoin(pair.QuoteDenom(), discount),
+	})
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestReferrals(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	partner := testutil.AccAddress()
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.ReferralFeeRatio = sdk.MustNewDecFromStr("0.2")
+	params.ReferralDiscountRatio = sdk.MustNewDecFromStr("0.1")
+	require.NoError(t, params.Validate())
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10_020))))
+
+	t.Log("the partner registers a referral code")
+	require.Error(t, app.PerpKeeperV2.RegisterReferralCode(ctx, partner, "No Caps"))
+	require.NoError(t, app.PerpKeeperV2.RegisterReferralCode(ctx, partner, "desk-1"))
+	require.ErrorIs(t, app.PerpKeeperV2.RegisterReferralCode(ctx, alice, "desk-1"), v2types.ErrReferralCodeTaken)
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.ReferralCodeRegisteredEvent{
+		Code:            "desk-1",
+		ReferrerAddress: partner.String(),
+	})
+
+	t.Log("alice binds to the code, once")
+	require.ErrorIs(t, app.PerpKeeperV2.SetReferrer(ctx, alice, "desk-2"), v2types.ErrReferralCodeNotFound)
+	require.ErrorIs(t, app.PerpKeeperV2.SetReferrer(ctx, partner, "desk-1"), v2types.ErrSelfReferral)
+	require.NoError(t, app.PerpKeeperV2.SetReferrer(ctx, alice, "desk-1"))
+	require.ErrorIs(t, app.PerpKeeperV2.SetReferrer(ctx, alice, "desk-1"), v2types.ErrReferrerAlreadySet)
+
+	t.Log("alice gets a discount on her exchange fee, the partner earns a share of it")
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.ReferralFeePaidEvent{
+		TraderAddress:   alice.String(),
+		ReferrerAddress: partner.String(),
+		ReferrerFee:     sdk.NewInt64Coin(denoms.NUSD, 2),
+		Discount:        sdk.NewInt64Coin(denoms.NUSD, 1),
+	})
+
+	moduleBalance := func(module string) int64 {
+		return app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(module), denoms.NUSD).Amount.Int64()
+	}
+	require.EqualValues(t, 1, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).Amount.Int64())
+	require.EqualValues(t, 7, moduleBalance(v2types.FeePoolModuleAccount))
+	require.EqualValues(t, 2, moduleBalance(v2types.ReferralPoolModuleAccount))
+	require.EqualValues(t, 10, moduleBalance(v2types.PerpEFModuleAccount))
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	code, err := queryServer.ReferralCode(sdk.WrapSDKContext(ctx), &v2types.QueryReferralCodeRequest{Code: "desk-1"})
+	require.NoError(t, err)
+	require.Equal(t, partner.String(), code.ReferralCode.ReferrerAddress)
+	earnings, err := queryServer.ReferralEarnings(sdk.WrapSDKContext(ctx), &v2types.QueryReferralEarningsRequest{Referrer: partner.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 2)), earnings.Earnings)
+
+	t.Log("the partner claims their earnings")
+	claimed, err := app.PerpKeeperV2.ClaimReferralEarnings(ctx, partner)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 2)), claimed)
+	require.Equal(t, claimed, app.BankKeeper.GetAllBalances(ctx, partner))
+	require.Zero(t, moduleBalance(v2types.ReferralPoolModuleAccount))
+
+	_, err = app.PerpKeeperV2.ClaimReferralEarnings(ctx, partner)
+	require.ErrorIs(t, err, v2types.ErrNoReferralEarnings)
+	earnings, err = queryServer.ReferralEarnings(sdk.WrapSDKContext(ctx), &v2types.QueryReferralEarningsRequest{Referrer: partner.String()})
+	require.NoError(t, err)
+	require.True(t, earnings.Earnings.IsZero())
+}
+
+func TestReferralDiscountCappedByFee(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	partner := testutil.AccAddress()
+	alice := testutil.AccAddress()
+
+	app, ctx := setupOrdersMarket(pair)
+	params := v2types.DefaultParams()
+	params.ReferralFeeRatio = sdk.MustNewDecFromStr("0.6")
+	params.ReferralDiscountRatio = sdk.MustNewDecFromStr("0.6")
+	// each ratio passes its own param validation, only their sum is too high
+	require.Error(t, params.Validate())
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 10_020))))
+
+	require.NoError(t, app.PerpKeeperV2.RegisterReferralCode(ctx, partner, "desk-1"))
+	require.NoError(t, app.PerpKeeperV2.SetReferrer(ctx, alice, "desk-1"))
+
+	t.Log("the discount is capped at the exchange fee left after the referrer fee")
+	_, err := app.PerpKeeperV2.OpenPosition(ctx, pair, v2types.Direction_LONG, alice, sdk.NewInt(10_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	testutil.RequireHasTypedEvent(t, ctx, &v2types.ReferralFeePaidEvent{
+		TraderAddress:   alice.String(),
+		ReferrerAddress: partner.String(),
+		ReferrerFee:     sdk.NewInt64Coin(denoms.NUSD, 6),
+		Discount:        sdk.NewInt64Coin(denoms.NUSD, 4),
+	})
+
+	moduleBalance := func(module string) int64 {
+		return app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(module), denoms.NUSD).Amount.Int64()
+	}
+	require.EqualValues(t, 4, app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).Amount.Int64())
+	require.Zero(t, moduleBalance(v2types.FeePoolModuleAccount))
+	require.EqualValues(t, 6, moduleBalance(v2types.ReferralPoolModuleAccount))
+	require.EqualValues(t, 10, moduleBalance(v2types.PerpEFModuleAccount))
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// RepegMarkets repegs the AMMs of the markets funded on the epoch whose mark
+// price diverges from the index price TWAP by more than the repeg divergence
+// threshold of the market. Called at the end of the epoch.
+func (k Keeper) RepegMarkets(ctx sdk.Context, epochIdentifier string) {
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if !market.Enabled || market.Settled || epochIdentifier != market.FundingRateEpochId {
+			continue
+		}
+		if market.RepegDivergenceThreshold.IsNil() || !market.RepegDivergenceThreshold.IsPositive() {
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		if err := k.repegMarket(cachedCtx, market); err != nil {
+			k.Logger(ctx).Error("failed to repeg market", "pair", market.Pair, "error", err)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+	}
+}
+
+// repegMarket repegs the AMM of the market to the index price TWAP if the mark
+// price diverges from it by more than the repeg divergence threshold. The
+// cost paid by the perp EF is capped by the repeg budget of the market and the
+// balance of the perp EF, in which case the AMM is repegged only part of the
+// way to the index price.
+func (k Keeper) repegMarket(ctx sdk.Context, market v2types.Market) error {
+	indexPrice, err := k.OracleKeeper.GetExchangeRateTwap(ctx, market.Pair)
+	if err != nil {
+		return err
+	}
+	if !indexPrice.IsPositive() {
+		return fmt.Errorf("index price is not positive: %s", indexPrice)
+	}
+
+	amm, err := k.AMMs.Get(ctx, market.Pair)
+	if err != nil {
+		return err
+	}
+
+	markPrice := amm.MarkPrice()
+	if !markPrice.IsPositive() {
+		return fmt.Errorf("mark price is not positive: %s", markPrice)
+	}
+
+	if markPrice.Sub(indexPrice).Abs().Quo(indexPrice).LT(market.RepegDivergenceThreshold) {
+		return nil
+	}
+
+	// the mark price scales with the price multiplier
+	newPriceMultiplier := amm.PriceMultiplier.Mul(indexPrice).Quo(markPrice)
+	cost, err := amm.CalcRepegCost(newPriceMultiplier)
+	if err != nil {
+		return err
+	}
+
+	capped := false
+	if cost.IsPositive() {
+		budget := sdk.ZeroInt()
+		if !market.RepegBudgetPerEpoch.IsNil() {
+			budget = market.RepegBudgetPerEpoch
+		}
+		budget = sdk.MinInt(budget, k.BankKeeper.GetBalance(
+			ctx, k.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), market.Pair.QuoteDenom(),
+		).Amount)
+
+		if cost.GT(budget) {
+			if !budget.IsPositive() {
+				return types.ErrNotEnoughFundToPayAction.Wrapf("no budget left to repeg %s", market.Pair)
+			}
+
+			// the cost is linear in the change of the price multiplier
+			delta := newPriceMultiplier.Sub(amm.PriceMultiplier).MulInt(budget).QuoInt(cost)
+			newPriceMultiplier = amm.PriceMultiplier.Add(delta)
+			capped = true
+
+			cost, err = amm.CalcRepegCost(newPriceMultiplier)
+			if err != nil {
+				return err
+			}
+			if cost.GT(budget) {
+				return types.ErrNotEnoughFundToPayAction.Wrapf("repeg of %s costs %s over a budget of %s", market.Pair, cost, budget)
+			}
+		}
+	}
+
+	oldPriceMultiplier := amm.PriceMultiplier
+	if err = k.EditPriceMultiplier(ctx, market.Pair, newPriceMultiplier); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.AmmRepeggedEvent{
+		Pair:               market.Pair,
+		MarkPrice:          markPrice,
+		IndexPrice:         indexPrice,
+		OldPriceMultiplier: oldPriceMultiplier,
+		NewPriceMultiplier: newPriceMultiplier,
+		Cost:               cost,
+		Capped:             capped,
+	})
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestRepegMarkets(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	tests := []struct {
+		name        string
+		totalLong   int64
+		indexPrice  sdk.Dec
+		budget      int64
+		efBalance   int64
+		expectRepeg bool
+		expectCap   bool
+	}{
+		{
+			name:        "mark price within the threshold",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("1.05"),
+			budget:      1e8,
+			efBalance:   1e8,
+			expectRepeg: false,
+		},
+		{
+			name:        "repegged to the index price",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("1.5"),
+			budget:      1e8,
+			efBalance:   1e8,
+			expectRepeg: true,
+		},
+		{
+			name:        "repeg capped by the epoch budget",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("1.5"),
+			budget:      1000,
+			efBalance:   1e8,
+			expectRepeg: true,
+			expectCap:   true,
+		},
+		{
+			name:        "repeg capped by the ecosystem fund balance",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("1.5"),
+			budget:      1e8,
+			efBalance:   1000,
+			expectRepeg: true,
+			expectCap:   true,
+		},
+		{
+			name:        "no budget for a costly repeg",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("1.5"),
+			budget:      0,
+			efBalance:   1e8,
+			expectRepeg: false,
+		},
+		{
+			name:        "a repeg paying the ecosystem fund needs no budget",
+			totalLong:   1e6,
+			indexPrice:  sdk.MustNewDecFromStr("0.5"),
+			budget:      0,
+			efBalance:   0,
+			expectRepeg: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := setupOrdersMarket(pair)
+			market := app.PerpKeeperV2.Markets.GetOr(ctx, pair, v2types.Market{})
+			market.RepegDivergenceThreshold = sdk.MustNewDecFromStr("0.1")
+			market.RepegBudgetPerEpoch = sdk.NewInt(tc.budget)
+			app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+
+			amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+			require.NoError(t, err)
+			amm.TotalLong = sdk.NewDec(tc.totalLong)
+			app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+
+			app.OracleKeeper.SetPrice(ctx, pair, tc.indexPrice)
+			require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e8))))
+			if tc.efBalance > 0 {
+				require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, tc.efBalance))))
+			}
+
+			app.PerpKeeperV2.RepegMarkets(ctx, market.FundingRateEpochId)
+
+			newAmm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+			require.NoError(t, err)
+			efBalance := app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD).Amount
+			cost := sdk.NewInt(tc.efBalance).Sub(efBalance)
+
+			if !tc.expectRepeg {
+				require.Equal(t, amm.PriceMultiplier, newAmm.PriceMultiplier)
+				require.True(t, cost.IsZero())
+				testutil.RequireNotHasTypedEvent(t, ctx, &v2types.AmmRepeggedEvent{})
+				return
+			}
+
+			if tc.expectCap {
+				require.True(t, newAmm.PriceMultiplier.GT(amm.PriceMultiplier))
+				require.True(t, newAmm.PriceMultiplier.LT(tc.indexPrice))
+				require.True(t, cost.IsPositive())
+				require.True(t, cost.LTE(sdk.MinInt(sdk.NewInt(tc.budget), sdk.NewInt(tc.efBalance))))
+			} else {
+				require.Equal(t, tc.indexPrice, newAmm.MarkPrice())
+			}
+
+			testutil.RequireHasTypedEvent(t, ctx, &v2types.AmmRepeggedEvent{
+				Pair:               pair,
+				MarkPrice:          sdk.OneDec(),
+				IndexPrice:         tc.indexPrice,
+				OldPriceMultiplier: amm.PriceMultiplier,
+				NewPriceMultiplier: newAmm.PriceMultiplier,
+				Cost:               cost,
+				Capped:             tc.expectCap,
+			})
+		})
+	}
+}
+
+func TestRepegMarketsSkipsOtherEpochs(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	app, ctx := setupOrdersMarket(pair)
+	market := app.PerpKeeperV2.Markets.GetOr(ctx, pair, v2types.Market{})
+	market.RepegDivergenceThreshold = sdk.MustNewDecFromStr("0.1")
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+	app.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(2))
+
+	app.PerpKeeperV2.RepegMarkets(ctx, "not the funding epoch")
+
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), amm.PriceMultiplier)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// SettleMarket freezes a market at the settlement price computed from its AMM.
+// The market gets disabled: trading, margin changes, liquidations and funding
+// payments stop, and positions can only be settled with SettlePosition.
+func (k Keeper) SettleMarket(ctx sdk.Context, pair asset.Pair) error {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	if market.Settled {
+		return v2types.ErrMarketSettled.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	settlementPrice, err := amm.ComputeSettlementPrice()
+	if err != nil {
+		return err
+	}
+
+	market.Enabled = false
+	market.Settled = true
+	market.SettlementPrice = settlementPrice
+	k.Markets.Insert(ctx, pair, market)
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.MarketUpdatedEvent{
+		FinalMarket: market,
+	})
+}
+
+// SettlePosition closes the position of a trader on a settled market at the
+// settlement price of the market. The remaining margin, after PnL and funding
+// payments, is withdrawn to the trader. A negative remaining margin is
+// realized as bad debt.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - pair: the pair of the settled market
+//   - traderAddr: the owner of the position
+//
+// returns:
+//   - resp: the coins sent to the trader and the bad debt realized
+//   - err: error
+func (k Keeper) SettlePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress) (resp *v2types.MsgSettlePositionResponse, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	if !market.Settled {
+		return nil, v2types.ErrMarketNotSettled.Wrapf("pair: %s", pair)
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	if position.Size_.IsZero() {
+		return nil, v2types.ErrPositionZero
+	}
+
+	// pnl = size * (settlementPrice - openPrice)
+	openPrice := position.OpenNotional.Quo(position.Size_.Abs())
+	realizedPnl := position.Size_.Mul(market.SettlementPrice.Sub(openPrice))
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Add(realizedPnl).Sub(fundingPayment)
+
+	settledCoins := sdk.NewCoins()
+	badDebt := sdk.ZeroDec()
+	if remainingMargin.IsPositive() {
+		settledAmount := remainingMargin.RoundInt()
+		if err = k.Withdraw(ctx, market, traderAddr, settledAmount); err != nil {
+			return nil, err
+		}
+		settledCoins = sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), settledAmount))
+	} else if remainingMargin.IsNegative() {
+		badDebt = remainingMargin.Abs()
+		if err = k.realizeBadDebt(ctx, market, badDebt.RoundInt(), v2types.Direction_DIRECTION_UNSPECIFIED); err != nil {
+			return nil, err
+		}
+	}
+
+	if position.Size_.IsPositive() {
+		amm.TotalLong = amm.TotalLong.Sub(position.Size_)
+	} else {
+		amm.TotalShort = amm.TotalShort.Sub(position.Size_.Abs())
+	}
+	k.AMMs.Insert(ctx, pair, amm)
+
+	if err = k.DeletePosition(ctx, pair, traderAddr); err != nil {
+		return nil, err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&v2types.PositionSettledEvent{
+		Pair:          pair,
+		TraderAddress: traderAddr.String(),
+		SettledCoins:  settledCoins,
+		BadDebt:       sdk.NewCoin(pair.QuoteDenom(), badDebt.RoundInt()),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgSettlePositionResponse{
+		SettledCoins: settledCoins,
+		BadDebt:      badDebt,
+	}, nil
+}
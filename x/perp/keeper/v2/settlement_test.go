@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestSettleMarketAndPositions(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	amm := mock.TestAMM(sdk.NewDec(1e6), sdk.OneDec())
+	amm.TotalLong = sdk.NewDec(2e5)
+	amm.TotalShort = sdk.NewDec(1e5)
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, *mock.TestMarket())
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, *amm)
+
+	// alice is long at 0.75, bob is short at 0.5
+	app.PerpKeeperV2.SetPosition(ctx, alice, v2types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(2e5),
+		Margin:                          sdk.NewDec(1e4),
+		OpenNotional:                    sdk.NewDec(15e4),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+	app.PerpKeeperV2.SetPosition(ctx, bob, v2types.Position{
+		TraderAddress:                   bob.String(),
+		Pair:                            pair,
+		Size_:                           sdk.NewDec(-1e5),
+		Margin:                          sdk.NewDec(1e4),
+		OpenNotional:                    sdk.NewDec(5e4),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e5))))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1e5))))
+
+	t.Log("positions can't be settled before the market")
+	_, err := app.PerpKeeperV2.SettlePosition(ctx, pair, alice)
+	require.ErrorIs(t, err, v2types.ErrMarketNotSettled)
+
+	t.Log("settle the market")
+	require.NoError(t, app.PerpKeeperV2.SettleMarket(ctx, pair))
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+	require.NoError(t, err)
+	require.False(t, market.Enabled)
+	require.True(t, market.Settled)
+	require.Equal(t, sdk.MustNewDecFromStr("0.909090909090909091"), market.SettlementPrice)
+
+	require.ErrorIs(t, app.PerpKeeperV2.SettleMarket(ctx, pair), v2types.ErrMarketSettled)
+	require.ErrorIs(t, app.PerpKeeperV2.ChangeMarketEnabledParameter(ctx, pair, true), v2types.ErrMarketSettled)
+	require.ErrorIs(t, app.PerpKeeperV2.EditPriceMultiplier(ctx, pair, sdk.NewDec(2)), v2types.ErrMarketSettled)
+	_, err = app.PerpKeeperV2.ClosePosition(ctx, pair, alice)
+	require.ErrorIs(t, err, v2types.ErrMarketSettled)
+
+	t.Log("alice settles with a profit")
+	resp, err := app.PerpKeeperV2.SettlePosition(ctx, pair, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 41818)), resp.SettledCoins)
+	require.True(t, resp.BadDebt.IsZero())
+	require.Equal(t, sdk.NewInt(41818), app.BankKeeper.GetBalance(ctx, alice, denoms.NUSD).Amount)
+
+	t.Log("bob settles underwater, realizing bad debt")
+	resp, err = app.PerpKeeperV2.SettlePosition(ctx, pair, bob)
+	require.NoError(t, err)
+	require.True(t, resp.SettledCoins.IsZero())
+	require.Equal(t, sdk.NewInt(30909), resp.BadDebt.RoundInt())
+	require.True(t, app.BankKeeper.GetBalance(ctx, bob, denoms.NUSD).IsZero())
+	require.Equal(t, sdk.NewInt(1e5-30909), app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD).Amount)
+
+	t.Log("positions are gone and the open interest is closed")
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, alice))
+	require.Error(t, err)
+	_, err = app.PerpKeeperV2.SettlePosition(ctx, pair, bob)
+	require.Error(t, err)
+
+	settledAMM, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+	require.NoError(t, err)
+	require.True(t, settledAMM.TotalLong.IsZero())
+	require.True(t, settledAMM.TotalShort.IsZero())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
@@ -163,3 +164,24 @@ func getPriceWithSnapshot(
 
 	return sdk.ZeroDec(), nil
 }
+
+// PruneReserveSnapshots deletes the reserve snapshots older than
+// SnapshotRetentionLookbacks twap lookback windows of their market, except the
+// latest one of each market, up to MaxSnapshotsPrunedPerBlock snapshots across
+// all markets. Does nothing unless the SnapshotRetentionLookbacks param is set.
+// Called in the EndBlocker.
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context) (pruned uint64) {
+	params := k.GetParams(ctx)
+	if params.SnapshotRetentionLookbacks == 0 {
+		return 0
+	}
+
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if pruned >= params.MaxSnapshotsPrunedPerBlock {
+			break
+		}
+		retention := time.Duration(params.SnapshotRetentionLookbacks) * market.TwapLookbackWindow
+		pruned += common.PruneSnapshots(ctx, k.ReserveSnapshots, market.Pair, ctx.BlockTime().Add(-retention), params.MaxSnapshotsPrunedPerBlock-pruned)
+	}
+	return pruned
+}
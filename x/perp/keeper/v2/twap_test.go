@@ -358,3 +358,57 @@ func TestCalcTwapExtended(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneReserveSnapshots(t *testing.T) {
+	btc := asset.NewPair(denoms.BTC, denoms.NUSD)
+	eth := asset.NewPair(denoms.ETH, denoms.NUSD)
+
+	app, ctx := setupOrdersMarket(btc)
+	app.PerpKeeperV2.Markets.Insert(ctx, eth, *mock.TestMarket().WithPair(eth))
+
+	// a snapshot of each market every 10 minutes for 3 hours, the twap lookback
+	// window of the markets is 30 minutes
+	start := ctx.BlockTime()
+	for i := 0; i <= 18; i++ {
+		for _, pair := range []asset.Pair{btc, eth} {
+			amm := *mock.TestAMM(sdk.NewDec(1e12), sdk.NewDec(int64(i+1)))
+			amm.Pair = pair
+			snapshotTime := start.Add(time.Duration(i) * 10 * time.Minute)
+			app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, snapshotTime), v2types.ReserveSnapshot{
+				Amm:         amm,
+				TimestampMs: snapshotTime.UnixMilli(),
+			})
+		}
+	}
+	snapshotCount := func(pair asset.Pair) int {
+		return len(app.PerpKeeperV2.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Keys())
+	}
+	ctx = ctx.WithBlockTime(start.Add(3 * time.Hour))
+	twapBefore, err := app.PerpKeeperV2.CalcTwap(ctx, btc, v2types.TwapCalcOption_SPOT, v2types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), 30*time.Minute)
+	require.NoError(t, err)
+
+	t.Log("nothing is pruned while the pruning is disabled")
+	params := v2types.DefaultParams()
+	params.SnapshotRetentionLookbacks = 0
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.Zero(t, app.PerpKeeperV2.PruneReserveSnapshots(ctx))
+
+	t.Log("the snapshots older than 2 lookback windows are pruned, 10 at most per block")
+	params.SnapshotRetentionLookbacks = 2
+	params.MaxSnapshotsPrunedPerBlock = 10
+	require.NoError(t, params.Validate())
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.EqualValues(t, 10, app.PerpKeeperV2.PruneReserveSnapshots(ctx))
+	require.EqualValues(t, 10, app.PerpKeeperV2.PruneReserveSnapshots(ctx))
+	require.EqualValues(t, 2, app.PerpKeeperV2.PruneReserveSnapshots(ctx))
+	require.Zero(t, app.PerpKeeperV2.PruneReserveSnapshots(ctx))
+
+	t.Log("the latest snapshot older than 2 lookback windows is kept")
+	require.Equal(t, 8, snapshotCount(btc))
+	require.Equal(t, 8, snapshotCount(eth))
+
+	t.Log("the TWAP over the lookback window is unchanged")
+	twap, err := app.PerpKeeperV2.CalcTwap(ctx, btc, v2types.TwapCalcOption_SPOT, v2types.Direction_DIRECTION_UNSPECIFIED, sdk.ZeroDec(), 30*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, twapBefore, twap)
+}
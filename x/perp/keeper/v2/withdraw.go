@@ -8,7 +8,8 @@ import (
 
 // Withdraws coins from the vault to the receiver.
 // If the total amount of coins to withdraw is greater than the vault's amount, then
-// withdraw the shortage from the PerpEF and mark it as prepaid bad debt.
+// withdraw the shortage from the insurance fund, then from the PerpEF, and mark it
+// as prepaid bad debt.
 //
 // Prepaid bad debt will count towards realized bad debt from negative PnL positions
 // when those are closed/liquidated.
@@ -54,16 +55,14 @@ func (k Keeper) Withdraw(
 
 		// TODO(k-yang): emit event for prepaid bad debt
 
-		if err := k.BankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			v2types.PerpEFModuleAccount,
-			v2types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(market.Pair.QuoteDenom(), shortage),
-			),
-		); err != nil {
+		uncovered, err := k.coverBadDebt(ctx, market.Pair.QuoteDenom(), shortage)
+		if err != nil {
 			return err
 		}
+		if uncovered.IsPositive() {
+			return v2types.ErrInsuranceFundDepleted.Wrapf(
+				"the insurance and ecosystem funds can't cover a shortage of %s%s", uncovered, market.Pair.QuoteDenom())
+		}
 	}
 
 	// Transfer from Vault to receiver
@@ -101,11 +100,15 @@ Prepaid bad debt accrues when more coins are withdrawn from the vault than the
 vault contains, so we "credit" ourselves with prepaid bad debt.
 
 then, when bad debt is actually realized (by closing underwater positions), we
-can consume the credit we have built before withdrawing more from the ecosystem fund.
+can consume the credit we have built before withdrawing more from the insurance
+fund and the ecosystem fund. The bad debt neither fund can cover is absorbed by auto-deleveraging the
+profitable positions of the market on the side adlSide, or on both sides if it
+is unspecified. What is left is queued to be socialized by the EndBlocker over
+the profitable positions of the markets quoted in the same denom.
 */
-func (k Keeper) realizeBadDebt(ctx sdk.Context, market v2types.Market, badDebtToRealize sdk.Int) (
-	err error,
-) {
+func (k Keeper) realizeBadDebt(
+	ctx sdk.Context, market v2types.Market, badDebtToRealize sdk.Int, adlSide v2types.Direction,
+) (err error) {
 	if market.PrepaidBadDebt.Amount.GTE(badDebtToRealize) {
 		// prepaidBadDebtBalance > badDebtToRealize
 		k.DecrementPrepaidBadDebt(ctx, market, badDebtToRealize)
@@ -113,16 +116,20 @@ func (k Keeper) realizeBadDebt(ctx sdk.Context, market v2types.Market, badDebtTo
 		// badDebtToRealize > prepaidBadDebtBalance
 		k.ZeroPrepaidBadDebt(ctx, market)
 
-		return k.BankKeeper.SendCoinsFromModuleToModule(ctx,
-			/*from=*/ v2types.PerpEFModuleAccount,
-			/*to=*/ v2types.VaultModuleAccount,
-			sdk.NewCoins(
-				sdk.NewCoin(
-					market.Pair.QuoteDenom(),
-					badDebtToRealize.Sub(market.PrepaidBadDebt.Amount),
-				),
-			),
-		)
+		denom := market.Pair.QuoteDenom()
+		uncovered, err := k.coverBadDebt(ctx, denom, badDebtToRealize.Sub(market.PrepaidBadDebt.Amount))
+		if err != nil {
+			return err
+		}
+		if uncovered.IsPositive() {
+			uncovered, err = k.autoDeleverage(ctx, market.Pair, adlSide, uncovered)
+			if err != nil {
+				return err
+			}
+		}
+		if uncovered.IsPositive() {
+			k.queueLoss(ctx, denom, uncovered)
+		}
 	}
 
 	return nil
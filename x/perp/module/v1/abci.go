@@ -11,7 +11,8 @@ import (
 	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
 )
 
-// EndBlocker Called every block to store metrics.
+// EndBlocker Called every block to store metrics and prune the perp amm reserve
+// snapshots older than their retention.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	for _, metrics := range k.Metrics.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
 		_ = ctx.EventManager().EmitTypedEvent(&types.MetricsEvent{
@@ -21,5 +22,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			BlockTimeMs: ctx.BlockTime().Unix(),
 		})
 	}
+
+	if retention, maxPrunedPerBlock := k.SnapshotRetention(ctx); retention > 0 {
+		k.PerpAmmKeeper.PruneReserveSnapshots(ctx, retention, maxPrunedPerBlock)
+	}
 	return []abci.ValidatorUpdate{}
 }
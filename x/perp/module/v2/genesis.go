@@ -26,12 +26,57 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	}
 
 	for _, p := range genState.Positions {
-		k.Positions.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.TraderAddress)), p)
+		k.SetPosition(ctx, sdk.MustAccAddressFromBech32(p.TraderAddress), p)
 	}
 
 	for _, p := range genState.ReserveSnapshots {
 		k.ReserveSnapshots.Insert(ctx, collections.Join(p.Amm.Pair, time.UnixMilli(p.TimestampMs)), p)
 	}
+
+	for _, o := range genState.Orders {
+		k.Orders.Insert(ctx, o.Id, o)
+		k.OrderTriggers.Insert(ctx, keeper.NewOrderTrigger(o))
+	}
+
+	if genState.NextOrderId != 0 {
+		k.NextOrderID.Set(ctx, genState.NextOrderId)
+	}
+
+	for _, a := range genState.CrossMarginAccounts {
+		k.CrossMarginAccounts.Insert(ctx, sdk.MustAccAddressFromBech32(a.TraderAddress), a)
+	}
+
+	for _, w := range genState.InsuranceFundWithdrawals {
+		k.InsuranceFundWithdrawals.Insert(ctx, collections.Join(w.UnlockTime, sdk.MustAccAddressFromBech32(w.StakerAddress)), w)
+	}
+
+	for _, v := range genState.TraderVolumes {
+		k.TraderVolumes.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(v.TraderAddress), v.Day), v)
+	}
+
+	for _, c := range genState.ReferralCodes {
+		k.ReferralCodes.Insert(ctx, c.Code, c)
+	}
+
+	for _, r := range genState.TraderReferrals {
+		k.TraderReferrals.Insert(ctx, sdk.MustAccAddressFromBech32(r.TraderAddress), r)
+	}
+
+	for _, e := range genState.ReferralEarnings {
+		k.ReferralEarnings.Insert(ctx, sdk.MustAccAddressFromBech32(e.ReferrerAddress), e)
+	}
+
+	for _, r := range genState.FundingRates {
+		k.FundingRates.Insert(ctx, collections.Join(r.Pair, r.Epoch), r)
+	}
+
+	for _, c := range genState.CollateralAssets {
+		k.CollateralAssets.Insert(ctx, c.Denom, c)
+	}
+
+	for _, l := range genState.PendingLosses {
+		k.PendingLosses.Insert(ctx, l.Denom, l)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -43,6 +88,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Amms = k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
 	genesis.Positions = k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
 	genesis.ReserveSnapshots = k.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Values()
+	genesis.Orders = k.Orders.Iterate(ctx, collections.Range[uint64]{}).Values()
+	genesis.NextOrderId = k.NextOrderID.Peek(ctx)
+	genesis.CrossMarginAccounts = k.CrossMarginAccounts.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values()
+	genesis.InsuranceFundWithdrawals = k.InsuranceFundWithdrawals.Iterate(ctx, collections.PairRange[time.Time, sdk.AccAddress]{}).Values()
+	genesis.TraderVolumes = k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}).Values()
+	genesis.ReferralCodes = k.ReferralCodes.Iterate(ctx, collections.Range[string]{}).Values()
+	genesis.TraderReferrals = k.TraderReferrals.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values()
+	genesis.ReferralEarnings = k.ReferralEarnings.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values()
+	genesis.FundingRates = k.FundingRates.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}).Values()
+	genesis.CollateralAssets = k.CollateralAssets.Iterate(ctx, collections.Range[string]{}).Values()
+	genesis.PendingLosses = k.PendingLosses.Iterate(ctx, collections.Range[string]{}).Values()
 
 	return genesis
 }
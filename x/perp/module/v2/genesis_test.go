@@ -1,7 +1,9 @@
 package perp_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +14,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perp "github.com/NibiruChain/nibiru/x/perp/module/v2"
 	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
@@ -40,8 +43,123 @@ func TestGenesis(t *testing.T) {
 		})
 	}
 
+	// create some orders
+	for i := uint64(0); i < 10; i++ {
+		order := types.Order{
+			Id:                   app.PerpKeeperV2.NextOrderID.Next(ctx),
+			TraderAddress:        testutil.AccAddress().String(),
+			Pair:                 pair,
+			OrderType:            types.OrderType_LIMIT,
+			Side:                 types.Direction_LONG,
+			TriggerPrice:         sdk.NewDec(int64(i + 1)),
+			QuoteAssetAmount:     sdk.NewInt(100),
+			Leverage:             sdk.OneDec(),
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+			Deposit:              sdk.ZeroInt(),
+		}
+		app.PerpKeeperV2.Orders.Insert(ctx, order.Id, order)
+		app.PerpKeeperV2.OrderTriggers.Insert(ctx, keeper.NewOrderTrigger(order))
+	}
+
+	// create some cross-margin accounts
+	for i := int64(0); i < 5; i++ {
+		trader := testutil.AccAddress()
+		app.PerpKeeperV2.CrossMarginAccounts.Insert(ctx, trader, types.CrossMarginAccount{
+			TraderAddress: trader.String(),
+			Collateral:    sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, (i+1)*100)),
+		})
+	}
+
+	// create some insurance fund withdrawals
+	for i := int64(0); i < 5; i++ {
+		staker := testutil.AccAddress()
+		unlockTime := time.Unix(1_700_000_000+i*3600, 0).UTC()
+		app.PerpKeeperV2.InsuranceFundWithdrawals.Insert(ctx, collections.Join(unlockTime, staker), types.InsuranceFundWithdrawal{
+			StakerAddress: staker.String(),
+			Shares:        sdk.NewCoins(sdk.NewInt64Coin(types.InsuranceFundShareDenom(denoms.NUSD), (i+1)*100)),
+			UnlockTime:    unlockTime,
+		})
+	}
+
+	// create some trader volumes
+	for i := uint64(0); i < 5; i++ {
+		trader := testutil.AccAddress()
+		app.PerpKeeperV2.TraderVolumes.Insert(ctx, collections.Join(trader, 19_000+i), types.TraderVolume{
+			TraderAddress: trader.String(),
+			Day:           19_000 + i,
+			Volume:        sdk.NewDec(int64(i+1) * 1000),
+		})
+	}
+
+	// create some referrals
+	for i := 0; i < 5; i++ {
+		referrer := testutil.AccAddress()
+		trader := testutil.AccAddress()
+		code := fmt.Sprintf("code-%d", i)
+		app.PerpKeeperV2.ReferralCodes.Insert(ctx, code, types.ReferralCode{
+			Code:            code,
+			ReferrerAddress: referrer.String(),
+		})
+		app.PerpKeeperV2.TraderReferrals.Insert(ctx, trader, types.TraderReferral{
+			TraderAddress: trader.String(),
+			Code:          code,
+		})
+		app.PerpKeeperV2.ReferralEarnings.Insert(ctx, referrer, types.ReferralEarnings{
+			ReferrerAddress: referrer.String(),
+			Earnings:        sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, int64(i+1)*10)),
+		})
+	}
+
+	// create some funding rate history
+	for epoch := uint64(1); epoch <= 5; epoch++ {
+		app.PerpKeeperV2.FundingRates.Insert(ctx, collections.Join(pair, epoch), types.FundingRateRecord{
+			Pair:            pair,
+			Epoch:           epoch,
+			MarkTwap:        sdk.NewDec(10),
+			IndexTwap:       sdk.NewDec(9),
+			PremiumFraction: sdk.MustNewDecFromStr("0.1"),
+			FundingRate:     sdk.MustNewDecFromStr("0.1").QuoInt64(9),
+			Timestamp:       time.Unix(int64(epoch)*1800, 0).UTC(),
+		})
+	}
+
+	// accept some collateral assets
+	for i, denom := range []string{denoms.NIBI, denoms.ETH} {
+		app.PerpKeeperV2.CollateralAssets.Insert(ctx, denom, types.CollateralAsset{
+			Denom:      denom,
+			OraclePair: asset.NewPair(denom, denoms.NUSD),
+			Haircut:    sdk.MustNewDecFromStr("0.2"),
+			SpotPoolId: uint64(i + 1),
+		})
+	}
+
+	// leave a loss to socialize
+	app.PerpKeeperV2.PendingLosses.Insert(ctx, denoms.NUSD, types.PendingLoss{
+		Denom:  denoms.NUSD,
+		Amount: sdk.NewInt(100),
+		Cursor: &types.PositionCursor{Pair: pair, TraderAddress: testutil.AccAddress().String()},
+	})
+
 	// export genesis
 	genState := perp.ExportGenesis(ctx, app.PerpKeeperV2)
+	for _, w := range genState.InsuranceFundWithdrawals {
+		require.NoError(t, w.Validate())
+	}
+	for _, v := range genState.TraderVolumes {
+		require.NoError(t, v.Validate())
+	}
+	for _, r := range genState.TraderReferrals {
+		require.NoError(t, r.Validate())
+	}
+	for _, r := range genState.FundingRates {
+		require.NoError(t, r.Validate())
+	}
+	for _, c := range genState.CollateralAssets {
+		require.NoError(t, c.Validate())
+	}
+	for _, l := range genState.PendingLosses {
+		require.NoError(t, l.Validate())
+	}
 
 	// create new context and init genesis
 	ctx, _ = ctxUncached.CacheContext()
@@ -63,4 +181,24 @@ func TestGenesis(t *testing.T) {
 	for i, pos := range genState.Positions {
 		require.Equalf(t, pos, genStateAfterInit.Positions[i], "%s <-> %s", pos, genStateAfterInit.Positions[i])
 	}
+
+	require.Equal(t, genState.Orders, genStateAfterInit.Orders)
+	require.EqualValues(t, 11, genStateAfterInit.NextOrderId)
+	require.Len(t, app.PerpKeeperV2.OrderTriggers.Iterate(ctx, collections.Range[keeper.OrderTrigger]{}).Keys(), 10)
+	require.Len(t, genStateAfterInit.CrossMarginAccounts, 5)
+	require.Equal(t, genState.CrossMarginAccounts, genStateAfterInit.CrossMarginAccounts)
+	require.Len(t, genStateAfterInit.InsuranceFundWithdrawals, 5)
+	require.Equal(t, genState.InsuranceFundWithdrawals, genStateAfterInit.InsuranceFundWithdrawals)
+	require.Len(t, genStateAfterInit.TraderVolumes, 5)
+	require.Equal(t, genState.TraderVolumes, genStateAfterInit.TraderVolumes)
+	require.Len(t, genStateAfterInit.ReferralCodes, 5)
+	require.Equal(t, genState.ReferralCodes, genStateAfterInit.ReferralCodes)
+	require.Equal(t, genState.TraderReferrals, genStateAfterInit.TraderReferrals)
+	require.Equal(t, genState.ReferralEarnings, genStateAfterInit.ReferralEarnings)
+	require.Len(t, genStateAfterInit.FundingRates, 5)
+	require.Equal(t, genState.FundingRates, genStateAfterInit.FundingRates)
+	require.Len(t, genStateAfterInit.CollateralAssets, 2)
+	require.Equal(t, genState.CollateralAssets, genStateAfterInit.CollateralAssets)
+	require.Len(t, genStateAfterInit.PendingLosses, 1)
+	require.Equal(t, genState.PendingLosses, genStateAfterInit.PendingLosses)
 }
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
@@ -38,9 +39,54 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgClosePosition:
 			res, err := msgServer.ClosePosition(goCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPartialClose:
+			res, err := msgServer.PartialClose(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgMultiLiquidate:
 			res, err := msgServer.MultiLiquidate(goCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDonateToEcosystemFund:
+			res, err := msgServer.DonateToEcosystemFund(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSettlePosition:
+			res, err := msgServer.SettlePosition(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlaceOrder:
+			res, err := msgServer.PlaceOrder(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelOrder:
+			res, err := msgServer.CancelOrder(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgReplaceOrder:
+			res, err := msgServer.ReplaceOrder(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgEnableCrossMargin:
+			res, err := msgServer.EnableCrossMargin(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDisableCrossMargin:
+			res, err := msgServer.DisableCrossMargin(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddCrossMarginCollateral:
+			res, err := msgServer.AddCrossMarginCollateral(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveCrossMarginCollateral:
+			res, err := msgServer.RemoveCrossMarginCollateral(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDepositInsuranceFund:
+			res, err := msgServer.DepositInsuranceFund(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawInsuranceFund:
+			res, err := msgServer.WithdrawInsuranceFund(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRegisterReferralCode:
+			res, err := msgServer.RegisterReferralCode(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetReferrer:
+			res, err := msgServer.SetReferrer(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgClaimReferralEarnings:
+			res, err := msgServer.ClaimReferralEarnings(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf(
 				"unrecognized %s message type: %T", types.ModuleName, msg)
@@ -48,3 +94,36 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewMarketProposalHandler returns a govtypes.Handler for the market lifecycle
+// proposals of "x/perp".
+func NewMarketProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := content.ValidateBasic(); err != nil {
+			return err
+		}
+
+		switch proposal := content.(type) {
+		case *types.CreateMarketProposal:
+			return k.CreateMarket(ctx, keeper.ArgsCreateMarket{
+				Market:          proposal.Market,
+				SqrtDepth:       proposal.SqrtDepth,
+				PriceMultiplier: proposal.PriceMultiplier,
+			})
+		case *types.EditMarketProposal:
+			return k.EditMarket(ctx, proposal.Market)
+		case *types.EditPriceMultiplierProposal:
+			return k.EditPriceMultiplier(ctx, proposal.Pair, proposal.PriceMultiplier)
+		case *types.EditSwapInvariantProposal:
+			return k.EditSwapInvariant(ctx, proposal.Pair, proposal.SwapInvariant)
+		case *types.SettleMarketProposal:
+			return k.SettleMarket(ctx, proposal.Pair)
+		case *types.SetCollateralAssetProposal:
+			return k.SetCollateralAsset(ctx, proposal.CollateralAsset)
+		default:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", types.ModuleName, proposal)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package perp_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	perp "github.com/NibiruChain/nibiru/x/perp/module/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestMarketProposalHandler(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	handler := perp.NewMarketProposalHandler(app.PerpKeeperV2)
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	t.Log("create the market")
+	require.NoError(t, handler(ctx, &types.CreateMarketProposal{
+		Title:           "create market",
+		Description:     "list BTC:NUSD",
+		Market:          *mock.TestMarket(),
+		SqrtDepth:       sdk.NewDec(1e6),
+		PriceMultiplier: sdk.OneDec(),
+	}))
+
+	t.Log("edit the market")
+	market := mock.TestMarket()
+	market.MaxLeverage = sdk.NewDec(5)
+	market.RepegDivergenceThreshold = sdk.MustNewDecFromStr("0.05")
+	market.RepegBudgetPerEpoch = sdk.NewInt(1000)
+	require.NoError(t, handler(ctx, &types.EditMarketProposal{
+		Title:       "edit market",
+		Description: "lower max leverage of BTC:NUSD",
+		Market:      *market,
+	}))
+	gotMarket, err := app.PerpKeeperV2.Markets.Get(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5), gotMarket.MaxLeverage)
+	require.Equal(t, sdk.MustNewDecFromStr("0.05"), gotMarket.RepegDivergenceThreshold)
+	require.Equal(t, sdk.NewInt(1000), gotMarket.RepegBudgetPerEpoch)
+
+	t.Log("repeg the amm")
+	require.NoError(t, handler(ctx, &types.EditPriceMultiplierProposal{
+		Title:           "repeg",
+		Description:     "repeg BTC:NUSD",
+		Pair:            pair,
+		PriceMultiplier: sdk.NewDec(2),
+	}))
+
+	t.Log("change the depth of the amm")
+	require.NoError(t, handler(ctx, &types.EditSwapInvariantProposal{
+		Title:         "depth",
+		Description:   "deepen BTC:NUSD",
+		Pair:          pair,
+		SwapInvariant: sdk.NewDec(1e14),
+	}))
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), amm.PriceMultiplier)
+	require.Equal(t, sdk.NewDec(1e7), amm.SqrtDepth)
+
+	t.Log("settle the market")
+	require.NoError(t, handler(ctx, &types.SettleMarketProposal{
+		Title:       "settle",
+		Description: "settle BTC:NUSD",
+		Pair:        pair,
+	}))
+	gotMarket, err = app.PerpKeeperV2.Markets.Get(ctx, pair)
+	require.NoError(t, err)
+	require.True(t, gotMarket.Settled)
+	require.Equal(t, sdk.NewDec(2), gotMarket.SettlementPrice)
+
+	t.Log("unknown proposals are rejected")
+	require.Error(t, handler(ctx, govtypes.NewTextProposal("text", "text")))
+
+	t.Log("invalid proposals are rejected")
+	require.Error(t, handler(ctx, &types.EditPriceMultiplierProposal{
+		Title:           "repeg",
+		Description:     "repeg BTC:NUSD",
+		Pair:            pair,
+		PriceMultiplier: sdk.ZeroDec(),
+	}))
+}
@@ -0,0 +1,93 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// RandomizedGenState generates a random GenesisState for the perp v2 module,
+// with a btc and an eth market of random depth, price and margin ratios.
+func RandomizedGenState(simState *module.SimulationState) {
+	perpGenesis := types.DefaultGenesis()
+
+	for _, pair := range []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+	} {
+		market := RandomMarket(simState.Rand, pair)
+		amm := RandomAMM(simState.Rand, pair)
+
+		perpGenesis.Markets = append(perpGenesis.Markets, market)
+		perpGenesis.Amms = append(perpGenesis.Amms, amm)
+		perpGenesis.ReserveSnapshots = append(perpGenesis.ReserveSnapshots, types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: simState.GenTimestamp.UnixMilli(),
+		})
+	}
+
+	perpGenesisBytes, err := json.MarshalIndent(perpGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Generated perp v2 genesis:\n%s\n", perpGenesisBytes)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(perpGenesis)
+}
+
+// RandomMarket returns an enabled market with a random maintenance margin
+// ratio between 2% and 10%, and a random max leverage the maintenance margin
+// ratio allows.
+func RandomMarket(r *rand.Rand, pair asset.Pair) types.Market {
+	maintenanceMarginRatio := sdk.NewDecWithPrec(int64(2+r.Intn(9)), 2)
+	maxLeverage := sdk.NewDec(int64(2 + r.Intn(int(sdk.OneDec().Quo(maintenanceMarginRatio).TruncateInt64())-1)))
+
+	return types.Market{
+		Pair:                            pair,
+		Enabled:                         true,
+		PriceFluctuationLimitRatio:      sdk.MustNewDecFromStr("0.1"),
+		MaintenanceMarginRatio:          maintenanceMarginRatio,
+		MaxLeverage:                     maxLeverage,
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.001"),
+		EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.001"),
+		LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.0005"),
+		PartialLiquidationRatio:         sdk.MustNewDecFromStr("0.5"),
+		FundingRateEpochId:              epochstypes.ThirtyMinuteEpochID,
+		TwapLookbackWindow:              30 * time.Minute,
+		PrepaidBadDebt:                  sdk.NewInt64Coin(pair.QuoteDenom(), 0),
+		SettlementPrice:                 sdk.ZeroDec(),
+		RepegDivergenceThreshold:        sdk.ZeroDec(),
+		RepegBudgetPerEpoch:             sdk.ZeroInt(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxTraderNotional:               sdk.ZeroDec(),
+		MaxBias:                         sdk.ZeroDec(),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		FundingInterestRate:             sdk.ZeroDec(),
+		FundingDampener:                 sdk.ZeroDec(),
+	}
+}
+
+// RandomAMM returns a balanced AMM with a random sqrt depth between 1e11 and
+// 1e13, and a random price multiplier between 1 and 50,000.
+func RandomAMM(r *rand.Rand, pair asset.Pair) types.AMM {
+	sqrtDepth := sdk.NewDec(1e11).MulInt64(int64(1 + r.Intn(100)))
+
+	return types.AMM{
+		Pair:            pair,
+		BaseReserve:     sqrtDepth,
+		QuoteReserve:    sqrtDepth,
+		SqrtDepth:       sqrtDepth,
+		PriceMultiplier: sdk.NewDec(int64(1 + r.Intn(50_000))),
+		TotalLong:       sdk.ZeroDec(),
+		TotalShort:      sdk.ZeroDec(),
+	}
+}
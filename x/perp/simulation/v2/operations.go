@@ -0,0 +1,368 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/NibiruChain/collections"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v1types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+const (
+	defaultWeight = 100
+
+	// TypeMsgMultiLiquidate is the message type of MsgMultiLiquidate, which
+	// isn't a legacy amino message.
+	TypeMsgMultiLiquidate = "multi_liquidate_msg"
+	// TypeEditPriceMultiplier is the operation type of a repeg.
+	TypeEditPriceMultiplier = "edit_price_multiplier"
+
+	// maxLiquidationsPerMsg caps the liquidations of a MsgMultiLiquidate.
+	maxLiquidationsPerMsg = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simulation.WeightedOperations {
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			defaultWeight,
+			SimulateMsgOpenPosition(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			33,
+			SimulateMsgClosePosition(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			50,
+			SimulateMsgAddMargin(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			50,
+			SimulateMsgRemoveMargin(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			50,
+			SimulateMsgMultiLiquidate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			20,
+			SimulateEditPriceMultiplier(k),
+		),
+	}
+}
+
+// SimulateMsgOpenPosition generates a MsgOpenPosition with random values on
+// a random market.
+func SimulateMsgOpenPosition(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		if err := fundAccountWithTokens(ctx, simAccount.Address, bk); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgOpenPosition{}.Type(), "unable to fund account"), nil, err
+		}
+
+		markets := k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+		if len(markets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgOpenPosition{}.Type(), "no market"), nil, nil
+		}
+		market := markets[r.Intn(len(markets))]
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(market.Pair.QuoteDenom())
+		quoteAmt, err := simtypes.RandPositiveInt(r, sdk.MinInt(spendable, sdk.NewInt(1e6)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgOpenPosition{}.Type(), "no quote to spend"), nil, nil
+		}
+		leverage := simtypes.RandomDecAmount(r, market.MaxLeverage.Sub(sdk.OneDec())).Add(sdk.OneDec()) // between [1, MaxLeverage]
+		side := types.Direction_LONG
+		if r.Float32() < .5 {
+			side = types.Direction_SHORT
+		}
+
+		msg := &types.MsgOpenPosition{
+			Sender:               simAccount.Address.String(),
+			Pair:                 market.Pair,
+			Side:                 side,
+			QuoteAssetAmount:     quoteAmt,
+			Leverage:             leverage,
+			BaseAssetAmountLimit: sdk.ZeroInt(),
+		}
+
+		spent, err := precheck(ctx, bk, simAccount.Address, func(ctx sdk.Context) error {
+			_, err := k.OpenPosition(ctx, msg.Pair, msg.Side, simAccount.Address, msg.QuoteAssetAmount, msg.Leverage, sdk.ZeroDec())
+			return err
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), spent)
+	}
+}
+
+// SimulateMsgClosePosition generates a MsgClosePosition closing a random
+// position.
+func SimulateMsgClosePosition(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		position, simAccount, found := randomPosition(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgClosePosition{}.Type(), "no position opened yet"), nil, nil
+		}
+
+		msg := &types.MsgClosePosition{
+			Sender: simAccount.Address.String(),
+			Pair:   position.Pair,
+		}
+
+		spent, err := precheck(ctx, bk, simAccount.Address, func(ctx sdk.Context) error {
+			_, err := k.ClosePosition(ctx, msg.Pair, simAccount.Address)
+			return err
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), spent)
+	}
+}
+
+// SimulateMsgAddMargin generates a MsgAddMargin adding a random amount of
+// margin to a random position.
+func SimulateMsgAddMargin(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		position, simAccount, found := randomPosition(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgAddMargin{}.Type(), "no position opened yet"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(position.Pair.QuoteDenom())
+		amount, err := simtypes.RandPositiveInt(r, sdk.MinInt(spendable, sdk.NewInt(1e5)))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgAddMargin{}.Type(), "no quote to spend"), nil, nil
+		}
+
+		msg := &types.MsgAddMargin{
+			Sender: simAccount.Address.String(),
+			Pair:   position.Pair,
+			Margin: sdk.NewCoin(position.Pair.QuoteDenom(), amount),
+		}
+
+		spent, err := precheck(ctx, bk, simAccount.Address, func(ctx sdk.Context) error {
+			_, err := k.AddMargin(ctx, msg.Pair, simAccount.Address, msg.Margin)
+			return err
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), spent)
+	}
+}
+
+// SimulateMsgRemoveMargin generates a MsgRemoveMargin removing a random
+// amount of margin from a random position, up to a tenth of its margin so that
+// it mostly stays above the initial margin ratio.
+func SimulateMsgRemoveMargin(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		position, simAccount, found := randomPosition(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgRemoveMargin{}.Type(), "no position opened yet"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, position.Margin.QuoInt64(10).TruncateInt())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.MsgRemoveMargin{}.Type(), "no margin to remove"), nil, nil
+		}
+
+		msg := &types.MsgRemoveMargin{
+			Sender: simAccount.Address.String(),
+			Pair:   position.Pair,
+			Margin: sdk.NewCoin(position.Pair.QuoteDenom(), amount),
+		}
+
+		spent, err := precheck(ctx, bk, simAccount.Address, func(ctx sdk.Context) error {
+			_, err := k.RemoveMargin(ctx, msg.Pair, simAccount.Address, msg.Margin)
+			return err
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, msg.Type(), spent)
+	}
+}
+
+// SimulateMsgMultiLiquidate generates a MsgMultiLiquidate from a random
+// account for the liquidatable positions of a random market.
+func SimulateMsgMultiLiquidate(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		markets := k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+		if len(markets) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMultiLiquidate, "no market"), nil, nil
+		}
+		pair := markets[r.Intn(len(markets))].Pair
+
+		// only the positions the keeper liquidates go in the msg
+		var liquidations []*types.MsgMultiLiquidate_Liquidation
+		positions := k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(pair)).Values()
+		for _, position := range positions {
+			if len(liquidations) == maxLiquidationsPerMsg {
+				break
+			}
+			liquidation := &types.MsgMultiLiquidate_Liquidation{
+				Pair:   position.Pair,
+				Trader: position.TraderAddress,
+			}
+			cachedCtx, _ := ctx.CacheContext()
+			resps, err := k.MultiLiquidate(cachedCtx, simAccount.Address, []*types.MsgMultiLiquidate_Liquidation{liquidation})
+			if err == nil && resps[0].Success {
+				liquidations = append(liquidations, liquidation)
+			}
+		}
+		if len(liquidations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMultiLiquidate, "no liquidatable position"), nil, nil
+		}
+
+		msg := &types.MsgMultiLiquidate{
+			Sender:       simAccount.Address.String(),
+			Liquidations: liquidations,
+		}
+
+		spent, err := precheck(ctx, bk, simAccount.Address, func(ctx sdk.Context) error {
+			_, err := k.MultiLiquidate(ctx, simAccount.Address, msg.Liquidations)
+			return err
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMultiLiquidate, err.Error()), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, simAccount, msg, TypeMsgMultiLiquidate, spent)
+	}
+}
+
+// SimulateEditPriceMultiplier repegs a random market, moving its price
+// multiplier by up to 5% either way. Repegs are governance actions, so the
+// keeper is called directly rather than through a tx.
+func SimulateEditPriceMultiplier(k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		amms := k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+		if len(amms) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeEditPriceMultiplier, "no market"), nil, nil
+		}
+		amm := amms[r.Intn(len(amms))]
+
+		// between [0.95, 1.05]
+		factor := sdk.NewDecWithPrec(int64(95+r.Intn(11)), 2)
+		newPriceMultiplier := amm.PriceMultiplier.Mul(factor)
+
+		cachedCtx, commit := ctx.CacheContext()
+		if err := k.EditPriceMultiplier(cachedCtx, amm.Pair, newPriceMultiplier); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeEditPriceMultiplier, err.Error()), nil, nil
+		}
+		commit()
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+
+		return simtypes.NewOperationMsgBasic(types.ModuleName, TypeEditPriceMultiplier, "", true, nil), nil, nil
+	}
+}
+
+// randomPosition returns a random open position held by one of the simulated
+// accounts along with that account, if any.
+func randomPosition(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (position types.Position, simAccount simtypes.Account, found bool) {
+	positions := k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
+	r.Shuffle(len(positions), func(i, j int) { positions[i], positions[j] = positions[j], positions[i] })
+	for _, position := range positions {
+		trader, err := sdk.AccAddressFromBech32(position.TraderAddress)
+		if err != nil {
+			continue
+		}
+		if simAccount, found = simtypes.FindAccount(accs, trader); found {
+			return position, simAccount, true
+		}
+	}
+	return types.Position{}, simtypes.Account{}, false
+}
+
+// precheck runs f on a cached context and discards its writes, so that the
+// operations only deliver the txs the keeper accepts: a failed tx fails the
+// simulation. It returns the coins f spends from the account, which the tx
+// fees must leave.
+func precheck(ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress, f func(ctx sdk.Context) error) (spent sdk.Coins, err error) {
+	cachedCtx, _ := ctx.CacheContext()
+	before := bk.SpendableCoins(cachedCtx, addr)
+	if err = f(cachedCtx); err != nil {
+		return nil, err
+	}
+	after := bk.SpendableCoins(cachedCtx, addr)
+
+	spent = sdk.NewCoins()
+	for _, coin := range before {
+		if remaining := after.AmountOf(coin.Denom); remaining.LT(coin.Amount) {
+			spent = spent.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(remaining)))
+		}
+	}
+	return spent, nil
+}
+
+// deliver signs and delivers a tx with the msg from the account, with random
+// fees out of what the msg doesn't spend.
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper,
+	simAccount simtypes.Account, msg sdk.Msg, msgType string, spentCoins sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(
+		simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simapp.MakeTestEncodingConfig().TxConfig,
+			Cdc:             codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			Msg:             msg,
+			MsgType:         msgType,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spentCoins,
+		},
+	)
+}
+
+// fundAccountWithTokens mints unusd to the receiver. The perp v1 module
+// account is the unusd minter of the perp modules.
+func fundAccountWithTokens(ctx sdk.Context, receiver sdk.AccAddress, bk types.BankKeeper) error {
+	newCoins := sdk.NewCoins(
+		sdk.NewCoin(denoms.NUSD, sdk.NewInt(1e6)),
+	)
+
+	if err := bk.MintCoins(ctx, v1types.ModuleName, newCoins); err != nil {
+		return err
+	}
+
+	return bk.SendCoinsFromModuleToAccount(ctx, v1types.ModuleName, receiver, newCoins)
+}
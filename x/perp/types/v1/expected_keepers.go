@@ -30,6 +30,7 @@ type AccountKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(
 		ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string,
 		amt sdk.Coins,
@@ -42,6 +43,7 @@ type BankKeeper interface {
 	) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
 type OracleKeeper interface {
@@ -102,6 +104,7 @@ type PerpAmmKeeper interface {
 	ExistsPool(ctx sdk.Context, pair asset.Pair) bool
 	GetSettlementPrice(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	GetLastSnapshot(ctx sdk.Context, pool perpammtypes.Market) (perpammtypes.ReserveSnapshot, error)
+	PruneReserveSnapshots(ctx sdk.Context, retention time.Duration, limit uint64) (pruned uint64)
 
 	EditPoolPegMultiplier(ctx sdk.Context, pair asset.Pair, pegMultiplier sdk.Dec) error
 	EditSwapInvariant(
@@ -10,9 +10,31 @@ import (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// KeyTwapLookbackWindow is the param key of Params.TwapLookbackWindow.
+var KeyTwapLookbackWindow = []byte("TwapLookbackWindow")
+
+// The pruning of the perp amm reserve snapshots is configured by params kept
+// out of Params, so that the params of chains started without them still load.
+var (
+	// KeySnapshotRetentionLookbacks is the number of twap lookback windows a
+	// perp amm reserve snapshot is kept for. Zero disables the pruning.
+	KeySnapshotRetentionLookbacks = []byte("SnapshotRetentionLookbacks")
+	// KeyMaxSnapshotsPrunedPerBlock is the maximum number of perp amm reserve
+	// snapshots the EndBlocker prunes in a block, across all pools.
+	KeyMaxSnapshotsPrunedPerBlock = []byte("MaxSnapshotsPrunedPerBlock")
+)
+
+const (
+	DefaultSnapshotRetentionLookbacks uint64 = 4
+	DefaultMaxSnapshotsPrunedPerBlock uint64 = 1000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable(
+		paramtypes.NewParamSetPair(KeySnapshotRetentionLookbacks, uint64(0), validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxSnapshotsPrunedPerBlock, uint64(0), validateUint64),
+	).RegisterParamSet(&Params{})
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -49,7 +71,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			validateFundingRateInterval,
 		),
 		paramtypes.NewParamSetPair(
-			[]byte("TwapLookbackWindow"),
+			KeyTwapLookbackWindow,
 			&p.TwapLookbackWindow,
 			validateTwapLookbackWindow,
 		),
@@ -187,3 +209,10 @@ func validateAddress(i interface{}) error {
 	}
 	return nil
 }
+
+func validateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
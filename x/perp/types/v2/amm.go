@@ -336,6 +336,26 @@ func (amm AMM) GetMarketValue() (sdk.Dec, error) {
 	return amm.FromQuoteReserveToAsset(marketValueInReserves), nil
 }
 
+/*
+ComputeSettlementPrice returns the price at which all positions of the market
+are settled: the average price at which the bias would be closed against the
+reserves, or the mark price if longs and shorts offset each other.
+*/
+func (amm AMM) ComputeSettlementPrice() (sdk.Dec, error) {
+	bias := amm.Bias()
+
+	if bias.IsZero() {
+		return amm.MarkPrice(), nil
+	}
+
+	marketValue, err := amm.GetMarketValue()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return marketValue.Quo(bias), nil
+}
+
 /*
 CalcUpdateSwapInvariantCost returns the cost of updating the invariant of the pool
 */
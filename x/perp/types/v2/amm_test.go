@@ -655,3 +655,58 @@ func TestGetMarketValue(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeSettlementPrice(t *testing.T) {
+	tests := []struct {
+		name                    string
+		amm                     v2.AMM
+		expectedSettlementPrice sdk.Dec
+	}{
+		{
+			name: "no bias settles at the mark price",
+			amm: v2.AMM{
+				BaseReserve:     sdk.NewDec(1e6),
+				QuoteReserve:    sdk.NewDec(1e6),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.NewDec(2),
+				TotalLong:       sdk.NewDec(1e5),
+				TotalShort:      sdk.NewDec(1e5),
+			},
+			expectedSettlementPrice: sdk.NewDec(2),
+		},
+		{
+			name: "net long",
+			amm: v2.AMM{
+				BaseReserve:     sdk.NewDec(1e6),
+				QuoteReserve:    sdk.NewDec(1e6),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+				TotalLong:       sdk.NewDec(2e5),
+				TotalShort:      sdk.NewDec(1e5),
+			},
+			expectedSettlementPrice: sdk.MustNewDecFromStr("0.909090909090909091"),
+		},
+		{
+			name: "net short",
+			amm: v2.AMM{
+				BaseReserve:     sdk.NewDec(1e6),
+				QuoteReserve:    sdk.NewDec(1e6),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+				TotalLong:       sdk.NewDec(1e5),
+				TotalShort:      sdk.NewDec(2e5),
+			},
+			expectedSettlementPrice: sdk.MustNewDecFromStr("1.111111111111111111"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			settlementPrice, err := tc.amm.ComputeSettlementPrice()
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedSettlementPrice, settlementPrice)
+		})
+	}
+}
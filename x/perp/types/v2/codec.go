@@ -5,6 +5,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
@@ -12,8 +13,22 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveMargin{}, "perpv2/remove_margin", nil)
 	cdc.RegisterConcrete(&MsgOpenPosition{}, "perpv2/open_position", nil)
 	cdc.RegisterConcrete(&MsgClosePosition{}, "perpv2/close_position", nil)
+	cdc.RegisterConcrete(&MsgPartialClose{}, "perpv2/partial_close", nil)
 	cdc.RegisterConcrete(&MsgDonateToEcosystemFund{}, "perpv2/donate_to_ef", nil)
 	cdc.RegisterConcrete(&MsgMultiLiquidate{}, "perpv2/multi_liquidate", nil)
+	cdc.RegisterConcrete(&MsgSettlePosition{}, "perpv2/settle_position", nil)
+	cdc.RegisterConcrete(&MsgPlaceOrder{}, "perpv2/place_order", nil)
+	cdc.RegisterConcrete(&MsgCancelOrder{}, "perpv2/cancel_order", nil)
+	cdc.RegisterConcrete(&MsgReplaceOrder{}, "perpv2/replace_order", nil)
+	cdc.RegisterConcrete(&MsgEnableCrossMargin{}, "perpv2/enable_cross_margin", nil)
+	cdc.RegisterConcrete(&MsgDisableCrossMargin{}, "perpv2/disable_cross_margin", nil)
+	cdc.RegisterConcrete(&MsgAddCrossMarginCollateral{}, "perpv2/add_cross_margin_collateral", nil)
+	cdc.RegisterConcrete(&MsgRemoveCrossMarginCollateral{}, "perpv2/remove_cross_margin_collateral", nil)
+	cdc.RegisterConcrete(&MsgDepositInsuranceFund{}, "perpv2/deposit_insurance_fund", nil)
+	cdc.RegisterConcrete(&MsgWithdrawInsuranceFund{}, "perpv2/withdraw_insurance_fund", nil)
+	cdc.RegisterConcrete(&MsgRegisterReferralCode{}, "perpv2/register_referral_code", nil)
+	cdc.RegisterConcrete(&MsgSetReferrer{}, "perpv2/set_referrer", nil)
+	cdc.RegisterConcrete(&MsgClaimReferralEarnings{}, "perpv2/claim_referral_earnings", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,9 +39,31 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddMargin{},
 		&MsgOpenPosition{},
 		&MsgClosePosition{},
+		&MsgPartialClose{},
 		&MsgMultiLiquidate{},
+		&MsgDonateToEcosystemFund{},
+		&MsgSettlePosition{},
+		&MsgPlaceOrder{},
+		&MsgCancelOrder{},
+		&MsgReplaceOrder{},
+		&MsgEnableCrossMargin{},
+		&MsgDisableCrossMargin{},
+		&MsgAddCrossMarginCollateral{},
+		&MsgRemoveCrossMarginCollateral{},
+		&MsgDepositInsuranceFund{},
+		&MsgWithdrawInsuranceFund{},
+		&MsgRegisterReferralCode{},
+		&MsgSetReferrer{},
+		&MsgClaimReferralEarnings{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil), &CreateMarketProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &EditMarketProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &EditPriceMultiplierProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &EditSwapInvariantProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &SettleMarketProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &SetCollateralAssetProposal{})
+
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
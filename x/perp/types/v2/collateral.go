@@ -0,0 +1,36 @@
+package v2
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuoteDenom returns the quote denom of the positions the asset backs.
+func (m CollateralAsset) QuoteDenom() string {
+	return m.OraclePair.QuoteDenom()
+}
+
+func (m *CollateralAsset) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if err := m.OraclePair.Validate(); err != nil {
+		return err
+	}
+
+	if m.OraclePair.BaseDenom() != m.Denom {
+		return fmt.Errorf("oracle pair %s doesn't price %s", m.OraclePair, m.Denom)
+	}
+
+	if m.Haircut.IsNil() || m.Haircut.IsNegative() || m.Haircut.GTE(sdk.OneDec()) {
+		return fmt.Errorf("haircut must be in [0, 1), got %s", m.Haircut)
+	}
+
+	if m.SpotPoolId == 0 {
+		return fmt.Errorf("spot pool id must be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (m *CrossMarginAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	return m.Collateral.Validate()
+}
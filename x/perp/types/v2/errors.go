@@ -34,4 +34,21 @@ var (
 	ErrNonPositivePegMultiplier           = sdkerrors.Register(ModuleName, 26, "peg multiplier must be > 0")
 	ErrNonPositiveSwapInvariantMutliplier = sdkerrors.Register(ModuleName, 27, "swap multiplier must be > 0")
 	ErrNilSwapInvariantMutliplier         = sdkerrors.Register(ModuleName, 28, "swap multiplier must be not nil")
+	ErrMarketSettled                      = sdkerrors.Register(ModuleName, 29, "market is settled, you can only settle your position")
+	ErrMarketNotSettled                   = sdkerrors.Register(ModuleName, 30, "market is not settled")
+	ErrOrderNotFound                      = sdkerrors.Register(ModuleName, 31, "order not found")
+	ErrCrossMarginNotEnabled              = sdkerrors.Register(ModuleName, 32, "cross margin is not enabled for the trader")
+	ErrCrossMarginAlreadyEnabled          = sdkerrors.Register(ModuleName, 33, "cross margin is already enabled for the trader")
+	ErrReduceOnly                         = sdkerrors.Register(ModuleName, 34, "reduce only position change would open, increase or flip the position")
+	ErrInsuranceFundDepleted              = sdkerrors.Register(ModuleName, 35, "insurance fund is depleted")
+	ErrOpenInterestCapExceeded            = sdkerrors.Register(ModuleName, 36, "position change would exceed the open interest cap of the market")
+	ErrTraderNotionalCapExceeded          = sdkerrors.Register(ModuleName, 37, "position change would exceed the maximum open notional of a trader")
+	ErrMaxBiasExceeded                    = sdkerrors.Register(ModuleName, 38, "position change would exceed the maximum bias of the market")
+	ErrReferralCodeNotFound               = sdkerrors.Register(ModuleName, 39, "referral code not found")
+	ErrReferralCodeTaken                  = sdkerrors.Register(ModuleName, 40, "referral code is already registered")
+	ErrReferrerAlreadySet                 = sdkerrors.Register(ModuleName, 41, "trader is already bound to a referral code")
+	ErrNoReferralEarnings                 = sdkerrors.Register(ModuleName, 42, "no referral earnings to claim")
+	ErrSelfReferral                       = sdkerrors.Register(ModuleName, 43, "a trader can't use their own referral code")
+	ErrCollateralNotAccepted              = sdkerrors.Register(ModuleName, 44, "denom is not accepted as cross-margin collateral")
+	ErrOrderMarketable                    = sdkerrors.Register(ModuleName, 45, "order would be executed right away")
 )
@@ -10,17 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -106,6 +109,12 @@ type PositionChangedEvent struct {
 	BlockHeight int64 `protobuf:"varint,13,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	// The block time in unix milliseconds at which this position was changed.
 	BlockTimeMs int64 `protobuf:"varint,14,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+	// The fee tier applied to the exchange fee, 0 if the trader paid the
+	// exchange fee ratio of the market.
+	FeeTier uint64 `protobuf:"varint,15,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty"`
+	// The rebate paid to the trader by the exchange fee pool for executing a
+	// maker order.
+	MakerRebate types.Coin `protobuf:"bytes,16,opt,name=maker_rebate,json=makerRebate,proto3" json:"maker_rebate"`
 }
 
 func (m *PositionChangedEvent) Reset()         { *m = PositionChangedEvent{} }
@@ -183,6 +192,20 @@ func (m *PositionChangedEvent) GetBlockTimeMs() int64 {
 	return 0
 }
 
+func (m *PositionChangedEvent) GetFeeTier() uint64 {
+	if m != nil {
+		return m.FeeTier
+	}
+	return 0
+}
+
+func (m *PositionChangedEvent) GetMakerRebate() types.Coin {
+	if m != nil {
+		return m.MakerRebate
+	}
+	return types.Coin{}
+}
+
 // Emitted when a position is liquidated.
 type PositionLiquidatedEvent struct {
 	// identifier of the corresponding virtual pool for the position
@@ -317,6 +340,8 @@ type PositionSettledEvent struct {
 	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
 	// Settled coin as dictated by the settlement price of the perp.amm.
 	SettledCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=settled_coins,json=settledCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_coins" yaml:"settled_coins"`
+	// Bad debt realized by settling the position, in quote assets.
+	BadDebt types.Coin `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
 }
 
 func (m *PositionSettledEvent) Reset()         { *m = PositionSettledEvent{} }
@@ -366,6 +391,13 @@ func (m *PositionSettledEvent) GetSettledCoins() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
+func (m *PositionSettledEvent) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
 // Emitted when a new funding rate is calculated.
 type FundingRateChangedEvent struct {
 	// The pair for which the funding rate was calculated.
@@ -503,773 +535,5982 @@ func (m *LiquidationFailedEvent) GetReason() LiquidationFailedEvent_LiquidationF
 	return LiquidationFailedEvent_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
-	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
-	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v2.PositionLiquidatedEvent")
-	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v2.PositionSettledEvent")
-	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v2.FundingRateChangedEvent")
-	proto.RegisterType((*LiquidationFailedEvent)(nil), "nibiru.perp.v2.LiquidationFailedEvent")
+// Emitted when a market is created or its parameters are edited.
+type MarketUpdatedEvent struct {
+	// the final state of the market
+	FinalMarket Market `protobuf:"bytes,1,opt,name=final_market,json=finalMarket,proto3" json:"final_market"`
 }
 
-func init() { proto.RegisterFile("perp/v2/event.proto", fileDescriptor_e18a1bd6d2374200) }
-
-var fileDescriptor_e18a1bd6d2374200 = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4d, 0x4f, 0x23, 0x37,
-	0x18, 0xc7, 0x09, 0xb0, 0xbc, 0x38, 0x24, 0x04, 0x6f, 0x80, 0xd9, 0xed, 0x2a, 0xd0, 0xa8, 0xad,
-	0xb8, 0x30, 0x23, 0xe8, 0xa9, 0x7b, 0xa8, 0xc4, 0x4b, 0x28, 0x91, 0x20, 0x64, 0x27, 0xd9, 0xbe,
-	0xaa, 0x9d, 0x7a, 0x66, 0x9c, 0x60, 0x31, 0x63, 0xcf, 0x8e, 0x3d, 0x11, 0xf0, 0x05, 0xda, 0x4b,
-	0xa5, 0xde, 0xfa, 0x1d, 0x7a, 0xed, 0x97, 0xd8, 0xe3, 0x1e, 0xab, 0x1e, 0x68, 0x05, 0xa7, 0x5e,
-	0xfb, 0x09, 0xaa, 0xf1, 0x38, 0x6f, 0xa4, 0x5d, 0x60, 0x76, 0xd1, 0x9e, 0x92, 0x79, 0x6c, 0xff,
-	0x1e, 0x3f, 0x8f, 0x9e, 0xe7, 0x6f, 0x1b, 0x3c, 0x0c, 0x70, 0x18, 0x18, 0x9d, 0x4d, 0x03, 0x77,
-	0x30, 0x15, 0x7a, 0x10, 0x32, 0xc1, 0x60, 0x9e, 0x12, 0x9b, 0x84, 0x91, 0x1e, 0x8f, 0xe9, 0x9d,
-	0xcd, 0xc7, 0xc5, 0x36, 0x6b, 0x33, 0x39, 0x64, 0xc4, 0xff, 0x92, 0x59, 0x8f, 0x9f, 0xb4, 0x19,
-	0x6b, 0x7b, 0xd8, 0x40, 0x01, 0x31, 0x10, 0xa5, 0x4c, 0x20, 0x41, 0x18, 0xe5, 0x6a, 0xb4, 0xe4,
-	0x30, 0xee, 0x33, 0x6e, 0xd8, 0x88, 0x63, 0xa3, 0xb3, 0x61, 0x63, 0x81, 0x36, 0x0c, 0x87, 0x11,
-	0xaa, 0xc6, 0x7b, 0x8e, 0xb9, 0x40, 0x02, 0x2b, 0xe3, 0x8a, 0x42, 0xca, 0x2f, 0x3b, 0x6a, 0x19,
-	0x82, 0xf8, 0x98, 0x0b, 0xe4, 0x07, 0xc9, 0x84, 0xf2, 0x6f, 0x33, 0xa0, 0x58, 0x67, 0x9c, 0xc4,
-	0x9e, 0x76, 0x8e, 0x11, 0x6d, 0x63, 0xb7, 0x12, 0x6f, 0x1c, 0x1e, 0x82, 0xc9, 0x00, 0x91, 0x50,
-	0xcb, 0xac, 0x66, 0xd6, 0x66, 0xb7, 0x3f, 0x79, 0x79, 0xb1, 0x32, 0xf6, 0xc7, 0xc5, 0xca, 0x46,
-	0x9b, 0x88, 0xe3, 0xc8, 0xd6, 0x1d, 0xe6, 0x1b, 0x35, 0x19, 0xd3, 0xce, 0x31, 0x22, 0xd4, 0x48,
-	0xe2, 0x33, 0x4e, 0x0d, 0x87, 0xf9, 0x3e, 0xa3, 0x06, 0xe2, 0x1c, 0x0b, 0xbd, 0x8e, 0x48, 0x68,
-	0x4a, 0x0c, 0xfc, 0x10, 0xe4, 0x45, 0x88, 0x5c, 0x1c, 0x5a, 0xc8, 0x75, 0x43, 0xcc, 0xb9, 0x36,
-	0x1e, 0x83, 0xcd, 0x5c, 0x62, 0xdd, 0x4a, 0x8c, 0x70, 0x1f, 0x4c, 0xf9, 0x28, 0x6c, 0x13, 0xaa,
-	0x4d, 0xac, 0x66, 0xd6, 0xb2, 0x9b, 0x8f, 0xf4, 0x24, 0x6a, 0x3d, 0x8e, 0x5a, 0x57, 0x51, 0xeb,
-	0x3b, 0x8c, 0xd0, 0xed, 0xc5, 0x78, 0x4b, 0xff, 0x5c, 0xac, 0xe4, 0xce, 0x90, 0xef, 0x3d, 0x2d,
-	0x27, 0xcb, 0xca, 0xa6, 0x5a, 0x0f, 0xbf, 0x01, 0x0b, 0x81, 0x8a, 0xcb, 0xa2, 0x2c, 0xfe, 0x41,
-	0x9e, 0x36, 0x29, 0x83, 0xd1, 0x55, 0x30, 0x1f, 0x0d, 0x04, 0xa3, 0x92, 0x9b, 0xfc, 0xac, 0x73,
-	0xf7, 0xc4, 0x10, 0x67, 0x01, 0xe6, 0xfa, 0x2e, 0x76, 0xcc, 0x42, 0x17, 0x54, 0x53, 0x1c, 0xf8,
-	0x1c, 0xe4, 0xf1, 0xa9, 0x93, 0xa4, 0xcb, 0xe2, 0xe4, 0x1c, 0x6b, 0x0f, 0x52, 0x91, 0x73, 0x3d,
-	0x4a, 0x83, 0x9c, 0x63, 0xf8, 0x2d, 0x80, 0x7d, 0x6c, 0x6f, 0xd3, 0x53, 0xa9, 0xd0, 0x0b, 0x3d,
-	0x52, 0x6f, 0xd7, 0x36, 0x98, 0x17, 0x21, 0xa2, 0x1c, 0x39, 0x32, 0x2b, 0x2d, 0x8c, 0xb5, 0xe9,
-	0x9b, 0xb2, 0x5c, 0x52, 0x59, 0x5e, 0x4a, 0xb2, 0x7c, 0x6d, 0x7d, 0xd9, 0xcc, 0x0f, 0x58, 0xf6,
-	0x30, 0x86, 0x0d, 0x90, 0xeb, 0xa5, 0x5d, 0x26, 0x66, 0x26, 0xd5, 0xee, 0xe7, 0xba, 0x10, 0x99,
-	0x97, 0x67, 0x60, 0x2e, 0xc4, 0xc8, 0x23, 0xe7, 0xd8, 0xb5, 0x02, 0xea, 0x69, 0xb3, 0xa9, 0x98,
-	0xd9, 0x2e, 0xa3, 0x4e, 0x3d, 0xf8, 0x3d, 0x28, 0x46, 0x74, 0x10, 0x6a, 0xa1, 0x96, 0xc0, 0xa1,
-	0x06, 0x52, 0xa1, 0x61, 0x9f, 0x55, 0xa7, 0xde, 0x56, 0x4c, 0x82, 0x4f, 0xc1, 0x8c, 0x8d, 0x5c,
-	0xcb, 0xc5, 0xb6, 0xd0, 0xb2, 0x37, 0xa5, 0x79, 0x32, 0x76, 0x68, 0x4e, 0xdb, 0xc8, 0xdd, 0xc5,
-	0xb6, 0x80, 0x5f, 0x80, 0xf9, 0x56, 0x44, 0x5d, 0x42, 0xdb, 0x56, 0x80, 0xce, 0x7c, 0x4c, 0x85,
-	0x36, 0x97, 0x6a, 0x63, 0x79, 0x85, 0xa9, 0x27, 0x14, 0xf8, 0x3e, 0x98, 0xb3, 0x3d, 0xe6, 0x9c,
-	0x58, 0xc7, 0x98, 0xb4, 0x8f, 0x85, 0x96, 0x5b, 0xcd, 0xac, 0x4d, 0x98, 0x59, 0x69, 0xdb, 0x97,
-	0x26, 0x58, 0x06, 0xb9, 0x64, 0x4a, 0x2c, 0x15, 0x96, 0xcf, 0xb5, 0xfc, 0xc0, 0x9c, 0x26, 0xf1,
-	0xf1, 0x21, 0x2f, 0xff, 0x34, 0x0b, 0x96, 0xbb, 0xaa, 0x71, 0x40, 0x5e, 0x44, 0xc4, 0x45, 0xe2,
-	0xdd, 0x0a, 0x87, 0x0b, 0x96, 0xfa, 0xad, 0xf3, 0x22, 0x62, 0x02, 0x5b, 0xc8, 0x67, 0x11, 0x15,
-	0x52, 0x48, 0xee, 0x9e, 0xb8, 0x62, 0x8f, 0xf6, 0x2c, 0x86, 0x6d, 0x49, 0x16, 0x6c, 0x81, 0xe5,
-	0xbe, 0x97, 0xe1, 0x3a, 0x4f, 0x27, 0x2d, 0x8b, 0x3d, 0x5c, 0x7d, 0xb0, 0xe0, 0xd7, 0x01, 0xf4,
-	0x54, 0x5a, 0x59, 0x3f, 0x70, 0xa9, 0x31, 0xe6, 0x42, 0x7f, 0xa4, 0x1b, 0x7c, 0x1b, 0x2c, 0xb4,
-	0x30, 0xb6, 0x04, 0xb3, 0xfa, 0x63, 0x52, 0x36, 0x5e, 0x5b, 0x73, 0xab, 0xaa, 0xb5, 0xb5, 0xa4,
-	0xb5, 0x47, 0x08, 0x65, 0x73, 0xbe, 0x85, 0x71, 0x93, 0x1d, 0xf4, 0x2c, 0x30, 0x04, 0x8b, 0x6a,
-	0x1a, 0x76, 0x18, 0x3f, 0xe3, 0x02, 0xfb, 0x56, 0x5c, 0x61, 0x37, 0xeb, 0xc8, 0x07, 0xca, 0xd9,
-	0x93, 0x21, 0x67, 0xc3, 0x94, 0xb2, 0x09, 0xa5, 0xc3, 0x4a, 0xd7, 0xba, 0x17, 0x51, 0x77, 0xa8,
-	0x8f, 0x66, 0xee, 0xd8, 0x47, 0xfd, 0xe3, 0x64, 0xf6, 0x3e, 0x8e, 0x13, 0xf0, 0x96, 0x8e, 0x93,
-	0x11, 0xd1, 0xcc, 0xbe, 0x05, 0xd1, 0x6c, 0x82, 0xdc, 0x90, 0x2a, 0xa5, 0x54, 0x90, 0x61, 0x08,
-	0x3c, 0x04, 0xc0, 0x47, 0xe1, 0x89, 0x15, 0x84, 0xc4, 0xc1, 0x52, 0x3e, 0xee, 0x8e, 0x9c, 0x8d,
-	0x09, 0xf5, 0x18, 0x30, 0xa2, 0x47, 0xf9, 0x5b, 0xe8, 0xd1, 0xfc, 0xa8, 0x1e, 0xfd, 0x32, 0xde,
-	0xbf, 0xc5, 0x34, 0xb0, 0x10, 0xde, 0xbb, 0x15, 0xa3, 0x1f, 0x33, 0x20, 0xc7, 0x93, 0x6d, 0x58,
-	0xf1, 0x0d, 0x8d, 0x6b, 0x13, 0xab, 0x13, 0xaf, 0x2f, 0xbf, 0x7d, 0x55, 0x7e, 0xc5, 0xa4, 0xfc,
-	0x86, 0x56, 0x97, 0x7f, 0xfd, 0x73, 0x65, 0xed, 0x16, 0xb9, 0x8d, 0x41, 0xdc, 0x9c, 0x53, 0x6b,
-	0xe5, 0x57, 0xf9, 0x87, 0x07, 0x60, 0x79, 0x2f, 0x39, 0x03, 0x4c, 0x24, 0xf0, 0x7d, 0x5e, 0xf1,
-	0x86, 0x4b, 0x63, 0xfc, 0x4d, 0x4b, 0xe3, 0x08, 0x64, 0x09, 0x75, 0xf1, 0xa9, 0xe2, 0xa5, 0x93,
-	0x71, 0x20, 0x11, 0x09, 0xf0, 0x3b, 0xf0, 0xd0, 0x43, 0x02, 0x73, 0x61, 0x75, 0xcf, 0xd6, 0x10,
-	0x89, 0xb4, 0xc2, 0xbd, 0x90, 0xa0, 0x06, 0x52, 0x1b, 0x1f, 0x0e, 0x8a, 0x1f, 0x84, 0xd8, 0x27,
-	0x91, 0x6f, 0xb5, 0xc2, 0xe4, 0x62, 0x94, 0xf2, 0x76, 0xb8, 0x98, 0xe0, 0xea, 0x09, 0x6d, 0x4f,
-	0xc1, 0x20, 0x05, 0xef, 0x39, 0x91, 0x1f, 0x79, 0x48, 0x90, 0x0e, 0x1e, 0xf5, 0x95, 0xee, 0xba,
-	0xf8, 0xa8, 0x8f, 0xbc, 0xee, 0xef, 0x7a, 0x8f, 0x4e, 0xdf, 0xa2, 0x47, 0x67, 0x46, 0x7b, 0xf4,
-	0xef, 0x71, 0xb0, 0xd4, 0x3d, 0x4a, 0xe2, 0xcb, 0x22, 0x22, 0xf7, 0xd5, 0xa5, 0x4b, 0x60, 0x2a,
-	0xe9, 0x47, 0xd5, 0x9d, 0xea, 0x0b, 0x96, 0x00, 0x18, 0x38, 0x1f, 0x65, 0x41, 0x99, 0x03, 0x16,
-	0xf8, 0x39, 0x98, 0x0a, 0x31, 0xe2, 0x8c, 0xca, 0x9a, 0xc8, 0x6f, 0x7e, 0xaa, 0x0f, 0x3f, 0xdb,
-	0xf4, 0xff, 0xde, 0xfe, 0xa8, 0xd9, 0x94, 0x14, 0x53, 0xd1, 0xca, 0x01, 0x58, 0xfe, 0x9f, 0x29,
-	0x70, 0x1e, 0x64, 0x9f, 0xd7, 0x1a, 0xf5, 0xca, 0x4e, 0x75, 0xaf, 0x5a, 0xd9, 0x2d, 0x8c, 0xc1,
-	0x22, 0x28, 0xd4, 0x8f, 0x1a, 0xd5, 0x66, 0xf5, 0xa8, 0x66, 0xed, 0x57, 0xb6, 0x0e, 0x9a, 0xfb,
-	0x5f, 0x15, 0x32, 0xb1, 0xb5, 0x76, 0x54, 0xab, 0x7c, 0x59, 0x6d, 0x34, 0x2b, 0xb5, 0xa6, 0x55,
-	0xdf, 0xaa, 0x9a, 0x85, 0x71, 0xa8, 0x81, 0xe2, 0x90, 0x55, 0xad, 0x2b, 0x4c, 0x6c, 0x7f, 0xf6,
-	0xf2, 0xb2, 0x94, 0x79, 0x75, 0x59, 0xca, 0xfc, 0x75, 0x59, 0xca, 0xfc, 0x7c, 0x55, 0x1a, 0x7b,
-	0x75, 0x55, 0x1a, 0xfb, 0xfd, 0xaa, 0x34, 0xf6, 0xf5, 0xfa, 0x4d, 0x49, 0x95, 0xaf, 0x48, 0x59,
-	0x17, 0x46, 0x67, 0xd3, 0x9e, 0x92, 0xaf, 0xc4, 0x8f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0c,
-	0xdf, 0xec, 0xab, 0xd6, 0x0e, 0x00, 0x00,
+func (m *MarketUpdatedEvent) Reset()         { *m = MarketUpdatedEvent{} }
+func (m *MarketUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*MarketUpdatedEvent) ProtoMessage()    {}
+func (*MarketUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{5}
+}
+func (m *MarketUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketUpdatedEvent.Merge(m, src)
+}
+func (m *MarketUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketUpdatedEvent.DiscardUnknown(m)
 }
 
-func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketUpdatedEvent proto.InternalMessageInfo
+
+func (m *MarketUpdatedEvent) GetFinalMarket() Market {
+	if m != nil {
+		return m.FinalMarket
 	}
-	return dAtA[:n], nil
+	return Market{}
 }
 
-func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when an AMM is created, repegged or has its depth changed.
+type AmmUpdatedEvent struct {
+	// the final state of the AMM
+	FinalAmm AMM `protobuf:"bytes,1,opt,name=final_amm,json=finalAmm,proto3" json:"final_amm"`
+	// the amount of quote assets paid by the perp ecosystem fund to the vault
+	// for the update. A negative value means the vault paid the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cost"`
 }
 
-func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+func (m *AmmUpdatedEvent) Reset()         { *m = AmmUpdatedEvent{} }
+func (m *AmmUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*AmmUpdatedEvent) ProtoMessage()    {}
+func (*AmmUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{6}
+}
+func (m *AmmUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmmUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmmUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *AmmUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmmUpdatedEvent.Merge(m, src)
+}
+func (m *AmmUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmmUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmmUpdatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmmUpdatedEvent proto.InternalMessageInfo
+
+func (m *AmmUpdatedEvent) GetFinalAmm() AMM {
+	if m != nil {
+		return m.FinalAmm
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+	return AMM{}
+}
+
+// Emitted when a trigger order is placed or replaced.
+type OrderPlacedEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+}
+
+func (m *OrderPlacedEvent) Reset()         { *m = OrderPlacedEvent{} }
+func (m *OrderPlacedEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderPlacedEvent) ProtoMessage()    {}
+func (*OrderPlacedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{7}
+}
+func (m *OrderPlacedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderPlacedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderPlacedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ExchangedNotional.Size()
-		i -= size
-		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.ExchangedSize.Size()
-		i -= size
-		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderPlacedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderPlacedEvent.Merge(m, src)
+}
+func (m *OrderPlacedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderPlacedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderPlacedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderPlacedEvent proto.InternalMessageInfo
+
+func (m *OrderPlacedEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+	return Order{}
+}
+
+// Emitted when a trigger order is cancelled, either by its trader or because
+// it could not be executed.
+type OrderCancelledEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// why the order was cancelled
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *OrderCancelledEvent) Reset()         { *m = OrderCancelledEvent{} }
+func (m *OrderCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderCancelledEvent) ProtoMessage()    {}
+func (*OrderCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{8}
+}
+func (m *OrderCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCancelledEvent.Merge(m, src)
+}
+func (m *OrderCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCancelledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCancelledEvent proto.InternalMessageInfo
+
+func (m *OrderCancelledEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return Order{}
 }
 
-func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OrderCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when a trigger order is executed.
+type OrderExecutedEvent struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// the price that triggered the order
+	TriggerSourcePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=trigger_source_price,json=triggerSourcePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_source_price"`
 }
 
-func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
-	}
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+func (m *OrderExecutedEvent) Reset()         { *m = OrderExecutedEvent{} }
+func (m *OrderExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderExecutedEvent) ProtoMessage()    {}
+func (*OrderExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{9}
+}
+func (m *OrderExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *OrderExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderExecutedEvent.Merge(m, src)
+}
+func (m *OrderExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderExecutedEvent proto.InternalMessageInfo
+
+func (m *OrderExecutedEvent) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+	return Order{}
+}
+
+// Emitted when the collateral of a cross-margin account changes, or when the
+// account is opened.
+type CrossMarginAccountUpdatedEvent struct {
+	Account CrossMarginAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *CrossMarginAccountUpdatedEvent) Reset()         { *m = CrossMarginAccountUpdatedEvent{} }
+func (m *CrossMarginAccountUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginAccountUpdatedEvent) ProtoMessage()    {}
+func (*CrossMarginAccountUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{10}
+}
+func (m *CrossMarginAccountUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginAccountUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginAccountUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.LiquidatorAddress) > 0 {
-		i -= len(m.LiquidatorAddress)
-		copy(dAtA[i:], m.LiquidatorAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedQuoteAmount.Size()
-		i -= size
-		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CrossMarginAccountUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginAccountUpdatedEvent.Merge(m, src)
+}
+func (m *CrossMarginAccountUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginAccountUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginAccountUpdatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossMarginAccountUpdatedEvent proto.InternalMessageInfo
+
+func (m *CrossMarginAccountUpdatedEvent) GetAccount() CrossMarginAccount {
+	if m != nil {
+		return m.Account
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return CrossMarginAccount{}
+}
+
+// Emitted when all the positions of a cross-margin account quoted in a denom
+// are liquidated together.
+type CrossMarginAccountLiquidatedEvent struct {
+	TraderAddress     string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	LiquidatorAddress string `protobuf:"bytes,2,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// the pairs of the liquidated positions
+	Pairs []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
+	// the account equity before the liquidation
+	Equity             types.Coin `protobuf:"bytes,4,opt,name=equity,proto3" json:"equity"`
+	FeeToLiquidator    types.Coin `protobuf:"bytes,5,opt,name=fee_to_liquidator,json=feeToLiquidator,proto3" json:"fee_to_liquidator"`
+	FeeToEcosystemFund types.Coin `protobuf:"bytes,6,opt,name=fee_to_ecosystem_fund,json=feeToEcosystemFund,proto3" json:"fee_to_ecosystem_fund"`
+	BadDebt            types.Coin `protobuf:"bytes,7,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) Reset()         { *m = CrossMarginAccountLiquidatedEvent{} }
+func (m *CrossMarginAccountLiquidatedEvent) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginAccountLiquidatedEvent) ProtoMessage()    {}
+func (*CrossMarginAccountLiquidatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{11}
+}
+func (m *CrossMarginAccountLiquidatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginAccountLiquidatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginAccountLiquidatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CrossMarginAccountLiquidatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginAccountLiquidatedEvent.Merge(m, src)
+}
+func (m *CrossMarginAccountLiquidatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginAccountLiquidatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginAccountLiquidatedEvent.DiscardUnknown(m)
 }
 
-func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CrossMarginAccountLiquidatedEvent proto.InternalMessageInfo
+
+func (m *CrossMarginAccountLiquidatedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CrossMarginAccountLiquidatedEvent) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
+	}
+	return ""
 }
 
-func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettledCoins) > 0 {
-		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *CrossMarginAccountLiquidatedEvent) GetEquity() types.Coin {
+	if m != nil {
+		return m.Equity
 	}
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return types.Coin{}
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) GetFeeToLiquidator() types.Coin {
+	if m != nil {
+		return m.FeeToLiquidator
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) GetFeeToEcosystemFund() types.Coin {
+	if m != nil {
+		return m.FeeToEcosystemFund
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CrossMarginAccountLiquidatedEvent) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when quote tokens are staked in the insurance fund.
+type InsuranceFundDepositedEvent struct {
+	StakerAddress string     `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Shares        types.Coin `protobuf:"bytes,3,opt,name=shares,proto3" json:"shares"`
 }
 
-func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.CumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LatestPremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *InsuranceFundDepositedEvent) Reset()         { *m = InsuranceFundDepositedEvent{} }
+func (m *InsuranceFundDepositedEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundDepositedEvent) ProtoMessage()    {}
+func (*InsuranceFundDepositedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{12}
+}
+func (m *InsuranceFundDepositedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundDepositedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundDepositedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.LatestFundingRate.Size()
-		i -= size
-		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *InsuranceFundDepositedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundDepositedEvent.Merge(m, src)
+}
+func (m *InsuranceFundDepositedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundDepositedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundDepositedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundDepositedEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundDepositedEvent) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.IndexPrice.Size()
-		i -= size
-		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *InsuranceFundDepositedEvent) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *InsuranceFundDepositedEvent) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return types.Coin{}
+}
+
+// Emitted when the withdrawal of insurance fund shares is requested.
+type InsuranceFundWithdrawalRequestedEvent struct {
+	StakerAddress string     `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	Shares        types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+	UnlockTime    time.Time  `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *InsuranceFundWithdrawalRequestedEvent) Reset()         { *m = InsuranceFundWithdrawalRequestedEvent{} }
+func (m *InsuranceFundWithdrawalRequestedEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundWithdrawalRequestedEvent) ProtoMessage()    {}
+func (*InsuranceFundWithdrawalRequestedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{13}
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundWithdrawalRequestedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundWithdrawalRequestedEvent.Merge(m, src)
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundWithdrawalRequestedEvent.DiscardUnknown(m)
 }
 
-func (m *LiquidationFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_InsuranceFundWithdrawalRequestedEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundWithdrawalRequestedEvent) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LiquidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InsuranceFundWithdrawalRequestedEvent) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
+	}
+	return types.Coin{}
 }
 
-func (m *LiquidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Reason != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Liquidator) > 0 {
-		i -= len(m.Liquidator)
-		copy(dAtA[i:], m.Liquidator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Liquidator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
+func (m *InsuranceFundWithdrawalRequestedEvent) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return time.Time{}
+}
+
+// Emitted when insurance fund shares are redeemed at the end of their
+// withdrawal cooldown.
+type InsuranceFundWithdrawnEvent struct {
+	StakerAddress string     `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	Shares        types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
+	Amount        types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *InsuranceFundWithdrawnEvent) Reset()         { *m = InsuranceFundWithdrawnEvent{} }
+func (m *InsuranceFundWithdrawnEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundWithdrawnEvent) ProtoMessage()    {}
+func (*InsuranceFundWithdrawnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{14}
+}
+func (m *InsuranceFundWithdrawnEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundWithdrawnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundWithdrawnEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *InsuranceFundWithdrawnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundWithdrawnEvent.Merge(m, src)
+}
+func (m *InsuranceFundWithdrawnEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundWithdrawnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundWithdrawnEvent.DiscardUnknown(m)
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_InsuranceFundWithdrawnEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundWithdrawnEvent) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *PositionChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TransactionFee.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+
+func (m *InsuranceFundWithdrawnEvent) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	return types.Coin{}
+}
+
+func (m *InsuranceFundWithdrawnEvent) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *PositionLiquidatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+// Emitted when part of a loss the insurance fund and the ecosystem fund
+// couldn't cover is taken from the unrealized PnL of the profitable positions
+// checked by the EndBlocker in a block.
+type LossSocializedEvent struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the pending loss before this block
+	Loss github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=loss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"loss"`
+	// the part of the loss taken from profitable positions, lower than the
+	// loss if their unrealized PnL is not enough. The rest is left pending.
+	SocializedLoss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=socialized_loss,json=socializedLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"socialized_loss"`
+}
+
+func (m *LossSocializedEvent) Reset()         { *m = LossSocializedEvent{} }
+func (m *LossSocializedEvent) String() string { return proto.CompactTextString(m) }
+func (*LossSocializedEvent) ProtoMessage()    {}
+func (*LossSocializedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{15}
+}
+func (m *LossSocializedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LossSocializedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LossSocializedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *LossSocializedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LossSocializedEvent.Merge(m, src)
+}
+func (m *LossSocializedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LossSocializedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LossSocializedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LossSocializedEvent proto.InternalMessageInfo
+
+func (m *LossSocializedEvent) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = m.ExchangedQuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.LiquidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+// Emitted when the AMM of a market is repegged automatically at the end of its
+// funding epoch.
+type AmmRepeggedEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the mark price before the repeg
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+	// the index price TWAP the AMM is repegged to
+	IndexPrice         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=index_price,json=indexPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_price"`
+	OldPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=old_price_multiplier,json=oldPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_price_multiplier"`
+	NewPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=new_price_multiplier,json=newPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_price_multiplier"`
+	// the amount of quote assets paid by the perp ecosystem fund to the vault.
+	// A negative value means the vault paid the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cost"`
+	// true if the repeg was cut short by the epoch budget of the market or the
+	// balance of the ecosystem fund
+	Capped bool `protobuf:"varint,7,opt,name=capped,proto3" json:"capped,omitempty"`
+}
+
+func (m *AmmRepeggedEvent) Reset()         { *m = AmmRepeggedEvent{} }
+func (m *AmmRepeggedEvent) String() string { return proto.CompactTextString(m) }
+func (*AmmRepeggedEvent) ProtoMessage()    {}
+func (*AmmRepeggedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{16}
+}
+func (m *AmmRepeggedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmmRepeggedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmmRepeggedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FeeToEcosystemFund.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+}
+func (m *AmmRepeggedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmmRepeggedEvent.Merge(m, src)
+}
+func (m *AmmRepeggedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmmRepeggedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmmRepeggedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmmRepeggedEvent proto.InternalMessageInfo
+
+func (m *AmmRepeggedEvent) GetCapped() bool {
+	if m != nil {
+		return m.Capped
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	return false
+}
+
+// Emitted when a referral code is registered.
+type ReferralCodeRegisteredEvent struct {
+	Code            string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ReferrerAddress string `protobuf:"bytes,2,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
+}
+
+func (m *ReferralCodeRegisteredEvent) Reset()         { *m = ReferralCodeRegisteredEvent{} }
+func (m *ReferralCodeRegisteredEvent) String() string { return proto.CompactTextString(m) }
+func (*ReferralCodeRegisteredEvent) ProtoMessage()    {}
+func (*ReferralCodeRegisteredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{17}
+}
+func (m *ReferralCodeRegisteredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralCodeRegisteredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralCodeRegisteredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ReferralCodeRegisteredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralCodeRegisteredEvent.Merge(m, src)
+}
+func (m *ReferralCodeRegisteredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralCodeRegisteredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralCodeRegisteredEvent.DiscardUnknown(m)
 }
 
-func (m *PositionSettledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ReferralCodeRegisteredEvent proto.InternalMessageInfo
+
+func (m *ReferralCodeRegisteredEvent) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *ReferralCodeRegisteredEvent) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
 	}
-	if len(m.SettledCoins) > 0 {
-		for _, e := range m.SettledCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+// Emitted when a trader binds to a referral code.
+type ReferrerSetEvent struct {
+	TraderAddress   string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	Code            string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ReferrerAddress string `protobuf:"bytes,3,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
+}
+
+func (m *ReferrerSetEvent) Reset()         { *m = ReferrerSetEvent{} }
+func (m *ReferrerSetEvent) String() string { return proto.CompactTextString(m) }
+func (*ReferrerSetEvent) ProtoMessage()    {}
+func (*ReferrerSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{18}
+}
+func (m *ReferrerSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerSetEvent.Merge(m, src)
+}
+func (m *ReferrerSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerSetEvent proto.InternalMessageInfo
+
+func (m *ReferrerSetEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *ReferrerSetEvent) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *ReferrerSetEvent) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
+	}
+	return ""
+}
+
+// Emitted when a referred trader pays an exchange fee.
+type ReferralFeePaidEvent struct {
+	TraderAddress   string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	ReferrerAddress string `protobuf:"bytes,2,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
+	// the part of the exchange fee earned by the referrer
+	ReferrerFee types.Coin `protobuf:"bytes,3,opt,name=referrer_fee,json=referrerFee,proto3" json:"referrer_fee"`
+	// the part of the exchange fee waived for the trader
+	Discount types.Coin `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount"`
+}
+
+func (m *ReferralFeePaidEvent) Reset()         { *m = ReferralFeePaidEvent{} }
+func (m *ReferralFeePaidEvent) String() string { return proto.CompactTextString(m) }
+func (*ReferralFeePaidEvent) ProtoMessage()    {}
+func (*ReferralFeePaidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{19}
+}
+func (m *ReferralFeePaidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralFeePaidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralFeePaidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralFeePaidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralFeePaidEvent.Merge(m, src)
+}
+func (m *ReferralFeePaidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralFeePaidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralFeePaidEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralFeePaidEvent proto.InternalMessageInfo
+
+func (m *ReferralFeePaidEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *ReferralFeePaidEvent) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
+	}
+	return ""
+}
+
+func (m *ReferralFeePaidEvent) GetReferrerFee() types.Coin {
+	if m != nil {
+		return m.ReferrerFee
+	}
+	return types.Coin{}
+}
+
+func (m *ReferralFeePaidEvent) GetDiscount() types.Coin {
+	if m != nil {
+		return m.Discount
+	}
+	return types.Coin{}
+}
+
+// Emitted when a referrer claims their referral earnings.
+type ReferralEarningsClaimedEvent struct {
+	ReferrerAddress string                                   `protobuf:"bytes,1,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
+	Earnings        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
+}
+
+func (m *ReferralEarningsClaimedEvent) Reset()         { *m = ReferralEarningsClaimedEvent{} }
+func (m *ReferralEarningsClaimedEvent) String() string { return proto.CompactTextString(m) }
+func (*ReferralEarningsClaimedEvent) ProtoMessage()    {}
+func (*ReferralEarningsClaimedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{20}
+}
+func (m *ReferralEarningsClaimedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralEarningsClaimedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralEarningsClaimedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralEarningsClaimedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralEarningsClaimedEvent.Merge(m, src)
+}
+func (m *ReferralEarningsClaimedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralEarningsClaimedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralEarningsClaimedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralEarningsClaimedEvent proto.InternalMessageInfo
+
+func (m *ReferralEarningsClaimedEvent) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
+	}
+	return ""
+}
+
+func (m *ReferralEarningsClaimedEvent) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
+// Emitted when a profitable position is reduced to absorb a loss the
+// insurance and ecosystem funds can't cover.
+type PositionAutoDeleveragedEvent struct {
+	Pair          github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	TraderAddress string                                            `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the rank of the position in the ADL queue, starting at 1
+	Rank uint64 `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	// the signed change in position size
+	ExchangedSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchanged_size,json=exchangedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_size"`
+	// the notional value of the reduced size at the mark price
+	ExchangedNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchanged_notional,json=exchangedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional"`
+	// the PnL realized by the reduction
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the part of the realized PnL withheld to absorb the loss
+	AbsorbedLoss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=absorbed_loss,json=absorbedLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"absorbed_loss"`
+	BlockHeight  int64                                  `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PositionAutoDeleveragedEvent) Reset()         { *m = PositionAutoDeleveragedEvent{} }
+func (m *PositionAutoDeleveragedEvent) String() string { return proto.CompactTextString(m) }
+func (*PositionAutoDeleveragedEvent) ProtoMessage()    {}
+func (*PositionAutoDeleveragedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{21}
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionAutoDeleveragedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.Merge(m, src)
+}
+func (m *PositionAutoDeleveragedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionAutoDeleveragedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionAutoDeleveragedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionAutoDeleveragedEvent proto.InternalMessageInfo
+
+func (m *PositionAutoDeleveragedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *PositionAutoDeleveragedEvent) GetRank() uint64 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *PositionAutoDeleveragedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Emitted when an asset is accepted as cross-margin collateral or its haircut
+// or spot pool are changed.
+type CollateralAssetUpdatedEvent struct {
+	CollateralAsset CollateralAsset `protobuf:"bytes,1,opt,name=collateral_asset,json=collateralAsset,proto3" json:"collateral_asset"`
+}
+
+func (m *CollateralAssetUpdatedEvent) Reset()         { *m = CollateralAssetUpdatedEvent{} }
+func (m *CollateralAssetUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*CollateralAssetUpdatedEvent) ProtoMessage()    {}
+func (*CollateralAssetUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{22}
+}
+func (m *CollateralAssetUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralAssetUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralAssetUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralAssetUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralAssetUpdatedEvent.Merge(m, src)
+}
+func (m *CollateralAssetUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralAssetUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralAssetUpdatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralAssetUpdatedEvent proto.InternalMessageInfo
+
+func (m *CollateralAssetUpdatedEvent) GetCollateralAsset() CollateralAsset {
+	if m != nil {
+		return m.CollateralAsset
+	}
+	return CollateralAsset{}
+}
+
+// Emitted when the collateral of a cross-margin account being liquidated is
+// sold through x/spot for the quote denom of its positions.
+type CollateralSoldEvent struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the collateral sold
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+	// the quote tokens received for it, added to the account collateral
+	Proceeds   types.Coin `protobuf:"bytes,3,opt,name=proceeds,proto3" json:"proceeds"`
+	SpotPoolId uint64     `protobuf:"varint,4,opt,name=spot_pool_id,json=spotPoolId,proto3" json:"spot_pool_id,omitempty"`
+}
+
+func (m *CollateralSoldEvent) Reset()         { *m = CollateralSoldEvent{} }
+func (m *CollateralSoldEvent) String() string { return proto.CompactTextString(m) }
+func (*CollateralSoldEvent) ProtoMessage()    {}
+func (*CollateralSoldEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{23}
+}
+func (m *CollateralSoldEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralSoldEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralSoldEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralSoldEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralSoldEvent.Merge(m, src)
+}
+func (m *CollateralSoldEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralSoldEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralSoldEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralSoldEvent proto.InternalMessageInfo
+
+func (m *CollateralSoldEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *CollateralSoldEvent) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *CollateralSoldEvent) GetProceeds() types.Coin {
+	if m != nil {
+		return m.Proceeds
+	}
+	return types.Coin{}
+}
+
+func (m *CollateralSoldEvent) GetSpotPoolId() uint64 {
+	if m != nil {
+		return m.SpotPoolId
+	}
+	return 0
+}
+
+// Emitted when the collateral of a cross-margin account being liquidated can't
+// be sold and is seized into the ecosystem fund instead.
+type CollateralSeizedEvent struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the collateral seized
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
+}
+
+func (m *CollateralSeizedEvent) Reset()         { *m = CollateralSeizedEvent{} }
+func (m *CollateralSeizedEvent) String() string { return proto.CompactTextString(m) }
+func (*CollateralSeizedEvent) ProtoMessage()    {}
+func (*CollateralSeizedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{24}
+}
+func (m *CollateralSeizedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralSeizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralSeizedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralSeizedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralSeizedEvent.Merge(m, src)
+}
+func (m *CollateralSeizedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralSeizedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralSeizedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralSeizedEvent proto.InternalMessageInfo
+
+func (m *CollateralSeizedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *CollateralSeizedEvent) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
+	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
+	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v2.PositionLiquidatedEvent")
+	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v2.PositionSettledEvent")
+	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v2.FundingRateChangedEvent")
+	proto.RegisterType((*LiquidationFailedEvent)(nil), "nibiru.perp.v2.LiquidationFailedEvent")
+	proto.RegisterType((*MarketUpdatedEvent)(nil), "nibiru.perp.v2.MarketUpdatedEvent")
+	proto.RegisterType((*AmmUpdatedEvent)(nil), "nibiru.perp.v2.AmmUpdatedEvent")
+	proto.RegisterType((*OrderPlacedEvent)(nil), "nibiru.perp.v2.OrderPlacedEvent")
+	proto.RegisterType((*OrderCancelledEvent)(nil), "nibiru.perp.v2.OrderCancelledEvent")
+	proto.RegisterType((*OrderExecutedEvent)(nil), "nibiru.perp.v2.OrderExecutedEvent")
+	proto.RegisterType((*CrossMarginAccountUpdatedEvent)(nil), "nibiru.perp.v2.CrossMarginAccountUpdatedEvent")
+	proto.RegisterType((*CrossMarginAccountLiquidatedEvent)(nil), "nibiru.perp.v2.CrossMarginAccountLiquidatedEvent")
+	proto.RegisterType((*InsuranceFundDepositedEvent)(nil), "nibiru.perp.v2.InsuranceFundDepositedEvent")
+	proto.RegisterType((*InsuranceFundWithdrawalRequestedEvent)(nil), "nibiru.perp.v2.InsuranceFundWithdrawalRequestedEvent")
+	proto.RegisterType((*InsuranceFundWithdrawnEvent)(nil), "nibiru.perp.v2.InsuranceFundWithdrawnEvent")
+	proto.RegisterType((*LossSocializedEvent)(nil), "nibiru.perp.v2.LossSocializedEvent")
+	proto.RegisterType((*AmmRepeggedEvent)(nil), "nibiru.perp.v2.AmmRepeggedEvent")
+	proto.RegisterType((*ReferralCodeRegisteredEvent)(nil), "nibiru.perp.v2.ReferralCodeRegisteredEvent")
+	proto.RegisterType((*ReferrerSetEvent)(nil), "nibiru.perp.v2.ReferrerSetEvent")
+	proto.RegisterType((*ReferralFeePaidEvent)(nil), "nibiru.perp.v2.ReferralFeePaidEvent")
+	proto.RegisterType((*ReferralEarningsClaimedEvent)(nil), "nibiru.perp.v2.ReferralEarningsClaimedEvent")
+	proto.RegisterType((*PositionAutoDeleveragedEvent)(nil), "nibiru.perp.v2.PositionAutoDeleveragedEvent")
+	proto.RegisterType((*CollateralAssetUpdatedEvent)(nil), "nibiru.perp.v2.CollateralAssetUpdatedEvent")
+	proto.RegisterType((*CollateralSoldEvent)(nil), "nibiru.perp.v2.CollateralSoldEvent")
+	proto.RegisterType((*CollateralSeizedEvent)(nil), "nibiru.perp.v2.CollateralSeizedEvent")
+}
+
+func init() { proto.RegisterFile("perp/v2/event.proto", fileDescriptor_e18a1bd6d2374200) }
+
+var fileDescriptor_e18a1bd6d2374200 = []byte{
+	// 2053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6f, 0xdc, 0xc8,
+	0x11, 0x36, 0xa5, 0x91, 0x34, 0xaa, 0xd1, 0x63, 0x4c, 0x8d, 0x6c, 0xae, 0x6d, 0x48, 0x5a, 0x22,
+	0x1b, 0x38, 0x07, 0xcf, 0xc4, 0x0a, 0x90, 0x45, 0x36, 0x40, 0x16, 0x23, 0x79, 0x14, 0x0b, 0xb1,
+	0xa4, 0x59, 0x8e, 0x9c, 0xcd, 0x9b, 0xee, 0x21, 0x4b, 0x23, 0x42, 0x24, 0x9b, 0xee, 0xee, 0x91,
+	0x1f, 0x97, 0x1c, 0x93, 0xcb, 0x02, 0x8b, 0xfc, 0x83, 0x1c, 0x37, 0xc7, 0x1c, 0xf2, 0x1b, 0x7c,
+	0x0a, 0x16, 0xc8, 0x25, 0x08, 0x02, 0xef, 0xc2, 0x3e, 0xed, 0x35, 0xbf, 0x20, 0xe8, 0x66, 0x73,
+	0x9e, 0x72, 0x34, 0xa2, 0xed, 0xf5, 0x21, 0xa7, 0x19, 0x16, 0xbb, 0xbe, 0xaa, 0xea, 0xae, 0xae,
+	0xfa, 0xba, 0x09, 0x2b, 0x09, 0xb2, 0xa4, 0x76, 0xba, 0x59, 0xc3, 0x53, 0x8c, 0x45, 0x35, 0x61,
+	0x54, 0x50, 0x73, 0x29, 0x0e, 0xda, 0x01, 0xeb, 0x56, 0xe5, 0xbb, 0xea, 0xe9, 0xe6, 0xb5, 0x4a,
+	0x87, 0x76, 0xa8, 0x7a, 0x55, 0x93, 0xff, 0xd2, 0x51, 0xd7, 0x6e, 0x74, 0x28, 0xed, 0x84, 0x58,
+	0x23, 0x49, 0x50, 0x23, 0x71, 0x4c, 0x05, 0x11, 0x01, 0x8d, 0xb9, 0x7e, 0xbb, 0xe6, 0x51, 0x1e,
+	0x51, 0x5e, 0x6b, 0x13, 0x8e, 0xb5, 0xd3, 0xdb, 0x6d, 0x14, 0xe4, 0x76, 0xcd, 0xa3, 0x41, 0xac,
+	0xdf, 0xf7, 0x0c, 0x73, 0x41, 0x04, 0x6a, 0xe1, 0xba, 0x86, 0x54, 0x4f, 0xed, 0xee, 0x51, 0x4d,
+	0x04, 0x11, 0x72, 0x41, 0xa2, 0x24, 0x1d, 0x60, 0xff, 0x69, 0x1e, 0x2a, 0x4d, 0xca, 0x03, 0x69,
+	0x69, 0xfb, 0x98, 0xc4, 0x1d, 0xf4, 0x1b, 0xd2, 0x71, 0x73, 0x0f, 0x0a, 0x09, 0x09, 0x98, 0x65,
+	0x6c, 0x18, 0x37, 0xe7, 0xb7, 0x7e, 0xf4, 0xec, 0xf9, 0xfa, 0xa5, 0x7f, 0x3d, 0x5f, 0xbf, 0xdd,
+	0x09, 0xc4, 0x71, 0xb7, 0x5d, 0xf5, 0x68, 0x54, 0xdb, 0x57, 0x31, 0x6d, 0x1f, 0x93, 0x20, 0xae,
+	0xa5, 0xf1, 0xd5, 0x1e, 0xd7, 0x3c, 0x1a, 0x45, 0x34, 0xae, 0x11, 0xce, 0x51, 0x54, 0x9b, 0x24,
+	0x60, 0x8e, 0x82, 0x31, 0x3f, 0x80, 0x25, 0xc1, 0x88, 0x8f, 0xcc, 0x25, 0xbe, 0xcf, 0x90, 0x73,
+	0x6b, 0x4a, 0x02, 0x3b, 0x8b, 0xa9, 0xb4, 0x9e, 0x0a, 0xcd, 0xbb, 0x30, 0x1b, 0x11, 0xd6, 0x09,
+	0x62, 0x6b, 0x7a, 0xc3, 0xb8, 0x59, 0xda, 0x7c, 0xaf, 0x9a, 0x46, 0x5d, 0x95, 0x51, 0x57, 0x75,
+	0xd4, 0xd5, 0x6d, 0x1a, 0xc4, 0x5b, 0xab, 0xd2, 0xa5, 0xff, 0x3c, 0x5f, 0x5f, 0x7c, 0x42, 0xa2,
+	0xf0, 0x23, 0x3b, 0x55, 0xb3, 0x1d, 0xad, 0x6f, 0xfe, 0x1a, 0x2e, 0x27, 0x3a, 0x2e, 0x37, 0xa6,
+	0xf2, 0x87, 0x84, 0x56, 0x41, 0x05, 0x53, 0xd5, 0xc1, 0x7c, 0x77, 0x20, 0x18, 0x3d, 0xb9, 0xe9,
+	0xcf, 0x2d, 0xee, 0x9f, 0xd4, 0xc4, 0x93, 0x04, 0x79, 0xf5, 0x0e, 0x7a, 0x4e, 0x39, 0x03, 0xda,
+	0xd7, 0x38, 0xe6, 0x7d, 0x58, 0xc2, 0xc7, 0x5e, 0x3a, 0x5d, 0x2e, 0x0f, 0x9e, 0xa2, 0x35, 0x93,
+	0x0b, 0x79, 0xb1, 0x87, 0xd2, 0x0a, 0x9e, 0xa2, 0xf9, 0x5b, 0x30, 0xfb, 0xb0, 0x3d, 0xa7, 0x67,
+	0x73, 0x41, 0x5f, 0xee, 0x21, 0xf5, 0xbc, 0x6e, 0xc3, 0xb2, 0x60, 0x24, 0xe6, 0xc4, 0x53, 0xb3,
+	0x72, 0x84, 0x68, 0xcd, 0x9d, 0x37, 0xcb, 0x6b, 0x7a, 0x96, 0xaf, 0xa4, 0xb3, 0x3c, 0xa2, 0x6f,
+	0x3b, 0x4b, 0x03, 0x92, 0x1d, 0x44, 0xb3, 0x05, 0x8b, 0xbd, 0x69, 0x57, 0x13, 0x53, 0xcc, 0xe5,
+	0xfd, 0x42, 0x06, 0xa2, 0xe6, 0xe5, 0x13, 0x58, 0x60, 0x48, 0xc2, 0xe0, 0x29, 0xfa, 0x6e, 0x12,
+	0x87, 0xd6, 0x7c, 0x2e, 0xcc, 0x52, 0x86, 0xd1, 0x8c, 0x43, 0xf3, 0x01, 0x54, 0xba, 0xf1, 0x20,
+	0xa8, 0x4b, 0x8e, 0x04, 0x32, 0x0b, 0x72, 0x41, 0x9b, 0x7d, 0xac, 0x66, 0x1c, 0xd6, 0x25, 0x92,
+	0xf9, 0x11, 0x14, 0xdb, 0xc4, 0x77, 0x7d, 0x6c, 0x0b, 0xab, 0x74, 0xde, 0x34, 0x17, 0xa4, 0x41,
+	0x67, 0xae, 0x4d, 0xfc, 0x3b, 0xd8, 0x16, 0xe6, 0xa7, 0xb0, 0x7c, 0xd4, 0x8d, 0xfd, 0x20, 0xee,
+	0xb8, 0x09, 0x79, 0x12, 0x61, 0x2c, 0xac, 0x85, 0x5c, 0x8e, 0x2d, 0x69, 0x98, 0x66, 0x8a, 0x62,
+	0xbe, 0x0f, 0x0b, 0xed, 0x90, 0x7a, 0x27, 0xee, 0x31, 0x06, 0x9d, 0x63, 0x61, 0x2d, 0x6e, 0x18,
+	0x37, 0xa7, 0x9d, 0x92, 0x92, 0xdd, 0x55, 0x22, 0xd3, 0x86, 0xc5, 0x74, 0x88, 0x2c, 0x15, 0x6e,
+	0xc4, 0xad, 0xa5, 0x81, 0x31, 0x87, 0x41, 0x84, 0x7b, 0xdc, 0x7c, 0x0f, 0x8a, 0x47, 0x88, 0xae,
+	0x08, 0x90, 0x59, 0xcb, 0x1b, 0xc6, 0xcd, 0x82, 0x33, 0x77, 0x84, 0x78, 0x18, 0x20, 0x33, 0xb7,
+	0x60, 0x21, 0x22, 0x27, 0xc8, 0x5c, 0x86, 0x6d, 0x22, 0xd0, 0x2a, 0x4f, 0x16, 0x7a, 0x49, 0x29,
+	0x39, 0x4a, 0xc7, 0xfe, 0x6c, 0x1e, 0xae, 0x66, 0x45, 0xe9, 0x5e, 0xf0, 0xb0, 0x1b, 0xf8, 0x44,
+	0xbc, 0xdb, 0xba, 0xe4, 0xc3, 0x95, 0xfe, 0xce, 0x7c, 0xd8, 0xa5, 0x02, 0x5d, 0x12, 0xd1, 0x6e,
+	0x2c, 0xac, 0xe9, 0x5c, 0xeb, 0x52, 0xe9, 0xa1, 0x7d, 0x22, 0xc1, 0xea, 0x0a, 0xcb, 0x3c, 0x82,
+	0xab, 0x7d, 0x2b, 0xc3, 0xdb, 0x28, 0x5f, 0xe5, 0x5a, 0xed, 0xc1, 0x35, 0x07, 0xf7, 0xd3, 0x2d,
+	0x30, 0x43, 0x3d, 0xad, 0xb4, 0x1f, 0xb8, 0x2a, 0x61, 0xce, 0xe5, 0xfe, 0x9b, 0x2c, 0xf8, 0x0e,
+	0x5c, 0x56, 0xab, 0x4d, 0xdd, 0xfe, 0x3b, 0x6b, 0xf6, 0xbc, 0x75, 0xdd, 0xd0, 0x95, 0xc3, 0x4a,
+	0x2b, 0xc7, 0x18, 0x82, 0xed, 0x2c, 0xcb, 0x9c, 0xa1, 0xf7, 0x7a, 0x12, 0x93, 0xc1, 0xaa, 0x1e,
+	0x86, 0x1e, 0xe5, 0x4f, 0xb8, 0xc0, 0xc8, 0x95, 0x09, 0x7c, 0x7e, 0x99, 0xfa, 0x8e, 0x36, 0x76,
+	0x63, 0xc8, 0xd8, 0x30, 0x8a, 0xed, 0x98, 0xca, 0x60, 0x23, 0x93, 0xee, 0x74, 0x63, 0x7f, 0x68,
+	0x9b, 0x16, 0x2f, 0xb8, 0x4d, 0xfb, 0xdd, 0x6a, 0xfe, 0x6d, 0x74, 0x2b, 0x78, 0x43, 0xdd, 0x6a,
+	0xac, 0x26, 0x97, 0xde, 0x40, 0x4d, 0x3e, 0x84, 0xc5, 0xa1, 0xa2, 0x97, 0xb3, 0x40, 0x0d, 0x83,
+	0x98, 0x7b, 0x00, 0x11, 0x61, 0x27, 0x6e, 0xc2, 0x02, 0x0f, 0xad, 0xc5, 0x5c, 0x90, 0xf3, 0x12,
+	0xa1, 0x29, 0x01, 0xc6, 0xca, 0xdd, 0xd2, 0x04, 0xe5, 0x6e, 0x79, 0xac, 0xdc, 0xd9, 0xff, 0x98,
+	0xea, 0x93, 0xa4, 0x16, 0x0a, 0x11, 0xbe, 0xdb, 0x62, 0xf4, 0x47, 0x03, 0x16, 0x79, 0xea, 0x86,
+	0x2b, 0x09, 0x20, 0xb7, 0xa6, 0x37, 0xa6, 0xff, 0x77, 0xfa, 0xdd, 0xd5, 0xe9, 0x57, 0x49, 0xd3,
+	0x6f, 0x48, 0xdb, 0xfe, 0xcb, 0x57, 0xeb, 0x37, 0x27, 0x98, 0x5b, 0x09, 0xc4, 0x9d, 0x05, 0xad,
+	0xab, 0x9e, 0x86, 0x76, 0x4f, 0xe1, 0x62, 0xbb, 0xc7, 0xfe, 0xc3, 0x0c, 0x5c, 0xdd, 0x49, 0xdb,
+	0x93, 0x43, 0x04, 0xbe, 0x4d, 0xf6, 0x39, 0x9c, 0x56, 0x53, 0xaf, 0x9b, 0x56, 0x07, 0x50, 0x0a,
+	0x62, 0x1f, 0x1f, 0x6b, 0xbc, 0x7c, 0x2d, 0x00, 0x14, 0x44, 0x0a, 0xf8, 0x3b, 0x58, 0x09, 0x89,
+	0x40, 0x2e, 0xdc, 0xac, 0xed, 0x33, 0xd9, 0x3b, 0xf3, 0x15, 0xfd, 0xcb, 0x29, 0xd4, 0xc0, 0xd4,
+	0xca, 0xc6, 0xa2, 0xf1, 0x13, 0x86, 0x51, 0xd0, 0x8d, 0xdc, 0x23, 0x96, 0x72, 0xb6, 0x9c, 0xc4,
+	0x75, 0x35, 0x85, 0x6b, 0xa6, 0x68, 0x3b, 0x1a, 0xcc, 0x8c, 0xe1, 0xba, 0xd7, 0x8d, 0xba, 0x21,
+	0x11, 0xc1, 0x29, 0x8e, 0xdb, 0xca, 0xc7, 0x64, 0xdf, 0xeb, 0x43, 0x8e, 0xda, 0x1b, 0xdd, 0xdf,
+	0x73, 0x13, 0xec, 0xef, 0xe2, 0xf8, 0xfe, 0xfe, 0x66, 0x0a, 0xae, 0x64, 0x6d, 0x48, 0xf2, 0x58,
+	0x12, 0xbc, 0xad, 0x1d, 0x7e, 0x05, 0x66, 0xd3, 0xbd, 0xac, 0x77, 0xb6, 0x7e, 0x32, 0xd7, 0x00,
+	0x06, 0x7a, 0xab, 0x4a, 0x28, 0x67, 0x40, 0x62, 0xfe, 0x1c, 0x66, 0x19, 0x12, 0x4e, 0x63, 0x95,
+	0x13, 0x4b, 0x9b, 0x3f, 0xa9, 0x0e, 0x9f, 0x28, 0xab, 0x67, 0xbb, 0x3f, 0x2e, 0x76, 0x14, 0x8a,
+	0xa3, 0xd1, 0xec, 0x04, 0xae, 0xbe, 0x62, 0x88, 0xb9, 0x0c, 0xa5, 0xfb, 0xfb, 0xad, 0x66, 0x63,
+	0x7b, 0x77, 0x67, 0xb7, 0x71, 0xa7, 0x7c, 0xc9, 0xac, 0x40, 0xb9, 0x79, 0xd0, 0xda, 0x3d, 0xdc,
+	0x3d, 0xd8, 0x77, 0xef, 0x36, 0xea, 0xf7, 0x0e, 0xef, 0xfe, 0xb2, 0x6c, 0x48, 0xe9, 0xfe, 0xc1,
+	0x7e, 0xe3, 0x17, 0xbb, 0xad, 0xc3, 0xc6, 0xfe, 0xa1, 0xdb, 0xac, 0xef, 0x3a, 0xe5, 0x29, 0xd3,
+	0x82, 0xca, 0x90, 0x54, 0xeb, 0x95, 0xa7, 0xed, 0xfb, 0x60, 0xee, 0x11, 0x76, 0x82, 0xe2, 0x7e,
+	0x32, 0xc0, 0xea, 0x3e, 0x86, 0x85, 0xa3, 0x20, 0x26, 0xa1, 0x1b, 0xa9, 0x77, 0x6a, 0xba, 0x4b,
+	0x9b, 0x57, 0x46, 0xa3, 0x4c, 0x35, 0x33, 0xca, 0xa8, 0x34, 0x52, 0x91, 0xfd, 0x99, 0x01, 0xcb,
+	0xf5, 0x28, 0x1a, 0x02, 0xfd, 0x21, 0xcc, 0xa7, 0xa0, 0x24, 0x8a, 0x34, 0xe2, 0xca, 0x28, 0x62,
+	0x7d, 0x6f, 0x4f, 0xc3, 0x15, 0xd5, 0xd8, 0x7a, 0x14, 0x99, 0x5b, 0x50, 0xf0, 0x28, 0x17, 0x39,
+	0xea, 0xc4, 0x6e, 0x2c, 0x1c, 0xa5, 0x6b, 0x37, 0xa0, 0x7c, 0xc0, 0x7c, 0x64, 0xcd, 0x90, 0x78,
+	0x99, 0x3f, 0xb7, 0x61, 0x86, 0x4a, 0x99, 0xf6, 0x65, 0x75, 0xd4, 0x17, 0xa5, 0xa0, 0xbd, 0x49,
+	0x47, 0xda, 0x0f, 0x60, 0x45, 0x49, 0xb7, 0x49, 0xec, 0x61, 0x18, 0xe6, 0x47, 0x92, 0x99, 0xa7,
+	0x33, 0x48, 0x67, 0x9e, 0xce, 0x80, 0x2f, 0x0c, 0x30, 0xd5, 0xf0, 0xc6, 0x63, 0xf4, 0xba, 0xe2,
+	0x35, 0x2c, 0x3c, 0x80, 0x8a, 0x60, 0x41, 0xa7, 0x83, 0xcc, 0xe5, 0xb4, 0xcb, 0x3c, 0x7c, 0xad,
+	0x72, 0x6b, 0x6a, 0xac, 0x96, 0x82, 0x52, 0x65, 0xd2, 0xf6, 0x61, 0x6d, 0x9b, 0x51, 0xce, 0xf7,
+	0x14, 0x69, 0xaa, 0x7b, 0x9e, 0x64, 0xcd, 0x43, 0x4b, 0xbe, 0x05, 0x73, 0x24, 0x15, 0x6b, 0xc7,
+	0xed, 0x51, 0xc7, 0xc7, 0x01, 0xb2, 0xbe, 0xa4, 0x15, 0xed, 0xaf, 0xa7, 0xe1, 0xfd, 0xf1, 0x51,
+	0xa3, 0xe7, 0x90, 0xf1, 0x5e, 0x6d, 0x9c, 0xd5, 0xab, 0xcf, 0xa6, 0xda, 0x53, 0xaf, 0xa2, 0xda,
+	0x07, 0x30, 0x23, 0xeb, 0x44, 0xda, 0xd1, 0x5f, 0xab, 0xde, 0xa4, 0x38, 0xe6, 0x87, 0x30, 0x8b,
+	0x0f, 0xbb, 0x81, 0x78, 0x32, 0x69, 0x7b, 0xd6, 0xc3, 0xcd, 0x9f, 0x9d, 0x45, 0xfa, 0x67, 0x26,
+	0xc3, 0x18, 0x23, 0xf6, 0xce, 0xab, 0x88, 0xfd, 0xec, 0x64, 0x80, 0xe7, 0x11, 0xf7, 0xb9, 0x0b,
+	0x52, 0x8f, 0xbf, 0x19, 0x70, 0x7d, 0x37, 0xe6, 0x5d, 0x26, 0xb7, 0x95, 0x44, 0xbb, 0x83, 0x8a,
+	0xdc, 0x0e, 0x2c, 0x2e, 0x17, 0xea, 0x14, 0x3b, 0xb2, 0xb8, 0xa9, 0x34, 0x5b, 0xad, 0x0f, 0x61,
+	0x56, 0x9f, 0x02, 0xa7, 0x26, 0x9c, 0xdc, 0x74, 0xb8, 0x54, 0xe4, 0xc7, 0x84, 0x21, 0xb7, 0xa6,
+	0x27, 0x54, 0x4c, 0x87, 0xdb, 0x7f, 0x37, 0xe0, 0x83, 0x21, 0xc7, 0x3f, 0x0d, 0xc4, 0xb1, 0xcf,
+	0xc8, 0x23, 0x12, 0x3a, 0xf8, 0xb0, 0x8b, 0x3c, 0x47, 0x08, 0xda, 0x93, 0xa9, 0x0b, 0x79, 0x62,
+	0x36, 0xa0, 0xd4, 0x8d, 0x7b, 0x8d, 0x55, 0xc7, 0x71, 0xad, 0x9a, 0xde, 0x37, 0x56, 0xb3, 0xfb,
+	0xc6, 0xea, 0x61, 0x76, 0xdf, 0xb8, 0x55, 0x94, 0xea, 0x9f, 0x7f, 0xb5, 0x6e, 0x38, 0x90, 0x2a,
+	0xca, 0x57, 0xe3, 0x2b, 0x91, 0x05, 0x14, 0x7f, 0x3b, 0x61, 0xf4, 0x97, 0x70, 0xfa, 0x42, 0x4b,
+	0x68, 0x3f, 0x33, 0x60, 0xe5, 0x1e, 0xe5, 0xbc, 0x45, 0xbd, 0x20, 0x3d, 0xbf, 0xa4, 0x0e, 0x57,
+	0x60, 0xc6, 0xc7, 0x98, 0x46, 0xda, 0xcf, 0xf4, 0x41, 0xb6, 0x94, 0x90, 0x72, 0x9e, 0xa3, 0x16,
+	0xaa, 0x96, 0x22, 0x75, 0xe5, 0xa5, 0x10, 0xef, 0x19, 0x73, 0x15, 0x5c, 0x3e, 0xe6, 0xb9, 0xd4,
+	0x87, 0x91, 0x11, 0xd8, 0x7f, 0x2e, 0x40, 0xb9, 0x1e, 0x45, 0x0e, 0x26, 0xd8, 0xf9, 0x7f, 0x65,
+	0xe0, 0x0f, 0xa0, 0x42, 0x43, 0x3f, 0x85, 0x73, 0xa3, 0x6e, 0x28, 0x82, 0x24, 0x0c, 0x90, 0xe5,
+	0xa4, 0xe0, 0x26, 0x0d, 0x7d, 0x85, 0xbb, 0xd7, 0x43, 0x92, 0x16, 0x62, 0x7c, 0x34, 0x6e, 0x21,
+	0x1f, 0x01, 0x37, 0x63, 0x7c, 0x34, 0x6a, 0x21, 0xe3, 0x2d, 0xb3, 0xf9, 0x79, 0x8b, 0xa4, 0x09,
+	0x1e, 0x49, 0x12, 0x4c, 0xef, 0x5c, 0x8a, 0x8e, 0x7e, 0xb2, 0x7f, 0x03, 0xd7, 0x1d, 0x3c, 0x42,
+	0xc6, 0x48, 0xb8, 0x4d, 0x7d, 0x74, 0xb0, 0x13, 0x70, 0x81, 0x2c, 0xcb, 0x16, 0x53, 0x9a, 0xf6,
+	0x51, 0x27, 0xbd, 0xfa, 0x6f, 0x7e, 0x0f, 0xca, 0x4c, 0xa9, 0x8c, 0x9d, 0x67, 0x97, 0x33, 0xb9,
+	0xde, 0xbe, 0xf6, 0x63, 0x28, 0x3b, 0x5a, 0xd4, 0x42, 0x71, 0xa1, 0x06, 0x9b, 0x59, 0x9e, 0x3a,
+	0xc7, 0xf2, 0xf4, 0xd9, 0x96, 0xbf, 0x31, 0xa0, 0x92, 0x05, 0xb6, 0x83, 0xd8, 0x24, 0xc1, 0xc5,
+	0xfa, 0xfb, 0xe4, 0x41, 0xca, 0x9b, 0xd1, 0xde, 0x50, 0x79, 0xf7, 0x3e, 0x61, 0xc1, 0x29, 0x65,
+	0x4a, 0xf2, 0x7a, 0xfd, 0xc7, 0x50, 0xf4, 0x03, 0x9e, 0x12, 0x9c, 0x09, 0x1b, 0x7a, 0x4f, 0xc1,
+	0xfe, 0xab, 0x01, 0x37, 0xb2, 0x58, 0x1b, 0x84, 0xc5, 0x41, 0xdc, 0xe1, 0xdb, 0x21, 0x09, 0xa2,
+	0x6c, 0x15, 0xcf, 0x0a, 0xc6, 0x38, 0x3b, 0x98, 0x0e, 0x14, 0x51, 0x43, 0x58, 0x53, 0xe7, 0xdd,
+	0x3e, 0x7c, 0x5f, 0x3a, 0x72, 0xa1, 0x5b, 0x86, 0x1e, 0xb8, 0xfd, 0x45, 0x01, 0x6e, 0x64, 0x77,
+	0x2f, 0xf5, 0xae, 0xa0, 0x77, 0x30, 0xc4, 0x53, 0x64, 0xe4, 0x1d, 0x7f, 0xa8, 0x32, 0xa1, 0xc0,
+	0x48, 0x7c, 0xa2, 0x16, 0xb1, 0xe0, 0xa8, 0xff, 0x67, 0x7c, 0x15, 0x2a, 0xbc, 0xbd, 0xaf, 0x42,
+	0x33, 0x6f, 0xea, 0xab, 0xd0, 0xe8, 0xc7, 0x95, 0xd9, 0xd7, 0xff, 0xb8, 0xd2, 0x82, 0x45, 0xd2,
+	0xe6, 0x94, 0xb5, 0xb3, 0x3e, 0x35, 0x97, 0xef, 0xc2, 0x31, 0x03, 0x91, 0x5d, 0x6a, 0xec, 0xac,
+	0x5f, 0x1c, 0x3b, 0xeb, 0xdb, 0x14, 0xae, 0x6f, 0xd3, 0x50, 0x5e, 0x4d, 0x30, 0x12, 0xd6, 0xe5,
+	0xca, 0x0e, 0x1d, 0x0e, 0x9a, 0x50, 0xf6, 0x7a, 0xaf, 0x5d, 0xb5, 0xf2, 0xfa, 0x94, 0xb0, 0x3e,
+	0x76, 0x4a, 0x18, 0x86, 0xc9, 0x78, 0xad, 0x37, 0x2c, 0xb6, 0xff, 0x6d, 0xc0, 0x4a, 0x7f, 0x68,
+	0x8b, 0x86, 0x17, 0x2b, 0x1e, 0x1f, 0x03, 0xf4, 0x11, 0x27, 0x65, 0x2e, 0x03, 0x2a, 0xb2, 0x1c,
+	0x24, 0x8c, 0x7a, 0x88, 0xfe, 0xc4, 0x4c, 0xb2, 0xa7, 0x60, 0x6e, 0xc0, 0x02, 0x4f, 0xa8, 0x70,
+	0x13, 0x4a, 0x43, 0x37, 0xf0, 0x55, 0xb2, 0x16, 0x1c, 0x90, 0xb2, 0x26, 0xa5, 0xe1, 0xae, 0x6f,
+	0xff, 0x1e, 0x56, 0x07, 0xa2, 0xc3, 0x3e, 0xc9, 0xf9, 0x96, 0xe2, 0xdb, 0xfa, 0xe9, 0xb3, 0x17,
+	0x6b, 0xc6, 0x97, 0x2f, 0xd6, 0x8c, 0xaf, 0x5f, 0xac, 0x19, 0x9f, 0xbf, 0x5c, 0xbb, 0xf4, 0xe5,
+	0xcb, 0xb5, 0x4b, 0xff, 0x7c, 0xb9, 0x76, 0xe9, 0x57, 0xb7, 0xce, 0xdb, 0xdf, 0xea, 0x6b, 0xb8,
+	0xca, 0xa5, 0xda, 0xe9, 0x66, 0x7b, 0x56, 0x11, 0xd2, 0x1f, 0xfc, 0x77, 0x00, 0x80, 0x26, 0xd1,
+	0x3d, 0x9e, 0x1f, 0x00, 0x00,
+}
+
+func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MakerRebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.FeeTier != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedQuoteAmount.Size()
+		i -= size
+		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SettledCoins) > 0 {
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LatestPremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LatestFundingRate.Size()
+		i -= size
+		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalMarket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AmmUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmmUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmmUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FinalAmm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderPlacedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderPlacedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderPlacedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TriggerSourcePrice.Size()
+		i -= size
+		if _, err := m.TriggerSourcePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginAccountUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginAccountUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginAccountUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Equity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Pairs) > 0 {
+		for iNdEx := len(m.Pairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Pairs[iNdEx].Size()
+				i -= size
+				if _, err := m.Pairs[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundDepositedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundDepositedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundDepositedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundWithdrawalRequestedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundWithdrawalRequestedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundWithdrawalRequestedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintEvent(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundWithdrawnEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundWithdrawnEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundWithdrawnEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LossSocializedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LossSocializedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LossSocializedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SocializedLoss.Size()
+		i -= size
+		if _, err := m.SocializedLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Loss.Size()
+		i -= size
+		if _, err := m.Loss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AmmRepeggedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmmRepeggedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmmRepeggedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NewPriceMultiplier.Size()
+		i -= size
+		if _, err := m.NewPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OldPriceMultiplier.Size()
+		i -= size
+		if _, err := m.OldPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralCodeRegisteredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralCodeRegisteredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralCodeRegisteredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferrerSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralFeePaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralFeePaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralFeePaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Discount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ReferrerFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralEarningsClaimedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralEarningsClaimedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralEarningsClaimedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionAutoDeleveragedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionAutoDeleveragedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AbsorbedLoss.Size()
+		i -= size
+		if _, err := m.AbsorbedLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Rank != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Rank))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralAssetUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralAssetUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralAssetUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollateralAsset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralSoldEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralSoldEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralSoldEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpotPoolId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SpotPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Proceeds.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralSeizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralSeizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralSeizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TransactionFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	if m.FeeTier != 0 {
+		n += 1 + sovEvent(uint64(m.FeeTier))
+	}
+	l = m.MakerRebate.Size()
+	n += 2 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *PositionLiquidatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedQuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *FundingRateChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestFundingRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestPremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *LiquidationFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *MarketUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalMarket.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *AmmUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalAmm.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *OrderPlacedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *OrderCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TriggerSourcePrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *CrossMarginAccountUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *CrossMarginAccountLiquidatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Pairs) > 0 {
+		for _, e := range m.Pairs {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.Equity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundDepositedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundWithdrawalRequestedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundWithdrawnEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *LossSocializedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Loss.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SocializedLoss.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *AmmRepeggedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldPriceMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewPriceMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Capped {
+		n += 2
+	}
+	return n
+}
+
+func (m *ReferralCodeRegisteredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferrerSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferralFeePaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ReferrerFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *ReferralEarningsClaimedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PositionAutoDeleveragedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Rank != 0 {
+		n += 1 + sovEvent(uint64(m.Rank))
+	}
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.AbsorbedLoss.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *CollateralAssetUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollateralAsset.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *CollateralSoldEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Proceeds.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.SpotPoolId != 0 {
+		n += 1 + sovEvent(uint64(m.SpotPoolId))
+	}
+	return n
+}
+
+func (m *CollateralSeizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmmUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmmUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmmUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalAmm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalAmm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderPlacedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderPlacedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderPlacedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderExecutedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderExecutedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerSourcePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerSourcePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossMarginAccountUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossMarginAccountUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossMarginAccountUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossMarginAccountLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossMarginAccountLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossMarginAccountLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_NibiruChain_nibiru_x_common_asset.Pair
+			m.Pairs = append(m.Pairs, v)
+			if err := m.Pairs[len(m.Pairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Equity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *FundingRateChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.IndexPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestFundingRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestPremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CumulativePremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
-	}
-	return n
-}
 
-func (m *LiquidationFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Liquidator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFundDepositedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1292,15 +6533,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceFundDepositedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceFundDepositedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1328,15 +6569,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1346,27 +6585,28 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1393,13 +6633,63 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundWithdrawalRequestedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawalRequestedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawalRequestedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1427,15 +6717,46 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1445,29 +6766,78 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundWithdrawnEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawnEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawnEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,13 +6865,11 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1528,15 +6896,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1546,63 +6914,78 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LossSocializedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LossSocializedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LossSocializedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,15 +7013,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loss", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1648,28 +7029,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Loss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SocializedLoss", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1697,48 +7079,10 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SocializedLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
-			}
-			m.BlockTimeMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1760,7 +7104,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+func (m *AmmRepeggedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,10 +7127,10 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AmmRepeggedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AmmRepeggedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1825,7 +7169,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,11 +7197,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1885,13 +7231,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1919,13 +7265,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1953,13 +7299,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.NewPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1969,30 +7317,101 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralCodeRegisteredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralCodeRegisteredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralCodeRegisteredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2002,30 +7421,29 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2035,30 +7453,79 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2068,28 +7535,27 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2117,13 +7583,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2151,13 +7615,61 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralFeePaidEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralFeePaidEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralFeePaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2185,13 +7697,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2219,15 +7729,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerFee", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2237,16 +7745,30 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.BlockTimeMs = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferrerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2256,11 +7778,25 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2282,7 +7818,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+func (m *ReferralEarningsClaimedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,15 +7841,15 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReferralEarningsClaimedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReferralEarningsClaimedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2341,45 +7877,11 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2406,8 +7908,8 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledCoins = append(m.SettledCoins, types.Coin{})
-			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2432,7 +7934,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *PositionAutoDeleveragedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2455,10 +7957,10 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionAutoDeleveragedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2497,7 +7999,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,13 +8027,30 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rank", wireType)
+			}
+			m.Rank = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rank |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2559,13 +8078,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2593,13 +8112,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2627,13 +8146,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsorbedLoss", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,11 +8180,11 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AbsorbedLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -2684,11 +8203,61 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlockTimeMs = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralAssetUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralAssetUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralAssetUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAsset", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2698,11 +8267,25 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralAsset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2724,7 +8307,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *CollateralSoldEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,15 +8330,15 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollateralSoldEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollateralSoldEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2783,15 +8366,13 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2801,27 +8382,130 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proceeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proceeds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPoolId", wireType)
+			}
+			m.SpotPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpotPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralSeizedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralSeizedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralSeizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2849,13 +8533,13 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			m.Reason = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2865,11 +8549,25 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -11,6 +11,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
 )
 
 // ----------------------------------------------------------
@@ -107,6 +108,17 @@ type PerpAmmKeeper interface {
 	) (newMarket perpammtypes.Market, err error)
 }
 
+type SpotKeeper interface {
+	FetchPool(ctx sdk.Context, poolId uint64) (pool spottypes.Pool, err error)
+	SwapExactAmountIn(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		poolId uint64,
+		tokenIn sdk.Coin,
+		tokenOutDenom string,
+	) (tokenOut sdk.Coin, err error)
+}
+
 type EpochKeeper interface {
 	// GetEpochInfo returns epoch info by identifier.
 	GetEpochInfo(ctx sdk.Context, identifier string) types.EpochInfo
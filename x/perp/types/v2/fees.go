@@ -0,0 +1,47 @@
+package v2
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TraderVolumeWindowDays is the number of days of quote volume that count
+// towards the fee tier of a trader.
+const TraderVolumeWindowDays = 30
+
+// VolumeDay returns the number of days since the unix epoch at the given time,
+// which buckets the quote volume of the traders.
+func VolumeDay(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(24*time.Hour/time.Second))
+}
+
+func (m FeeTier) Validate() error {
+	if m.MinVolume.IsNil() || m.MinVolume.IsNegative() {
+		return fmt.Errorf("min volume must not be negative: %s", m.MinVolume)
+	}
+
+	if m.TakerFeeRatio.IsNil() || m.TakerFeeRatio.IsNegative() || m.TakerFeeRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("taker fee ratio must be between 0 and 1: %s", m.TakerFeeRatio)
+	}
+
+	// a negative maker fee ratio is a rebate
+	if m.MakerFeeRatio.IsNil() || m.MakerFeeRatio.Abs().GT(sdk.OneDec()) {
+		return fmt.Errorf("maker fee ratio must be between -1 and 1: %s", m.MakerFeeRatio)
+	}
+
+	return nil
+}
+
+func (m *TraderVolume) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if m.Volume.IsNil() || m.Volume.IsNegative() {
+		return fmt.Errorf("volume must not be negative: %s", m.Volume)
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"fmt"
+)
+
+func (m *FundingRateRecord) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.MarkTwap.IsNil() || !m.MarkTwap.IsPositive() {
+		return fmt.Errorf("mark twap must be > 0")
+	}
+
+	if m.IndexTwap.IsNil() || !m.IndexTwap.IsPositive() {
+		return fmt.Errorf("index twap must be > 0")
+	}
+
+	if m.PremiumFraction.IsNil() || m.FundingRate.IsNil() {
+		return fmt.Errorf("nil premium fraction or funding rate")
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package v2
 
+import "fmt"
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -8,6 +10,24 @@ func DefaultGenesis() *GenesisState {
 		Amms:             []AMM{},
 		Positions:        []Position{},
 		ReserveSnapshots: []ReserveSnapshot{},
+		Orders:           []Order{},
+		NextOrderId:      1,
+
+		CrossMarginAccounts: []CrossMarginAccount{},
+
+		InsuranceFundWithdrawals: []InsuranceFundWithdrawal{},
+
+		TraderVolumes: []TraderVolume{},
+
+		ReferralCodes:    []ReferralCode{},
+		TraderReferrals:  []TraderReferral{},
+		ReferralEarnings: []ReferralEarnings{},
+
+		FundingRates: []FundingRateRecord{},
+
+		CollateralAssets: []CollateralAsset{},
+
+		PendingLosses: []PendingLoss{},
 	}
 }
 
@@ -36,5 +56,136 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	orderIDs := make(map[uint64]struct{})
+	for _, o := range gs.Orders {
+		if err := o.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := orderIDs[o.Id]; found {
+			return fmt.Errorf("duplicate order id %d", o.Id)
+		}
+		orderIDs[o.Id] = struct{}{}
+
+		if o.Id >= gs.NextOrderId {
+			return fmt.Errorf("order id %d is not below the next order id %d", o.Id, gs.NextOrderId)
+		}
+	}
+
+	traders := make(map[string]struct{})
+	for _, a := range gs.CrossMarginAccounts {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := traders[a.TraderAddress]; found {
+			return fmt.Errorf("duplicate cross-margin account for %s", a.TraderAddress)
+		}
+		traders[a.TraderAddress] = struct{}{}
+	}
+
+	withdrawals := make(map[string]struct{})
+	for _, w := range gs.InsuranceFundWithdrawals {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", w.StakerAddress, w.UnlockTime.UnixNano())
+		if _, found := withdrawals[key]; found {
+			return fmt.Errorf("duplicate insurance fund withdrawal for %s unlocked at %s", w.StakerAddress, w.UnlockTime)
+		}
+		withdrawals[key] = struct{}{}
+	}
+
+	volumes := make(map[string]struct{})
+	for _, v := range gs.TraderVolumes {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", v.TraderAddress, v.Day)
+		if _, found := volumes[key]; found {
+			return fmt.Errorf("duplicate volume for %s on day %d", v.TraderAddress, v.Day)
+		}
+		volumes[key] = struct{}{}
+	}
+
+	codes := make(map[string]struct{})
+	for _, c := range gs.ReferralCodes {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := codes[c.Code]; found {
+			return fmt.Errorf("duplicate referral code %s", c.Code)
+		}
+		codes[c.Code] = struct{}{}
+	}
+
+	referredTraders := make(map[string]struct{})
+	for _, r := range gs.TraderReferrals {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := codes[r.Code]; !found {
+			return fmt.Errorf("trader %s is bound to the unknown referral code %s", r.TraderAddress, r.Code)
+		}
+
+		if _, found := referredTraders[r.TraderAddress]; found {
+			return fmt.Errorf("duplicate referral for %s", r.TraderAddress)
+		}
+		referredTraders[r.TraderAddress] = struct{}{}
+	}
+
+	referrers := make(map[string]struct{})
+	for _, e := range gs.ReferralEarnings {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := referrers[e.ReferrerAddress]; found {
+			return fmt.Errorf("duplicate referral earnings for %s", e.ReferrerAddress)
+		}
+		referrers[e.ReferrerAddress] = struct{}{}
+	}
+
+	fundingRates := make(map[string]struct{})
+	for _, r := range gs.FundingRates {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", r.Pair, r.Epoch)
+		if _, found := fundingRates[key]; found {
+			return fmt.Errorf("duplicate funding rate for %s on epoch %d", r.Pair, r.Epoch)
+		}
+		fundingRates[key] = struct{}{}
+	}
+
+	collateralDenoms := make(map[string]struct{})
+	for _, c := range gs.CollateralAssets {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := collateralDenoms[c.Denom]; found {
+			return fmt.Errorf("duplicate collateral asset %s", c.Denom)
+		}
+		collateralDenoms[c.Denom] = struct{}{}
+	}
+
+	pendingLossDenoms := make(map[string]struct{})
+	for _, l := range gs.PendingLosses {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := pendingLossDenoms[l.Denom]; found {
+			return fmt.Errorf("duplicate pending loss in %s", l.Denom)
+		}
+		pendingLossDenoms[l.Denom] = struct{}{}
+	}
+
 	return nil
 }
@@ -32,6 +32,18 @@ type GenesisState struct {
 	Amms             []AMM             `protobuf:"bytes,3,rep,name=amms,proto3" json:"amms"`
 	Positions        []Position        `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
 	ReserveSnapshots []ReserveSnapshot `protobuf:"bytes,5,rep,name=reserve_snapshots,json=reserveSnapshots,proto3" json:"reserve_snapshots"`
+	Orders           []Order           `protobuf:"bytes,6,rep,name=orders,proto3" json:"orders"`
+	// the id of the next order to be placed
+	NextOrderId              uint64                    `protobuf:"varint,7,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty"`
+	CrossMarginAccounts      []CrossMarginAccount      `protobuf:"bytes,8,rep,name=cross_margin_accounts,json=crossMarginAccounts,proto3" json:"cross_margin_accounts"`
+	InsuranceFundWithdrawals []InsuranceFundWithdrawal `protobuf:"bytes,9,rep,name=insurance_fund_withdrawals,json=insuranceFundWithdrawals,proto3" json:"insurance_fund_withdrawals"`
+	TraderVolumes            []TraderVolume            `protobuf:"bytes,10,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	ReferralCodes            []ReferralCode            `protobuf:"bytes,11,rep,name=referral_codes,json=referralCodes,proto3" json:"referral_codes"`
+	TraderReferrals          []TraderReferral          `protobuf:"bytes,12,rep,name=trader_referrals,json=traderReferrals,proto3" json:"trader_referrals"`
+	ReferralEarnings         []ReferralEarnings        `protobuf:"bytes,13,rep,name=referral_earnings,json=referralEarnings,proto3" json:"referral_earnings"`
+	FundingRates             []FundingRateRecord       `protobuf:"bytes,14,rep,name=funding_rates,json=fundingRates,proto3" json:"funding_rates"`
+	CollateralAssets         []CollateralAsset         `protobuf:"bytes,15,rep,name=collateral_assets,json=collateralAssets,proto3" json:"collateral_assets"`
+	PendingLosses            []PendingLoss             `protobuf:"bytes,16,rep,name=pending_losses,json=pendingLosses,proto3" json:"pending_losses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +114,83 @@ func (m *GenesisState) GetReserveSnapshots() []ReserveSnapshot {
 	return nil
 }
 
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOrderId() uint64 {
+	if m != nil {
+		return m.NextOrderId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCrossMarginAccounts() []CrossMarginAccount {
+	if m != nil {
+		return m.CrossMarginAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFundWithdrawals() []InsuranceFundWithdrawal {
+	if m != nil {
+		return m.InsuranceFundWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderVolumes() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralCodes() []ReferralCode {
+	if m != nil {
+		return m.ReferralCodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderReferrals() []TraderReferral {
+	if m != nil {
+		return m.TraderReferrals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralEarnings() []ReferralEarnings {
+	if m != nil {
+		return m.ReferralEarnings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingRates() []FundingRateRecord {
+	if m != nil {
+		return m.FundingRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralAssets() []CollateralAsset {
+	if m != nil {
+		return m.CollateralAssets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingLosses() []PendingLoss {
+	if m != nil {
+		return m.PendingLosses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v2.GenesisState")
 }
@@ -109,29 +198,49 @@ func init() {
 func init() { proto.RegisterFile("perp/v2/genesis.proto", fileDescriptor_8edcabc35f3cf683) }
 
 var fileDescriptor_8edcabc35f3cf683 = []byte{
-	// 346 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x4f, 0xf2, 0x40,
-	0x10, 0x86, 0x5b, 0xe0, 0xe3, 0x8b, 0xd5, 0x18, 0x2d, 0x6a, 0x1a, 0x62, 0x16, 0xe2, 0xc9, 0x0b,
-	0xdd, 0x50, 0x8d, 0x27, 0x2f, 0xe2, 0x81, 0x13, 0xc6, 0xc0, 0xcd, 0x8b, 0xd9, 0x92, 0x4d, 0xd9,
-	0x68, 0x77, 0x36, 0x3b, 0x4b, 0xa3, 0xff, 0xc2, 0x3f, 0xe4, 0x9d, 0x23, 0x47, 0x4f, 0xc6, 0xc0,
-	0x1f, 0x31, 0x6c, 0x97, 0x18, 0xd0, 0x5b, 0x33, 0xef, 0xf3, 0xbc, 0x3b, 0xcd, 0x04, 0xc7, 0x8a,
-	0x6b, 0x45, 0x8b, 0x84, 0x66, 0x5c, 0x72, 0x14, 0x18, 0x2b, 0x0d, 0x06, 0xc2, 0x7d, 0x29, 0x52,
-	0xa1, 0xa7, 0xf1, 0x2a, 0x8d, 0x8b, 0xa4, 0x79, 0x94, 0x41, 0x06, 0x36, 0xa2, 0xab, 0xaf, 0x92,
-	0x6a, 0x9e, 0x66, 0x00, 0xd9, 0x33, 0xa7, 0x4c, 0x09, 0xca, 0xa4, 0x04, 0xc3, 0x8c, 0x00, 0xe9,
-	0x3a, 0x9a, 0x64, 0x0c, 0x98, 0x03, 0xd2, 0x94, 0x21, 0xa7, 0x45, 0x37, 0xe5, 0x86, 0x75, 0xe9,
-	0x18, 0x84, 0x74, 0x79, 0x63, 0xfd, 0x34, 0x1a, 0x66, 0x78, 0x39, 0x3c, 0x7b, 0xaf, 0x04, 0x7b,
-	0xfd, 0x72, 0x95, 0xd1, 0x6a, 0x1c, 0x5e, 0x06, 0x75, 0xc5, 0x34, 0xcb, 0x31, 0xf2, 0xdb, 0xfe,
-	0xf9, 0x6e, 0x72, 0x12, 0x6f, 0xae, 0x16, 0xdf, 0xdb, 0xb4, 0x57, 0x9b, 0x7d, 0xb6, 0xbc, 0xa1,
-	0x63, 0xc3, 0xab, 0xe0, 0x7f, 0xce, 0xf4, 0x13, 0x37, 0x18, 0x55, 0xda, 0xd5, 0xbf, 0xb4, 0x81,
-	0x8d, 0x9d, 0xb6, 0x86, 0xc3, 0x4e, 0x50, 0x63, 0x79, 0x8e, 0x51, 0xd5, 0x4a, 0x8d, 0x6d, 0xe9,
-	0x66, 0x30, 0x70, 0x86, 0xc5, 0xc2, 0xeb, 0x60, 0x47, 0x01, 0x0a, 0xfb, 0xd7, 0x51, 0xcd, 0x3a,
-	0xd1, 0xaf, 0xfd, 0x1c, 0xe0, 0xc4, 0x1f, 0x21, 0x1c, 0x06, 0x87, 0x9a, 0x23, 0xd7, 0x05, 0x7f,
-	0x44, 0xc9, 0x14, 0x4e, 0xc0, 0x60, 0xf4, 0xcf, 0xb6, 0xb4, 0xb6, 0x5b, 0x86, 0x25, 0x38, 0x72,
-	0x9c, 0x2b, 0x3b, 0xd0, 0x9b, 0x63, 0xec, 0xf5, 0x67, 0x0b, 0xe2, 0xcf, 0x17, 0xc4, 0xff, 0x5a,
-	0x10, 0xff, 0x6d, 0x49, 0xbc, 0xf9, 0x92, 0x78, 0x1f, 0x4b, 0xe2, 0x3d, 0x74, 0x32, 0x61, 0x26,
-	0xd3, 0x34, 0x1e, 0x43, 0x4e, 0xef, 0x6c, 0xf9, 0xed, 0x84, 0x09, 0x49, 0xcb, 0x87, 0xe8, 0x0b,
-	0xb5, 0xe7, 0x30, 0xaf, 0x8a, 0x23, 0x2d, 0x92, 0xb4, 0x6e, 0xef, 0x71, 0xf1, 0x1d, 0x00, 0x00,
-	0xff, 0xff, 0xb9, 0x76, 0x4a, 0x8f, 0x21, 0x02, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x18, 0x85, 0x93, 0x4b, 0x6e, 0xb8, 0x0c, 0x24, 0x70, 0x87, 0xcb, 0xd5, 0x28, 0x45, 0x26, 0x65,
+	0x53, 0x36, 0xc4, 0x22, 0x54, 0x5d, 0x75, 0x03, 0x51, 0xa1, 0x48, 0xa4, 0x54, 0xa6, 0x6a, 0xa5,
+	0xaa, 0x92, 0x35, 0xb1, 0x07, 0x67, 0x84, 0x3d, 0x63, 0xcd, 0x3f, 0x0e, 0xf4, 0x11, 0xba, 0xeb,
+	0x63, 0xb1, 0x64, 0xd9, 0x55, 0x55, 0xc1, 0x8b, 0x54, 0x1e, 0x8f, 0x09, 0x31, 0xe9, 0x2e, 0x39,
+	0xff, 0xf7, 0x9f, 0x73, 0x26, 0xf1, 0x18, 0x6d, 0xa4, 0x4c, 0xa5, 0xee, 0xa4, 0xef, 0x46, 0x4c,
+	0x30, 0xe0, 0xd0, 0x4b, 0x95, 0xd4, 0x12, 0xb7, 0x05, 0x1f, 0x71, 0x95, 0xf5, 0xf2, 0x69, 0x6f,
+	0xd2, 0xef, 0xfc, 0x17, 0xc9, 0x48, 0x9a, 0x91, 0x9b, 0x7f, 0x2a, 0xa8, 0xce, 0x66, 0x24, 0x65,
+	0x14, 0x33, 0x97, 0xa6, 0xdc, 0xa5, 0x42, 0x48, 0x4d, 0x35, 0x97, 0xc2, 0x7a, 0x74, 0x9c, 0x40,
+	0x42, 0x22, 0xc1, 0x1d, 0x51, 0x60, 0xee, 0x64, 0x6f, 0xc4, 0x34, 0xdd, 0x73, 0x03, 0xc9, 0x85,
+	0x9d, 0xaf, 0x97, 0xd1, 0xa0, 0xa9, 0x66, 0x85, 0xb8, 0xfd, 0x6d, 0x09, 0xad, 0x1c, 0x17, 0x55,
+	0xce, 0x73, 0x19, 0xbf, 0x44, 0xcd, 0x94, 0x2a, 0x9a, 0x00, 0xa9, 0x77, 0xeb, 0x3b, 0xcb, 0xfd,
+	0xff, 0x7b, 0xb3, 0xd5, 0x7a, 0xef, 0xcd, 0xf4, 0xb0, 0x71, 0xf3, 0x73, 0xab, 0xe6, 0x59, 0x16,
+	0xbf, 0x42, 0x8b, 0x09, 0x55, 0x97, 0x4c, 0x03, 0xf9, 0xab, 0xbb, 0x30, 0x6f, 0x6d, 0x68, 0xc6,
+	0x76, 0xad, 0x84, 0xf1, 0x2e, 0x6a, 0xd0, 0x24, 0x01, 0xb2, 0x60, 0x96, 0xd6, 0xab, 0x4b, 0x07,
+	0xc3, 0xa1, 0xdd, 0x30, 0x18, 0x7e, 0x8d, 0x96, 0x52, 0x09, 0xdc, 0x9c, 0x9a, 0x34, 0xcc, 0x0e,
+	0x79, 0xd2, 0xcf, 0x02, 0x76, 0x71, 0xba, 0x80, 0x3d, 0xf4, 0xaf, 0x62, 0xc0, 0xd4, 0x84, 0xf9,
+	0x20, 0x68, 0x0a, 0x63, 0xa9, 0x81, 0xfc, 0x6d, 0x5c, 0xb6, 0xaa, 0x2e, 0x5e, 0x01, 0x9e, 0x5b,
+	0xce, 0x9a, 0xad, 0xa9, 0x59, 0x19, 0xf0, 0x3e, 0x6a, 0x4a, 0x15, 0x32, 0x05, 0xa4, 0x69, 0x8c,
+	0x36, 0xaa, 0x46, 0x67, 0xf9, 0xb4, 0xfc, 0xb5, 0x0a, 0x14, 0x6f, 0xa3, 0x96, 0x60, 0xd7, 0xda,
+	0x37, 0x5f, 0x7d, 0x1e, 0x92, 0xc5, 0x6e, 0x7d, 0xa7, 0xe1, 0x2d, 0xe7, 0xa2, 0xe1, 0x4f, 0x42,
+	0xfc, 0x05, 0x6d, 0x04, 0x4a, 0x02, 0xf8, 0x09, 0x55, 0x11, 0x17, 0x3e, 0x0d, 0x02, 0x99, 0x09,
+	0x0d, 0xe4, 0x1f, 0x93, 0xb3, 0x5d, 0xcd, 0x19, 0xe4, 0xf0, 0xd0, 0xb0, 0x07, 0x05, 0x6a, 0x43,
+	0xd7, 0x83, 0x27, 0x13, 0xc0, 0x97, 0xa8, 0xc3, 0x05, 0x64, 0x8a, 0x8a, 0x80, 0xf9, 0x17, 0x99,
+	0x08, 0xfd, 0x2b, 0xae, 0xc7, 0xa1, 0xa2, 0x57, 0x34, 0x06, 0xb2, 0x64, 0x22, 0x5e, 0x54, 0x23,
+	0x4e, 0xca, 0x8d, 0xa3, 0x4c, 0x84, 0x9f, 0x1e, 0x78, 0x9b, 0x43, 0xf8, 0xfc, 0x31, 0xe0, 0x13,
+	0xd4, 0xd6, 0x8a, 0xe6, 0x47, 0x9d, 0xc8, 0x38, 0x4b, 0x18, 0x10, 0x64, 0x02, 0x36, 0xab, 0x01,
+	0x1f, 0x0c, 0xf5, 0xd1, 0x40, 0xd6, 0xb5, 0xa5, 0x1f, 0x69, 0xc6, 0x4a, 0xb1, 0x0b, 0xa6, 0x14,
+	0x8d, 0xfd, 0x40, 0x86, 0x0c, 0xc8, 0xf2, 0x7c, 0x2b, 0xcf, 0x52, 0x03, 0x19, 0x3e, 0x58, 0xa9,
+	0x47, 0x1a, 0xe0, 0x33, 0xb4, 0x66, 0x5b, 0x95, 0x3a, 0x90, 0x15, 0x63, 0xe6, 0xcc, 0xef, 0x55,
+	0x5a, 0x5a, 0xbb, 0x55, 0x3d, 0xa3, 0x02, 0x3e, 0xcf, 0x1f, 0x2f, 0xdb, 0x8d, 0x51, 0x25, 0xb8,
+	0x88, 0x80, 0xb4, 0x8c, 0x63, 0xf7, 0x4f, 0xf5, 0xde, 0x58, 0x6e, 0xfa, 0x7c, 0xcd, 0xea, 0xf8,
+	0x14, 0xb5, 0xf2, 0xbf, 0x87, 0x8b, 0xc8, 0x57, 0x54, 0x33, 0x20, 0x6d, 0x63, 0xf8, 0xbc, 0x6a,
+	0x78, 0x54, 0x40, 0x1e, 0xd5, 0xcc, 0x63, 0x81, 0x54, 0xa1, 0x75, 0x5c, 0xb9, 0x98, 0x0e, 0xcc,
+	0x0d, 0x08, 0x64, 0x1c, 0x53, 0xcd, 0xf2, 0x92, 0x14, 0x20, 0xbf, 0xb0, 0xab, 0xf3, 0x6f, 0xc0,
+	0xe0, 0x01, 0x3c, 0xc8, 0xb9, 0xb2, 0x61, 0x30, 0x2b, 0x03, 0x7e, 0x8b, 0xda, 0x29, 0x2b, 0x1a,
+	0xc6, 0x12, 0x80, 0x01, 0x59, 0x33, 0x86, 0xcf, 0x9e, 0x5c, 0xcc, 0x82, 0x3a, 0x95, 0x50, 0x1e,
+	0xb7, 0x95, 0x4e, 0x25, 0x06, 0x87, 0xc7, 0x37, 0x77, 0x4e, 0xfd, 0xf6, 0xce, 0xa9, 0xff, 0xba,
+	0x73, 0xea, 0xdf, 0xef, 0x9d, 0xda, 0xed, 0xbd, 0x53, 0xfb, 0x71, 0xef, 0xd4, 0x3e, 0xef, 0x46,
+	0x5c, 0x8f, 0xb3, 0x51, 0x2f, 0x90, 0x89, 0xfb, 0xce, 0xb8, 0x0e, 0xc6, 0x94, 0x0b, 0xb7, 0x48,
+	0x70, 0xaf, 0x5d, 0xf3, 0x6a, 0xd3, 0x5f, 0x53, 0x06, 0xee, 0xa4, 0x3f, 0x6a, 0x9a, 0x77, 0xdb,
+	0xfe, 0xef, 0x01, 0x00, 0x4e, 0x00, 0xde, 0xb8, 0x6d, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -154,6 +263,153 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingLosses) > 0 {
+		for iNdEx := len(m.PendingLosses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingLosses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.CollateralAssets) > 0 {
+		for iNdEx := len(m.CollateralAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for iNdEx := len(m.FundingRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ReferralEarnings) > 0 {
+		for iNdEx := len(m.ReferralEarnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralEarnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TraderReferrals) > 0 {
+		for iNdEx := len(m.TraderReferrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderReferrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ReferralCodes) > 0 {
+		for iNdEx := len(m.ReferralCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.InsuranceFundWithdrawals) > 0 {
+		for iNdEx := len(m.InsuranceFundWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFundWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for iNdEx := len(m.CrossMarginAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossMarginAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NextOrderId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ReserveSnapshots) > 0 {
 		for iNdEx := len(m.ReserveSnapshots) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -266,6 +522,69 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderId))
+	}
+	if len(m.CrossMarginAccounts) > 0 {
+		for _, e := range m.CrossMarginAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFundWithdrawals) > 0 {
+		for _, e := range m.InsuranceFundWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralCodes) > 0 {
+		for _, e := range m.ReferralCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderReferrals) > 0 {
+		for _, e := range m.TraderReferrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralEarnings) > 0 {
+		for _, e := range m.ReferralEarnings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for _, e := range m.FundingRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralAssets) > 0 {
+		for _, e := range m.CollateralAssets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingLosses) > 0 {
+		for _, e := range m.PendingLosses {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -473,6 +792,365 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderId", wireType)
+			}
+			m.NextOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMarginAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossMarginAccounts = append(m.CrossMarginAccounts, CrossMarginAccount{})
+			if err := m.CrossMarginAccounts[len(m.CrossMarginAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundWithdrawals = append(m.InsuranceFundWithdrawals, InsuranceFundWithdrawal{})
+			if err := m.InsuranceFundWithdrawals[len(m.InsuranceFundWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderVolumes = append(m.TraderVolumes, TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralCodes = append(m.ReferralCodes, ReferralCode{})
+			if err := m.ReferralCodes[len(m.ReferralCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderReferrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderReferrals = append(m.TraderReferrals, TraderReferral{})
+			if err := m.TraderReferrals[len(m.TraderReferrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralEarnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferralEarnings = append(m.ReferralEarnings, ReferralEarnings{})
+			if err := m.ReferralEarnings[len(m.ReferralEarnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRates = append(m.FundingRates, FundingRateRecord{})
+			if err := m.FundingRates[len(m.FundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralAssets = append(m.CollateralAssets, CollateralAsset{})
+			if err := m.CollateralAssets[len(m.CollateralAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingLosses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingLosses = append(m.PendingLosses, PendingLoss{})
+			if err := m.PendingLosses[len(m.PendingLosses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
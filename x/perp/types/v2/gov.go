@@ -0,0 +1,193 @@
+package v2
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeCreateMarket        = "CreateMarket"
+	ProposalTypeEditMarket          = "EditMarket"
+	ProposalTypeEditPriceMultiplier = "EditPriceMultiplier"
+	ProposalTypeEditSwapInvariant   = "EditSwapInvariant"
+	ProposalTypeSettleMarket        = "SettleMarket"
+	ProposalTypeSetCollateralAsset  = "SetCollateralAsset"
+)
+
+var _ govtypes.Content = &CreateMarketProposal{}
+var _ govtypes.Content = &EditMarketProposal{}
+var _ govtypes.Content = &EditPriceMultiplierProposal{}
+var _ govtypes.Content = &EditSwapInvariantProposal{}
+var _ govtypes.Content = &SettleMarketProposal{}
+var _ govtypes.Content = &SetCollateralAssetProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCreateMarket)
+	govtypes.RegisterProposalTypeCodec(&CreateMarketProposal{}, "perpv2/CreateMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeEditMarket)
+	govtypes.RegisterProposalTypeCodec(&EditMarketProposal{}, "perpv2/EditMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeEditPriceMultiplier)
+	govtypes.RegisterProposalTypeCodec(&EditPriceMultiplierProposal{}, "perpv2/EditPriceMultiplierProposal")
+	govtypes.RegisterProposalType(ProposalTypeEditSwapInvariant)
+	govtypes.RegisterProposalTypeCodec(&EditSwapInvariantProposal{}, "perpv2/EditSwapInvariantProposal")
+	govtypes.RegisterProposalType(ProposalTypeSettleMarket)
+	govtypes.RegisterProposalTypeCodec(&SettleMarketProposal{}, "perpv2/SettleMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetCollateralAsset)
+	govtypes.RegisterProposalTypeCodec(&SetCollateralAssetProposal{}, "perpv2/SetCollateralAssetProposal")
+}
+
+// CreateMarketProposal
+
+func (proposal *CreateMarketProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *CreateMarketProposal) ProposalType() string {
+	return ProposalTypeCreateMarket
+}
+
+func (proposal *CreateMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	if err := proposal.Market.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if err := proposal.Market.Validate(); err != nil {
+		return err
+	}
+
+	if proposal.SqrtDepth.IsNil() || proposal.PriceMultiplier.IsNil() {
+		return fmt.Errorf("sqrt depth and price multiplier must be set")
+	}
+
+	amm := AMM{
+		Pair:            proposal.Market.Pair,
+		BaseReserve:     proposal.SqrtDepth,
+		QuoteReserve:    proposal.SqrtDepth,
+		SqrtDepth:       proposal.SqrtDepth,
+		PriceMultiplier: proposal.PriceMultiplier,
+		TotalLong:       sdk.ZeroDec(),
+		TotalShort:      sdk.ZeroDec(),
+	}
+
+	return amm.Validate()
+}
+
+// EditMarketProposal
+
+func (proposal *EditMarketProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *EditMarketProposal) ProposalType() string {
+	return ProposalTypeEditMarket
+}
+
+func (proposal *EditMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	if err := proposal.Market.Pair.Validate(); err != nil {
+		return err
+	}
+
+	return proposal.Market.Validate()
+}
+
+// EditPriceMultiplierProposal
+
+func (proposal *EditPriceMultiplierProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *EditPriceMultiplierProposal) ProposalType() string {
+	return ProposalTypeEditPriceMultiplier
+}
+
+func (proposal *EditPriceMultiplierProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	if err := proposal.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if proposal.PriceMultiplier.IsNil() || !proposal.PriceMultiplier.IsPositive() {
+		return ErrNonPositivePegMultiplier
+	}
+
+	return nil
+}
+
+// EditSwapInvariantProposal
+
+func (proposal *EditSwapInvariantProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *EditSwapInvariantProposal) ProposalType() string {
+	return ProposalTypeEditSwapInvariant
+}
+
+func (proposal *EditSwapInvariantProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	if err := proposal.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if proposal.SwapInvariant.IsNil() {
+		return ErrNilSwapInvariantMutliplier
+	}
+
+	if !proposal.SwapInvariant.IsPositive() {
+		return ErrNonPositiveSwapInvariantMutliplier
+	}
+
+	return nil
+}
+
+// SettleMarketProposal
+
+func (proposal *SettleMarketProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *SettleMarketProposal) ProposalType() string {
+	return ProposalTypeSettleMarket
+}
+
+func (proposal *SettleMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.Pair.Validate()
+}
+
+// SetCollateralAssetProposal
+
+func (proposal *SetCollateralAssetProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *SetCollateralAssetProposal) ProposalType() string {
+	return ProposalTypeSetCollateralAsset
+}
+
+func (proposal *SetCollateralAssetProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.CollateralAsset.Validate()
+}
@@ -0,0 +1,161 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	v2 "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestCreateMarketProposal_ValidateBasic(t *testing.T) {
+	invalidMarket := mock.TestMarket()
+	invalidMarket.MaxLeverage = sdk.ZeroDec()
+
+	tests := []struct {
+		name      string
+		proposal  v2.CreateMarketProposal
+		expectErr bool
+	}{
+		{
+			name: "success",
+			proposal: v2.CreateMarketProposal{
+				Title:           "create market",
+				Description:     "list BTC:NUSD",
+				Market:          *mock.TestMarket(),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+			},
+		},
+		{
+			name: "missing title",
+			proposal: v2.CreateMarketProposal{
+				Description:     "list BTC:NUSD",
+				Market:          *mock.TestMarket(),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid market",
+			proposal: v2.CreateMarketProposal{
+				Title:           "create market",
+				Description:     "list BTC:NUSD",
+				Market:          *invalidMarket,
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty market",
+			proposal: v2.CreateMarketProposal{
+				Title:           "create market",
+				Description:     "list BTC:NUSD",
+				Market:          v2.Market{Pair: asset.NewPair(denoms.BTC, denoms.NUSD)},
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.OneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "nil sqrt depth",
+			proposal: v2.CreateMarketProposal{
+				Title:           "create market",
+				Description:     "list BTC:NUSD",
+				Market:          *mock.TestMarket(),
+				PriceMultiplier: sdk.OneDec(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "non positive price multiplier",
+			proposal: v2.CreateMarketProposal{
+				Title:           "create market",
+				Description:     "list BTC:NUSD",
+				Market:          *mock.TestMarket(),
+				SqrtDepth:       sdk.NewDec(1e6),
+				PriceMultiplier: sdk.ZeroDec(),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEditPriceMultiplierProposal_ValidateBasic(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	require.NoError(t, (&v2.EditPriceMultiplierProposal{
+		Title:           "repeg",
+		Description:     "repeg BTC:NUSD",
+		Pair:            pair,
+		PriceMultiplier: sdk.NewDec(2),
+	}).ValidateBasic())
+
+	require.ErrorIs(t, (&v2.EditPriceMultiplierProposal{
+		Title:           "repeg",
+		Description:     "repeg BTC:NUSD",
+		Pair:            pair,
+		PriceMultiplier: sdk.NewDec(-2),
+	}).ValidateBasic(), v2.ErrNonPositivePegMultiplier)
+}
+
+func TestEditSwapInvariantProposal_ValidateBasic(t *testing.T) {
+	pair := asset.NewPair(denoms.BTC, denoms.NUSD)
+
+	require.NoError(t, (&v2.EditSwapInvariantProposal{
+		Title:         "depth",
+		Description:   "deepen BTC:NUSD",
+		Pair:          pair,
+		SwapInvariant: sdk.NewDec(1e12),
+	}).ValidateBasic())
+
+	require.ErrorIs(t, (&v2.EditSwapInvariantProposal{
+		Title:       "depth",
+		Description: "deepen BTC:NUSD",
+		Pair:        pair,
+	}).ValidateBasic(), v2.ErrNilSwapInvariantMutliplier)
+
+	require.ErrorIs(t, (&v2.EditSwapInvariantProposal{
+		Title:         "depth",
+		Description:   "deepen BTC:NUSD",
+		Pair:          pair,
+		SwapInvariant: sdk.ZeroDec(),
+	}).ValidateBasic(), v2.ErrNonPositiveSwapInvariantMutliplier)
+}
+
+func TestSettleMarketProposal_ValidateBasic(t *testing.T) {
+	require.NoError(t, (&v2.SettleMarketProposal{
+		Title:       "settle",
+		Description: "settle BTC:NUSD",
+		Pair:        asset.NewPair(denoms.BTC, denoms.NUSD),
+	}).ValidateBasic())
+
+	require.Error(t, (&v2.SettleMarketProposal{
+		Title:       "settle",
+		Description: "settle an invalid pair",
+		Pair:        "invalid",
+	}).ValidateBasic())
+
+	require.Error(t, (&v2.SettleMarketProposal{
+		Description: "settle BTC:NUSD",
+		Pair:        asset.NewPair(denoms.BTC, denoms.NUSD),
+	}).ValidateBasic())
+}
@@ -0,0 +1,76 @@
+package v2
+
+import (
+	fmt "fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InsuranceFundSharePrefix prefixes the denom of the insurance fund shares of
+// a quote denom.
+const InsuranceFundSharePrefix = "insurance/"
+
+// InsuranceFundLockedShares is the number of shares the first deposit of a
+// quote denom locks in the insurance fund, on top of one share per quote token
+// already in the fund. The locked shares keep the share supply from being
+// redeemed down to a few shares whose price can be inflated by a donation.
+const InsuranceFundLockedShares = 1_000
+
+// InsuranceFundShareDenom returns the denom of the insurance fund shares of the
+// quote denom.
+func InsuranceFundShareDenom(quoteDenom string) string {
+	return InsuranceFundSharePrefix + quoteDenom
+}
+
+// QuoteDenomOfShares returns the quote denom of an insurance fund share denom.
+func QuoteDenomOfShares(shareDenom string) (string, error) {
+	if !strings.HasPrefix(shareDenom, InsuranceFundSharePrefix) {
+		return "", fmt.Errorf("%s is not an insurance fund share denom", shareDenom)
+	}
+	return strings.TrimPrefix(shareDenom, InsuranceFundSharePrefix), nil
+}
+
+func (m *InsuranceFundWithdrawal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.StakerAddress); err != nil {
+		return err
+	}
+
+	if err := m.Shares.Validate(); err != nil {
+		return err
+	}
+
+	if m.Shares.Empty() {
+		return fmt.Errorf("no shares to withdraw")
+	}
+
+	for _, share := range m.Shares {
+		if _, err := QuoteDenomOfShares(share.Denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PendingLoss) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return fmt.Errorf("pending loss must be positive, got %s", m.Amount)
+	}
+
+	if m.Cursor != nil {
+		if err := m.Cursor.Pair.Validate(); err != nil {
+			return err
+		}
+
+		if _, err := sdk.AccAddressFromBech32(m.Cursor.TraderAddress); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,12 @@
 package v2
 
 const (
-	ModuleName           = "v2perp"
-	VaultModuleAccount   = "vault"
-	PerpEFModuleAccount  = "perp_ef"
-	FeePoolModuleAccount = "fee_pool"
+	ModuleName                 = "v2perp"
+	VaultModuleAccount         = "vault"
+	PerpEFModuleAccount        = "perp_ef"
+	FeePoolModuleAccount       = "fee_pool"
+	InsuranceFundModuleAccount = "perp_insurance_fund"
+	ReferralPoolModuleAccount  = "perp_referral_pool"
 )
 
 var (
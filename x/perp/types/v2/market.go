@@ -9,7 +9,7 @@ import (
 )
 
 func isPercent(v sdk.Dec) bool {
-	return v.GTE(sdk.ZeroDec()) && v.LTE(sdk.OneDec())
+	return !v.IsNil() && v.GTE(sdk.ZeroDec()) && v.LTE(sdk.OneDec())
 }
 
 func (market *Market) Validate() error {
@@ -37,7 +37,7 @@ func (market *Market) Validate() error {
 		return fmt.Errorf("partial liquidation ratio must be 0 <= ratio <= 1")
 	}
 
-	if market.MaxLeverage.LTE(sdk.ZeroDec()) {
+	if market.MaxLeverage.IsNil() || market.MaxLeverage.LTE(sdk.ZeroDec()) {
 		return fmt.Errorf("max leverage must be > 0")
 	}
 
@@ -45,6 +45,38 @@ func (market *Market) Validate() error {
 		return fmt.Errorf("margin ratio opened with max leverage position will be lower than Maintenance margin ratio")
 	}
 
+	if !market.RepegDivergenceThreshold.IsNil() && market.RepegDivergenceThreshold.IsNegative() {
+		return fmt.Errorf("repeg divergence threshold must be >= 0")
+	}
+
+	if !market.RepegBudgetPerEpoch.IsNil() && market.RepegBudgetPerEpoch.IsNegative() {
+		return fmt.Errorf("repeg budget per epoch must be >= 0")
+	}
+
+	if !market.MaxOpenInterest.IsNil() && market.MaxOpenInterest.IsNegative() {
+		return fmt.Errorf("max open interest must be >= 0")
+	}
+
+	if !market.MaxTraderNotional.IsNil() && market.MaxTraderNotional.IsNegative() {
+		return fmt.Errorf("max trader notional must be >= 0")
+	}
+
+	if !market.MaxBias.IsNil() && market.MaxBias.IsNegative() {
+		return fmt.Errorf("max bias must be >= 0")
+	}
+
+	if !market.MaxFundingRate.IsNil() && market.MaxFundingRate.IsNegative() {
+		return fmt.Errorf("max funding rate must be >= 0")
+	}
+
+	if !market.FundingInterestRate.IsNil() && market.FundingInterestRate.Abs().GT(sdk.OneDec()) {
+		return fmt.Errorf("funding interest rate must be -1 <= rate <= 1")
+	}
+
+	if !market.FundingDampener.IsNil() && !isPercent(market.FundingDampener) {
+		return fmt.Errorf("funding dampener must be 0 <= dampener <= 1")
+	}
+
 	return nil
 }
 
@@ -157,3 +189,93 @@ func MarketsAreEqual(expected, actual *Market) error {
 
 	return nil
 }
+
+func (market *Market) WithRepegDivergenceThreshold(value sdk.Dec) *Market {
+	market.RepegDivergenceThreshold = value
+	return market
+}
+
+func (market *Market) WithRepegBudgetPerEpoch(value sdk.Int) *Market {
+	market.RepegBudgetPerEpoch = value
+	return market
+}
+
+func (market *Market) WithMaxOpenInterest(value sdk.Dec) *Market {
+	market.MaxOpenInterest = value
+	return market
+}
+
+func (market *Market) WithMaxTraderNotional(value sdk.Dec) *Market {
+	market.MaxTraderNotional = value
+	return market
+}
+
+func (market *Market) WithMaxBias(value sdk.Dec) *Market {
+	market.MaxBias = value
+	return market
+}
+
+func (market *Market) WithMaxFundingRate(value sdk.Dec) *Market {
+	market.MaxFundingRate = value
+	return market
+}
+
+func (market *Market) WithFundingInterestRate(value sdk.Dec) *Market {
+	market.FundingInterestRate = value
+	return market
+}
+
+func (market *Market) WithFundingDampener(value sdk.Dec) *Market {
+	market.FundingDampener = value
+	return market
+}
+
+// PremiumFraction returns the premium fraction longs pay shorts per unit of
+// base asset over a funding epoch, out of the mark and index TWAPs:
+//
+//	premium = mark - index, less the dampener band of the market in absolute value
+//	premiumFraction = (premium + interestRate * index) / intervalsPerDay
+//
+// The premium fraction is then clamped to ±maxFundingRate * index. Unset
+// funding parameters are ignored.
+//
+// returns:
+//   - premiumFraction: the premium fraction of the epoch
+//   - clamped: true if the premium fraction was clamped
+func (market *Market) PremiumFraction(
+	markTwap sdk.Dec, indexTwap sdk.Dec, intervalsPerDay int64,
+) (premiumFraction sdk.Dec, clamped bool) {
+	premium := markTwap.Sub(indexTwap)
+
+	if dampener := market.FundingDampener; !dampener.IsNil() && dampener.IsPositive() {
+		band := dampener.Mul(indexTwap)
+		switch {
+		case premium.Abs().LTE(band):
+			premium = sdk.ZeroDec()
+		case premium.IsPositive():
+			premium = premium.Sub(band)
+		default:
+			premium = premium.Add(band)
+		}
+	}
+
+	if interestRate := market.FundingInterestRate; !interestRate.IsNil() && !interestRate.IsZero() {
+		premium = premium.Add(interestRate.Mul(indexTwap))
+	}
+
+	premiumFraction = premium.QuoInt64(intervalsPerDay)
+
+	if maxRate := market.MaxFundingRate; !maxRate.IsNil() && maxRate.IsPositive() {
+		maxPremiumFraction := maxRate.Mul(indexTwap)
+		if premiumFraction.Abs().GT(maxPremiumFraction) {
+			if premiumFraction.IsPositive() {
+				premiumFraction = maxPremiumFraction
+			} else {
+				premiumFraction = maxPremiumFraction.Neg()
+			}
+			clamped = true
+		}
+	}
+
+	return premiumFraction, clamped
+}
@@ -0,0 +1,93 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+)
+
+func TestPremiumFraction(t *testing.T) {
+	for _, tc := range []struct {
+		name                    string
+		maxFundingRate          sdk.Dec
+		interestRate            sdk.Dec
+		dampener                sdk.Dec
+		markTwap                sdk.Dec
+		expectedPremiumFraction sdk.Dec
+		expectedClamped         bool
+	}{
+		{
+			name:                    "plain premium",
+			markTwap:                sdk.NewDec(11),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.05"),
+		},
+		{
+			name:                    "clamped up",
+			maxFundingRate:          sdk.MustNewDecFromStr("0.001"),
+			markTwap:                sdk.NewDec(11),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.01"),
+			expectedClamped:         true,
+		},
+		{
+			name:                    "clamped down",
+			maxFundingRate:          sdk.MustNewDecFromStr("0.001"),
+			markTwap:                sdk.NewDec(9),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("-0.01"),
+			expectedClamped:         true,
+		},
+		{
+			name:                    "within the max funding rate",
+			maxFundingRate:          sdk.MustNewDecFromStr("0.01"),
+			markTwap:                sdk.NewDec(9),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("-0.05"),
+		},
+		{
+			name:                    "interest rate added",
+			interestRate:            sdk.MustNewDecFromStr("0.02"),
+			markTwap:                sdk.NewDec(10),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:                    "premium within the dampener band",
+			dampener:                sdk.MustNewDecFromStr("0.1"),
+			markTwap:                sdk.NewDec(9),
+			expectedPremiumFraction: sdk.ZeroDec(),
+		},
+		{
+			name:                    "premium reduced by the dampener band",
+			dampener:                sdk.MustNewDecFromStr("0.05"),
+			markTwap:                sdk.NewDec(12),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("0.075"),
+		},
+		{
+			name:                    "dampener, interest and clamp combined",
+			maxFundingRate:          sdk.MustNewDecFromStr("0.005"),
+			interestRate:            sdk.MustNewDecFromStr("0.01"),
+			dampener:                sdk.MustNewDecFromStr("0.05"),
+			markTwap:                sdk.NewDec(8),
+			expectedPremiumFraction: sdk.MustNewDecFromStr("-0.05"),
+			expectedClamped:         true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			market := mock.TestMarket()
+			if !tc.maxFundingRate.IsNil() {
+				market.WithMaxFundingRate(tc.maxFundingRate)
+			}
+			if !tc.interestRate.IsNil() {
+				market.WithFundingInterestRate(tc.interestRate)
+			}
+			if !tc.dampener.IsNil() {
+				market.WithFundingDampener(tc.dampener)
+			}
+
+			// index price of 10 and 20 funding epochs a day
+			premiumFraction, clamped := market.PremiumFraction(tc.markTwap, sdk.NewDec(10), 20)
+			require.Equal(t, tc.expectedPremiumFraction, premiumFraction)
+			require.Equal(t, tc.expectedClamped, clamped)
+		})
+	}
+}
@@ -11,7 +11,21 @@ var _ sdk.Msg = &MsgRemoveMargin{}
 var _ sdk.Msg = &MsgAddMargin{}
 var _ sdk.Msg = &MsgOpenPosition{}
 var _ sdk.Msg = &MsgClosePosition{}
+var _ sdk.Msg = &MsgPartialClose{}
 var _ sdk.Msg = &MsgMultiLiquidate{}
+var _ sdk.Msg = &MsgSettlePosition{}
+var _ sdk.Msg = &MsgPlaceOrder{}
+var _ sdk.Msg = &MsgCancelOrder{}
+var _ sdk.Msg = &MsgReplaceOrder{}
+var _ sdk.Msg = &MsgEnableCrossMargin{}
+var _ sdk.Msg = &MsgDisableCrossMargin{}
+var _ sdk.Msg = &MsgAddCrossMarginCollateral{}
+var _ sdk.Msg = &MsgRemoveCrossMarginCollateral{}
+var _ sdk.Msg = &MsgDepositInsuranceFund{}
+var _ sdk.Msg = &MsgWithdrawInsuranceFund{}
+var _ sdk.Msg = &MsgRegisterReferralCode{}
+var _ sdk.Msg = &MsgSetReferrer{}
+var _ sdk.Msg = &MsgClaimReferralEarnings{}
 
 // MsgRemoveMargin
 
@@ -185,6 +199,39 @@ func (m MsgClosePosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{signer}
 }
 
+// MsgPartialClose
+
+func (m MsgPartialClose) Route() string { return "perp" }
+func (m MsgPartialClose) Type() string  { return "partial_close_msg" }
+
+func (m MsgPartialClose) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.SizeAmount.IsNil() || !m.SizeAmount.IsPositive() {
+		return fmt.Errorf("size amount must be always greater than zero")
+	}
+	if !m.QuoteAssetAmountLimit.IsNil() && m.QuoteAssetAmountLimit.IsNegative() {
+		return fmt.Errorf("quote asset amount limit must not be negative")
+	}
+	return nil
+}
+
+func (m MsgPartialClose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPartialClose) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
 // MsgDonateToEcosystemFund
 
 func (m MsgDonateToEcosystemFund) Route() string { return "perp" }
@@ -211,3 +258,358 @@ func (m MsgDonateToEcosystemFund) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// MsgSettlePosition
+
+func (m MsgSettlePosition) Route() string { return "perp" }
+func (m MsgSettlePosition) Type() string  { return "settle_position_msg" }
+
+func (m MsgSettlePosition) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgSettlePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSettlePosition) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgPlaceOrder
+
+func (m MsgPlaceOrder) Route() string { return "perp" }
+func (m MsgPlaceOrder) Type() string  { return "place_order_msg" }
+
+func (m MsgPlaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	order := m.NewOrder()
+	return order.Validate()
+}
+
+func (m MsgPlaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgCancelOrder
+
+func (m MsgCancelOrder) Route() string { return "perp" }
+func (m MsgCancelOrder) Type() string  { return "cancel_order_msg" }
+
+func (m MsgCancelOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgCancelOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgReplaceOrder
+
+func (m MsgReplaceOrder) Route() string { return "perp" }
+func (m MsgReplaceOrder) Type() string  { return "replace_order_msg" }
+
+func (m MsgReplaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+	if !m.QuoteAssetAmount.IsNil() && m.QuoteAssetAmount.IsNegative() {
+		return fmt.Errorf("quote asset amount must not be negative")
+	}
+	if !m.Leverage.IsNil() && m.Leverage.IsNegative() {
+		return fmt.Errorf("leverage must not be negative")
+	}
+	if !m.BaseAssetAmountLimit.IsNil() && m.BaseAssetAmountLimit.IsNegative() {
+		return fmt.Errorf("base asset amount limit must not be negative")
+	}
+	return nil
+}
+
+func (m MsgReplaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgReplaceOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgEnableCrossMargin
+
+func (m MsgEnableCrossMargin) Route() string { return "perp" }
+func (m MsgEnableCrossMargin) Type() string  { return "enable_cross_margin_msg" }
+
+func (m MsgEnableCrossMargin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgEnableCrossMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgEnableCrossMargin) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgDisableCrossMargin
+
+func (m MsgDisableCrossMargin) Route() string { return "perp" }
+func (m MsgDisableCrossMargin) Type() string  { return "disable_cross_margin_msg" }
+
+func (m MsgDisableCrossMargin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgDisableCrossMargin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDisableCrossMargin) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgAddCrossMarginCollateral
+
+func (m MsgAddCrossMarginCollateral) Route() string { return "perp" }
+func (m MsgAddCrossMarginCollateral) Type() string  { return "add_cross_margin_collateral_msg" }
+
+func (m MsgAddCrossMarginCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !m.Collateral.Amount.IsPositive() {
+		return fmt.Errorf("collateral must be positive, not: %v", m.Collateral.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgAddCrossMarginCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddCrossMarginCollateral) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgRemoveCrossMarginCollateral
+
+func (m MsgRemoveCrossMarginCollateral) Route() string { return "perp" }
+func (m MsgRemoveCrossMarginCollateral) Type() string  { return "remove_cross_margin_collateral_msg" }
+
+func (m MsgRemoveCrossMarginCollateral) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Collateral.Validate(); err != nil {
+		return err
+	}
+	if !m.Collateral.Amount.IsPositive() {
+		return fmt.Errorf("collateral must be positive, not: %v", m.Collateral.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgRemoveCrossMarginCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRemoveCrossMarginCollateral) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgDepositInsuranceFund
+
+func (m MsgDepositInsuranceFund) Route() string { return "perp" }
+func (m MsgDepositInsuranceFund) Type() string  { return "deposit_insurance_fund_msg" }
+
+func (m MsgDepositInsuranceFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Amount.Validate(); err != nil {
+		return err
+	}
+	if !m.Amount.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive, not: %v", m.Amount.Amount.String())
+	}
+	return nil
+}
+
+func (m MsgDepositInsuranceFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgDepositInsuranceFund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgWithdrawInsuranceFund
+
+func (m MsgWithdrawInsuranceFund) Route() string { return "perp" }
+func (m MsgWithdrawInsuranceFund) Type() string  { return "withdraw_insurance_fund_msg" }
+
+func (m MsgWithdrawInsuranceFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Shares.Validate(); err != nil {
+		return err
+	}
+	if !m.Shares.Amount.IsPositive() {
+		return fmt.Errorf("shares must be positive, not: %v", m.Shares.Amount.String())
+	}
+	if _, err := QuoteDenomOfShares(m.Shares.Denom); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgWithdrawInsuranceFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgWithdrawInsuranceFund) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgRegisterReferralCode
+
+func (m MsgRegisterReferralCode) Route() string { return "perp" }
+func (m MsgRegisterReferralCode) Type() string  { return "register_referral_code_msg" }
+
+func (m MsgRegisterReferralCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateReferralCode(m.Code)
+}
+
+func (m MsgRegisterReferralCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterReferralCode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSetReferrer
+
+func (m MsgSetReferrer) Route() string { return "perp" }
+func (m MsgSetReferrer) Type() string  { return "set_referrer_msg" }
+
+func (m MsgSetReferrer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateReferralCode(m.Code)
+}
+
+func (m MsgSetReferrer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetReferrer) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgClaimReferralEarnings
+
+func (m MsgClaimReferralEarnings) Route() string { return "perp" }
+func (m MsgClaimReferralEarnings) Type() string  { return "claim_referral_earnings_msg" }
+
+func (m MsgClaimReferralEarnings) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgClaimReferralEarnings) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgClaimReferralEarnings) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
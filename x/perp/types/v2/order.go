@@ -0,0 +1,137 @@
+package v2
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsOpenOrder returns true for the orders that open a position (LIMIT and
+// STOP_MARKET) and escrow their margin, false for the ones that close the
+// position of the trader (TAKE_PROFIT and STOP_LOSS).
+func (m *Order) IsOpenOrder() bool {
+	return m.OrderType == OrderType_LIMIT || m.OrderType == OrderType_STOP_MARKET
+}
+
+// IsMakerOrder returns true for the orders executed at a price in favor of the
+// trader (LIMIT and TAKE_PROFIT). They can't be placed already triggered, and
+// pay the maker fee of their fee tier once they rested for a block.
+func (m *Order) IsMakerOrder() bool {
+	return m.OrderType == OrderType_LIMIT || m.OrderType == OrderType_TAKE_PROFIT
+}
+
+// IsCloseOrder returns true for the orders that close the position of the
+// trader (TAKE_PROFIT and STOP_LOSS) and escrow a deposit.
+func (m *Order) IsCloseOrder() bool {
+	return m.OrderType == OrderType_TAKE_PROFIT || m.OrderType == OrderType_STOP_LOSS
+}
+
+// TriggersOnRise returns true for the orders triggered when the price rises to
+// their trigger price or above, false for the ones triggered when it falls to
+// their trigger price or below. The side of TAKE_PROFIT and STOP_LOSS orders
+// is the side of the position they close.
+func (m *Order) TriggersOnRise() bool {
+	switch m.OrderType {
+	case OrderType_LIMIT, OrderType_STOP_LOSS:
+		return m.Side == Direction_SHORT
+	default:
+		return m.Side == Direction_LONG
+	}
+}
+
+// IsTriggered returns whether the order must be executed at the given price.
+func (m *Order) IsTriggered(price sdk.Dec) bool {
+	if m.TriggersOnRise() {
+		return price.GTE(m.TriggerPrice)
+	}
+	return price.LTE(m.TriggerPrice)
+}
+
+func (m *Order) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if _, ok := OrderType_name[int32(m.OrderType)]; !ok || m.OrderType == OrderType_ORDER_TYPE_UNSPECIFIED {
+		return fmt.Errorf("invalid order type: %s", m.OrderType)
+	}
+
+	if _, ok := TriggerPriceSource_name[int32(m.PriceSource)]; !ok {
+		return fmt.Errorf("invalid price source: %s", m.PriceSource)
+	}
+
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+
+	if m.QuoteAssetAmount.IsNil() || m.Leverage.IsNil() || m.BaseAssetAmountLimit.IsNil() {
+		return fmt.Errorf("nil order amounts")
+	}
+
+	if m.Deposit.IsNil() || m.Deposit.IsNegative() {
+		return fmt.Errorf("deposit must not be negative")
+	}
+
+	if m.IsOpenOrder() {
+		if m.Side != Direction_LONG && m.Side != Direction_SHORT {
+			return fmt.Errorf("invalid side: %s", m.Side)
+		}
+
+		if !m.Deposit.IsZero() {
+			return fmt.Errorf("%s orders escrow their margin and take no deposit", m.OrderType)
+		}
+
+		if !m.QuoteAssetAmount.IsPositive() {
+			return fmt.Errorf("quote asset amount must be positive")
+		}
+
+		if !m.Leverage.IsPositive() {
+			return fmt.Errorf("leverage must be positive")
+		}
+
+		if m.BaseAssetAmountLimit.IsNegative() {
+			return fmt.Errorf("base asset amount limit must not be negative")
+		}
+	} else if !m.QuoteAssetAmount.IsZero() || !m.Leverage.IsZero() || !m.BaseAssetAmountLimit.IsZero() {
+		return fmt.Errorf("%s orders close the whole position and take no amounts", m.OrderType)
+	}
+
+	if m.BlockHeight < 0 {
+		return fmt.Errorf("invalid block height")
+	}
+
+	return nil
+}
+
+// NewOrder builds the order described by a MsgPlaceOrder. The amounts of
+// TAKE_PROFIT and STOP_LOSS orders are zeroed since they close the whole
+// position of the trader, their side and deposit are set by PlaceOrder.
+func (m MsgPlaceOrder) NewOrder() Order {
+	order := Order{
+		TraderAddress:        m.Sender,
+		Pair:                 m.Pair,
+		OrderType:            m.OrderType,
+		Side:                 m.Side,
+		TriggerPrice:         m.TriggerPrice,
+		PriceSource:          m.PriceSource,
+		QuoteAssetAmount:     m.QuoteAssetAmount,
+		Leverage:             m.Leverage,
+		BaseAssetAmountLimit: m.BaseAssetAmountLimit,
+		Deposit:              sdk.ZeroInt(),
+	}
+
+	if !order.IsOpenOrder() {
+		order.Side = Direction_DIRECTION_UNSPECIFIED
+		order.QuoteAssetAmount = sdk.ZeroInt()
+		order.Leverage = sdk.ZeroDec()
+		order.BaseAssetAmountLimit = sdk.ZeroInt()
+	} else if order.BaseAssetAmountLimit.IsNil() {
+		order.BaseAssetAmountLimit = sdk.ZeroInt()
+	}
+
+	return order
+}
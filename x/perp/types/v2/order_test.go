@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderIsTriggered(t *testing.T) {
+	trigger := sdk.NewDec(10)
+	below, above := sdk.NewDec(9), sdk.NewDec(11)
+
+	tests := []struct {
+		name      string
+		orderType OrderType
+		side      Direction
+		price     sdk.Dec
+		triggered bool
+	}{
+		{"limit long below", OrderType_LIMIT, Direction_LONG, below, true},
+		{"limit long above", OrderType_LIMIT, Direction_LONG, above, false},
+		{"limit short above", OrderType_LIMIT, Direction_SHORT, above, true},
+		{"limit short at trigger", OrderType_LIMIT, Direction_SHORT, trigger, true},
+		{"stop market long above", OrderType_STOP_MARKET, Direction_LONG, above, true},
+		{"stop market short above", OrderType_STOP_MARKET, Direction_SHORT, above, false},
+		{"take profit long above", OrderType_TAKE_PROFIT, Direction_LONG, above, true},
+		{"take profit short above", OrderType_TAKE_PROFIT, Direction_SHORT, above, false},
+		{"take profit short below", OrderType_TAKE_PROFIT, Direction_SHORT, below, true},
+		{"stop loss long above", OrderType_STOP_LOSS, Direction_LONG, above, false},
+		{"stop loss long below", OrderType_STOP_LOSS, Direction_LONG, below, true},
+		{"stop loss short below", OrderType_STOP_LOSS, Direction_SHORT, below, false},
+		{"stop loss short above", OrderType_STOP_LOSS, Direction_SHORT, above, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			order := Order{OrderType: tc.orderType, Side: tc.side, TriggerPrice: trigger}
+			require.Equal(t, tc.triggered, order.IsTriggered(tc.price))
+		})
+	}
+}
@@ -1,11 +1,32 @@
 package v2
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// MaxLiquidationsPerBlockLimit bounds the number of liquidations the EndBlocker
+// sweep can execute in a block.
+const MaxLiquidationsPerBlockLimit = 100
+
+// MaxSnapshotsPrunedPerBlockLimit bounds the number of reserve snapshots the
+// EndBlocker can prune in a block.
+const MaxSnapshotsPrunedPerBlockLimit = 10_000
+
+// MaxOrdersExecutedPerBlockLimit bounds the number of triggered orders the
+// EndBlocker can execute or cancel in a block.
+const MaxOrdersExecutedPerBlockLimit = 1_000
+
+// MaxPositionsScannedPerBlockLimit bounds the number of positions the
+// EndBlocker liquidation sweep and loss socialization can each check in a
+// block.
+const MaxPositionsScannedPerBlockLimit = 10_000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -13,20 +34,329 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			[]byte("LiquidationSweepEnabled"),
+			&p.LiquidationSweepEnabled,
+			validateLiquidationSweepEnabled,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxLiquidationsPerBlock"),
+			&p.MaxLiquidationsPerBlock,
+			validateMaxLiquidationsPerBlock,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundFeeRatio"),
+			&p.InsuranceFundFeeRatio,
+			validateInsuranceFundFeeRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("InsuranceFundWithdrawalCooldown"),
+			&p.InsuranceFundWithdrawalCooldown,
+			validateInsuranceFundWithdrawalCooldown,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeTiers"),
+			&p.FeeTiers,
+			validateFeeTiers,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ReferralFeeRatio"),
+			&p.ReferralFeeRatio,
+			validateReferralRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ReferralDiscountRatio"),
+			&p.ReferralDiscountRatio,
+			validateReferralRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("SnapshotRetentionLookbacks"),
+			&p.SnapshotRetentionLookbacks,
+			validateSnapshotRetentionLookbacks,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxSnapshotsPrunedPerBlock"),
+			&p.MaxSnapshotsPrunedPerBlock,
+			validateMaxSnapshotsPrunedPerBlock,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxOrdersExecutedPerBlock"),
+			&p.MaxOrdersExecutedPerBlock,
+			validateMaxOrdersExecutedPerBlock,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ClosingOrderDeposit"),
+			&p.ClosingOrderDeposit,
+			validateClosingOrderDeposit,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("MaxPositionsScannedPerBlock"),
+			&p.MaxPositionsScannedPerBlock,
+			validateMaxPositionsScannedPerBlock,
+		),
+	}
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	liquidationSweepEnabled bool,
+	maxLiquidationsPerBlock uint64,
+	insuranceFundFeeRatio sdk.Dec,
+	insuranceFundWithdrawalCooldown time.Duration,
+	feeTiers []FeeTier,
+	referralFeeRatio sdk.Dec,
+	referralDiscountRatio sdk.Dec,
+	snapshotRetentionLookbacks uint64,
+	maxSnapshotsPrunedPerBlock uint64,
+	maxOrdersExecutedPerBlock uint64,
+	closingOrderDeposit sdk.Int,
+	maxPositionsScannedPerBlock uint64,
+) Params {
+	return Params{
+		LiquidationSweepEnabled:         liquidationSweepEnabled,
+		MaxLiquidationsPerBlock:         maxLiquidationsPerBlock,
+		InsuranceFundFeeRatio:           insuranceFundFeeRatio,
+		InsuranceFundWithdrawalCooldown: insuranceFundWithdrawalCooldown,
+		FeeTiers:                        feeTiers,
+		ReferralFeeRatio:                referralFeeRatio,
+		ReferralDiscountRatio:           referralDiscountRatio,
+		SnapshotRetentionLookbacks:      snapshotRetentionLookbacks,
+		MaxSnapshotsPrunedPerBlock:      maxSnapshotsPrunedPerBlock,
+		MaxOrdersExecutedPerBlock:       maxOrdersExecutedPerBlock,
+		ClosingOrderDeposit:             closingOrderDeposit,
+		MaxPositionsScannedPerBlock:     maxPositionsScannedPerBlock,
+	}
 }
 
 // DefaultParams returns the default parameters for the x/perp module.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		/* liquidationSweepEnabled */ false,
+		/* maxLiquidationsPerBlock */ 10,
+		/* insuranceFundFeeRatio */ sdk.ZeroDec(),
+		/* insuranceFundWithdrawalCooldown */ 7*24*time.Hour,
+		/* feeTiers */ []FeeTier{},
+		/* referralFeeRatio */ sdk.ZeroDec(),
+		/* referralDiscountRatio */ sdk.ZeroDec(),
+		/* snapshotRetentionLookbacks */ 4,
+		/* maxSnapshotsPrunedPerBlock */ 1000,
+		/* maxOrdersExecutedPerBlock */ 100,
+		/* closingOrderDeposit */ sdk.NewInt(1_000_000),
+		/* maxPositionsScannedPerBlock */ 1000,
+	)
 }
 
 // Validate validates the set of params
 func (p *Params) Validate() error {
+	if err := validateLiquidationSweepEnabled(p.LiquidationSweepEnabled); err != nil {
+		return err
+	}
+
+	if err := validateMaxLiquidationsPerBlock(p.MaxLiquidationsPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateInsuranceFundFeeRatio(p.InsuranceFundFeeRatio); err != nil {
+		return err
+	}
+
+	if err := validateInsuranceFundWithdrawalCooldown(p.InsuranceFundWithdrawalCooldown); err != nil {
+		return err
+	}
+
+	if err := validateFeeTiers(p.FeeTiers); err != nil {
+		return err
+	}
+
+	if err := validateReferralRatio(p.ReferralFeeRatio); err != nil {
+		return err
+	}
+
+	if err := validateReferralRatio(p.ReferralDiscountRatio); err != nil {
+		return err
+	}
+
+	if p.ReferralFeeRatio.Add(p.ReferralDiscountRatio).GT(sdk.OneDec()) {
+		return fmt.Errorf("referral fee and discount ratios must add up to at most 1: %s + %s", p.ReferralFeeRatio, p.ReferralDiscountRatio)
+	}
+
+	if err := validateSnapshotRetentionLookbacks(p.SnapshotRetentionLookbacks); err != nil {
+		return err
+	}
+
+	if err := validateMaxSnapshotsPrunedPerBlock(p.MaxSnapshotsPrunedPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMaxOrdersExecutedPerBlock(p.MaxOrdersExecutedPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateClosingOrderDeposit(p.ClosingOrderDeposit); err != nil {
+		return err
+	}
+
+	if err := validateMaxPositionsScannedPerBlock(p.MaxPositionsScannedPerBlock); err != nil {
+		return err
+	}
+
+	if p.SnapshotRetentionLookbacks > 0 && p.MaxSnapshotsPrunedPerBlock == 0 {
+		return fmt.Errorf("max snapshots pruned per block must be positive when the snapshots are pruned")
+	}
+
+	if p.LiquidationSweepEnabled && p.MaxLiquidationsPerBlock == 0 {
+		return fmt.Errorf("max liquidations per block must be positive when the liquidation sweep is enabled")
+	}
+
+	return nil
+}
+
+func validateLiquidationSweepEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxLiquidationsPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val > MaxLiquidationsPerBlockLimit {
+		return fmt.Errorf("max liquidations per block must be at most %d: %d", MaxLiquidationsPerBlockLimit, val)
+	}
+
+	return nil
+}
+
+func validateInsuranceFundFeeRatio(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if ratio.IsNil() {
+		return fmt.Errorf("invalid nil decimal")
+	}
+
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("insurance fund fee ratio must be between 0 and 1: %s", ratio)
+	}
+
+	return nil
+}
+
+func validateInsuranceFundWithdrawalCooldown(i interface{}) error {
+	cooldown, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if cooldown < 0 {
+		return fmt.Errorf("insurance fund withdrawal cooldown must not be negative: %s", cooldown)
+	}
+
+	return nil
+}
+
+func validateFeeTiers(i interface{}) error {
+	tiers, ok := i.([]FeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("invalid fee tier %d: %w", i+1, err)
+		}
+
+		if i > 0 && !tier.MinVolume.GT(tiers[i-1].MinVolume) {
+			return fmt.Errorf("fee tiers must be sorted by strictly ascending min volume: %s after %s", tier.MinVolume, tiers[i-1].MinVolume)
+		}
+	}
+
+	return nil
+}
+
+func validateReferralRatio(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if ratio.IsNil() {
+		return fmt.Errorf("invalid nil decimal")
+	}
+
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("referral ratio must be between 0 and 1: %s", ratio)
+	}
+
+	return nil
+}
+
+func validateSnapshotRetentionLookbacks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxSnapshotsPrunedPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val > MaxSnapshotsPrunedPerBlockLimit {
+		return fmt.Errorf("max snapshots pruned per block must be at most %d: %d", MaxSnapshotsPrunedPerBlockLimit, val)
+	}
+
+	return nil
+}
+
+func validateMaxOrdersExecutedPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 || val > MaxOrdersExecutedPerBlockLimit {
+		return fmt.Errorf("max orders executed per block must be between 1 and %d: %d", MaxOrdersExecutedPerBlockLimit, val)
+	}
+
+	return nil
+}
+
+func validateClosingOrderDeposit(i interface{}) error {
+	deposit, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if deposit.IsNil() {
+		return fmt.Errorf("invalid nil integer")
+	}
+
+	if deposit.IsNegative() {
+		return fmt.Errorf("closing order deposit must not be negative: %s", deposit)
+	}
+
+	return nil
+}
+
+func validateMaxPositionsScannedPerBlock(i interface{}) error {
+	val, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if val == 0 || val > MaxPositionsScannedPerBlockLimit {
+		return fmt.Errorf("max positions scanned per block must be between 1 and %d: %d", MaxPositionsScannedPerBlockLimit, val)
+	}
+
 	return nil
 }
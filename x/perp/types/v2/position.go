@@ -17,9 +17,43 @@ func ZeroPosition(ctx sdk.Context, tokenPair asset.Pair, traderAddr sdk.AccAddre
 		OpenNotional:                    sdk.ZeroDec(),
 		LatestCumulativePremiumFraction: sdk.ZeroDec(),
 		LastUpdatedBlockNumber:          ctx.BlockHeight(),
+		RealizedPnl:                     sdk.ZeroDec(),
+		FundingPaid:                     sdk.ZeroDec(),
+		FeesPaid:                        sdk.ZeroDec(),
+		EntryTimeMs:                     ctx.BlockTime().UnixMilli(),
 	}
 }
 
+// CarryAccounting carries the lifetime accounting of the previous state of the
+// position over, adding the PnL realized, the funding paid and the fees paid
+// by the change. The accounting restarts at the change if the position is
+// opened by it or flips side.
+func (m *Position) CarryAccounting(
+	previous Position, realizedPnl sdk.Dec, fundingPaid sdk.Dec, feesPaid sdk.Dec, blockTimeMs int64,
+) {
+	if previous.Size_.IsNil() || previous.Size_.IsZero() || previous.Size_.IsPositive() != m.Size_.IsPositive() {
+		m.RealizedPnl = sdk.ZeroDec()
+		m.FundingPaid = sdk.ZeroDec()
+		m.FeesPaid = decOrZero(feesPaid)
+		m.EntryTimeMs = blockTimeMs
+		return
+	}
+
+	m.RealizedPnl = decOrZero(previous.RealizedPnl).Add(decOrZero(realizedPnl))
+	m.FundingPaid = decOrZero(previous.FundingPaid).Add(decOrZero(fundingPaid))
+	m.FeesPaid = decOrZero(previous.FeesPaid).Add(decOrZero(feesPaid))
+	m.EntryTimeMs = previous.EntryTimeMs
+}
+
+// decOrZero returns zero for a nil dec, e.g. a field added to a position
+// stored before it existed.
+func decOrZero(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return d
+}
+
 func PositionsAreEqual(expected, actual *Position) error {
 	if expected.Pair != actual.Pair {
 		return fmt.Errorf("expected position pair %s, got %s", expected.Pair, actual.Pair)
@@ -0,0 +1,48 @@
+package v2
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// referralCodeRegex matches the referral codes: 3 to 32 lowercase letters,
+// digits, dashes or underscores.
+var referralCodeRegex = regexp.MustCompile(`^[a-z0-9_-]{3,32}$`)
+
+// ValidateReferralCode returns an error if the referral code is malformed.
+func ValidateReferralCode(code string) error {
+	if !referralCodeRegex.MatchString(code) {
+		return fmt.Errorf("invalid referral code %q: must be 3 to 32 lowercase letters, digits, dashes or underscores", code)
+	}
+	return nil
+}
+
+func (m *ReferralCode) Validate() error {
+	if err := ValidateReferralCode(m.Code); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ReferrerAddress); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TraderReferral) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	return ValidateReferralCode(m.Code)
+}
+
+func (m *ReferralEarnings) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ReferrerAddress); err != nil {
+		return err
+	}
+
+	return m.Earnings.Validate()
+}
@@ -13,6 +13,7 @@ import (
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -97,7 +98,122 @@ func (TwapCalcOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9a497e70afa7e7d6, []int{1}
 }
 
+// Enumerates the kinds of trigger orders.
+type OrderType int32
+
+const (
+	OrderType_ORDER_TYPE_UNSPECIFIED OrderType = 0
+	// Opens a position once the price reaches the trigger price or better:
+	// at or below it for longs, at or above it for shorts. Can't be placed at a
+	// trigger price the price has already reached.
+	OrderType_LIMIT OrderType = 1
+	// Opens a position once the price moves through the trigger price: at or
+	// above it for longs, at or below it for shorts.
+	OrderType_STOP_MARKET OrderType = 2
+	// Closes the position of the trader once the price moves in its favor up
+	// to the trigger price. Can't be placed at a trigger price the price has
+	// already reached.
+	OrderType_TAKE_PROFIT OrderType = 3
+	// Closes the position of the trader once the price moves against it down
+	// to the trigger price.
+	OrderType_STOP_LOSS OrderType = 4
+)
+
+var OrderType_name = map[int32]string{
+	0: "ORDER_TYPE_UNSPECIFIED",
+	1: "LIMIT",
+	2: "STOP_MARKET",
+	3: "TAKE_PROFIT",
+	4: "STOP_LOSS",
+}
+
+var OrderType_value = map[string]int32{
+	"ORDER_TYPE_UNSPECIFIED": 0,
+	"LIMIT":                  1,
+	"STOP_MARKET":            2,
+	"TAKE_PROFIT":            3,
+	"STOP_LOSS":              4,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{2}
+}
+
+// Enumerates the prices a trigger order can be evaluated against.
+type TriggerPriceSource int32
+
+const (
+	// AMM.MarkPrice of the market
+	TriggerPriceSource_MARK_PRICE TriggerPriceSource = 0
+	// TWAP of the oracle exchange rate of the pair
+	TriggerPriceSource_INDEX_TWAP TriggerPriceSource = 1
+)
+
+var TriggerPriceSource_name = map[int32]string{
+	0: "MARK_PRICE",
+	1: "INDEX_TWAP",
+}
+
+var TriggerPriceSource_value = map[string]int32{
+	"MARK_PRICE": 0,
+	"INDEX_TWAP": 1,
+}
+
+func (x TriggerPriceSource) String() string {
+	return proto.EnumName(TriggerPriceSource_name, int32(x))
+}
+
+func (TriggerPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{3}
+}
+
 type Params struct {
+	// whether the EndBlocker liquidates unhealthy positions itself, without
+	// waiting for an external liquidator. The liquidator fees of these
+	// liquidations go to the ecosystem fund.
+	LiquidationSweepEnabled bool `protobuf:"varint,1,opt,name=liquidation_sweep_enabled,json=liquidationSweepEnabled,proto3" json:"liquidation_sweep_enabled,omitempty"`
+	// the maximum number of liquidations the EndBlocker sweep executes in a
+	// block, across all markets
+	MaxLiquidationsPerBlock uint64 `protobuf:"varint,2,opt,name=max_liquidations_per_block,json=maxLiquidationsPerBlock,proto3" json:"max_liquidations_per_block,omitempty"`
+	// the portion of the exchange fees, and of the liquidation fees going to the
+	// ecosystem fund, paid to the insurance fund instead
+	InsuranceFundFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=insurance_fund_fee_ratio,json=insuranceFundFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_fee_ratio"`
+	// how long insurance fund shares stay locked, and exposed to bad debt, once
+	// their withdrawal is requested
+	InsuranceFundWithdrawalCooldown time.Duration `protobuf:"bytes,4,opt,name=insurance_fund_withdrawal_cooldown,json=insuranceFundWithdrawalCooldown,proto3,stdduration" json:"insurance_fund_withdrawal_cooldown"`
+	// the exchange fee tiers, by ascending minimum volume. A trader pays the
+	// exchange fee of the highest tier their rolling 30-day quote volume
+	// qualifies for, and the exchange fee ratio of the market below the first
+	// tier.
+	FeeTiers []FeeTier `protobuf:"bytes,5,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// the portion of the exchange fee of a referred trader paid to their
+	// referrer
+	ReferralFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=referral_fee_ratio,json=referralFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"referral_fee_ratio"`
+	// the portion of the exchange fee of a referred trader waived as a discount
+	ReferralDiscountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=referral_discount_ratio,json=referralDiscountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"referral_discount_ratio"`
+	// the number of twap lookback windows of its market a reserve snapshot is
+	// kept for. Older snapshots are pruned by the EndBlocker, except the latest
+	// one, which starts the TWAP window. Zero disables the pruning.
+	SnapshotRetentionLookbacks uint64 `protobuf:"varint,8,opt,name=snapshot_retention_lookbacks,json=snapshotRetentionLookbacks,proto3" json:"snapshot_retention_lookbacks,omitempty"`
+	// the maximum number of reserve snapshots the EndBlocker prunes in a block,
+	// across all markets
+	MaxSnapshotsPrunedPerBlock uint64 `protobuf:"varint,9,opt,name=max_snapshots_pruned_per_block,json=maxSnapshotsPrunedPerBlock,proto3" json:"max_snapshots_pruned_per_block,omitempty"`
+	// the maximum number of triggered orders the EndBlocker executes or cancels
+	// in a block, across all markets
+	MaxOrdersExecutedPerBlock uint64 `protobuf:"varint,10,opt,name=max_orders_executed_per_block,json=maxOrdersExecutedPerBlock,proto3" json:"max_orders_executed_per_block,omitempty"`
+	// the deposit, in the quote denom of the pair, escrowed by TAKE_PROFIT and
+	// STOP_LOSS orders until they are executed or cancelled
+	ClosingOrderDeposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=closing_order_deposit,json=closingOrderDeposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"closing_order_deposit"`
+	// the maximum number of positions the EndBlocker liquidation sweep checks in
+	// a block, across all markets. The sweep resumes after the last position it
+	// checked in the next block. The loss socialization of the EndBlocker gets
+	// its own budget of the same size, shared by the pending losses of every
+	// denom.
+	MaxPositionsScannedPerBlock uint64 `protobuf:"varint,12,opt,name=max_positions_scanned_per_block,json=maxPositionsScannedPerBlock,proto3" json:"max_positions_scanned_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -133,6 +249,108 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetLiquidationSweepEnabled() bool {
+	if m != nil {
+		return m.LiquidationSweepEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxLiquidationsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxLiquidationsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetInsuranceFundWithdrawalCooldown() time.Duration {
+	if m != nil {
+		return m.InsuranceFundWithdrawalCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
+	}
+	return nil
+}
+
+func (m *Params) GetSnapshotRetentionLookbacks() uint64 {
+	if m != nil {
+		return m.SnapshotRetentionLookbacks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshotsPrunedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxSnapshotsPrunedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOrdersExecutedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxOrdersExecutedPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPositionsScannedPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPositionsScannedPerBlock
+	}
+	return 0
+}
+
+// An exchange fee tier, replacing the exchange fee ratio of the markets for
+// the traders whose rolling 30-day quote volume reaches its minimum volume.
+type FeeTier struct {
+	// the rolling 30-day quote volume from which the tier applies
+	MinVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+	// the exchange fee ratio of market orders
+	TakerFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=taker_fee_ratio,json=takerFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee_ratio"`
+	// the exchange fee ratio of limit and take profit orders executed in a
+	// later block than the one they were placed or replaced in. A negative
+	// ratio is a rebate paid to the trader by the exchange fee pool.
+	MakerFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=maker_fee_ratio,json=makerFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee_ratio"`
+}
+
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{1}
+}
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
+}
+func (m *FeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
+
 type Market struct {
 	// the trading pair represented by this market
 	// always BASE:QUOTE, e.g. BTC:NUSD or ETH:NUSD
@@ -169,13 +387,43 @@ type Market struct {
 	TwapLookbackWindow time.Duration `protobuf:"bytes,12,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window"`
 	// the amount of collateral already credited from the ecosystem fund
 	PrepaidBadDebt types.Coin `protobuf:"bytes,13,opt,name=prepaid_bad_debt,json=prepaidBadDebt,proto3" json:"prepaid_bad_debt"`
+	// whether or not the market has been settled. A settled market is frozen:
+	// positions can only be settled at the settlement price.
+	Settled bool `protobuf:"varint,14,opt,name=settled,proto3" json:"settled,omitempty"`
+	// the price at which positions of a settled market are closed
+	SettlementPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=settlement_price,json=settlementPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"settlement_price"`
+	// the divergence between the mark price and the index price TWAP, as a
+	// ratio of the index price, past which the AMM is repegged to the index
+	// price at the end of the funding epoch. Zero disables automatic repegs.
+	RepegDivergenceThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=repeg_divergence_threshold,json=repegDivergenceThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"repeg_divergence_threshold"`
+	// the maximum amount of quote assets the perp ecosystem fund pays for the
+	// automatic repeg of the market per funding epoch
+	RepegBudgetPerEpoch github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=repeg_budget_per_epoch,json=repegBudgetPerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"repeg_budget_per_epoch"`
+	// the maximum total size of the long positions, and of the short positions,
+	// of the market in base assets. Zero means no limit.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// the maximum open notional of a position in quote assets. Zero means no
+	// limit.
+	MaxTraderNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=max_trader_notional,json=maxTraderNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_trader_notional"`
+	// the maximum difference between the total long and the total short sizes
+	// of the market in base assets. Zero means no limit.
+	MaxBias github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=max_bias,json=maxBias,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_bias"`
+	// the maximum absolute funding rate of a funding epoch, as a fraction of
+	// the index price. Zero means no limit.
+	MaxFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=max_funding_rate,json=maxFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_funding_rate"`
+	// the daily interest rate added to the premium, as a fraction of the index
+	// price. Longs pay shorts when positive.
+	FundingInterestRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=funding_interest_rate,json=fundingInterestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_interest_rate"`
+	// the band around the index price, as a fraction of it, within which the
+	// premium is ignored. Outside of it, the premium is reduced by the band.
+	FundingDampener github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=funding_dampener,json=fundingDampener,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_dampener"`
 }
 
 func (m *Market) Reset()         { *m = Market{} }
 func (m *Market) String() string { return proto.CompactTextString(m) }
 func (*Market) ProtoMessage()    {}
 func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{1}
+	return fileDescriptor_9a497e70afa7e7d6, []int{2}
 }
 func (m *Market) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,6 +480,13 @@ func (m *Market) GetPrepaidBadDebt() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Market) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
 type AMM struct {
 	// identifies the market this AMM belongs to
 	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
@@ -253,7 +508,7 @@ func (m *AMM) Reset()         { *m = AMM{} }
 func (m *AMM) String() string { return proto.CompactTextString(m) }
 func (*AMM) ProtoMessage()    {}
 func (*AMM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{2}
+	return fileDescriptor_9a497e70afa7e7d6, []int{3}
 }
 func (m *AMM) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,13 +553,24 @@ type Position struct {
 	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
 	// last block number this position was updated
 	LastUpdatedBlockNumber int64 `protobuf:"varint,7,opt,name=last_updated_block_number,json=lastUpdatedBlockNumber,proto3" json:"last_updated_block_number,omitempty"`
+	// the PnL realized since the position was opened
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the funding payments paid since the position was opened, negative if
+	// received
+	FundingPaid github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=funding_paid,json=fundingPaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_paid"`
+	// the exchange and liquidation fees paid since the position was opened,
+	// net of maker rebates
+	FeesPaid github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fees_paid,json=feesPaid,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees_paid"`
+	// the block time at which the position was opened, in milliseconds since
+	// the unix epoch
+	EntryTimeMs int64 `protobuf:"varint,11,opt,name=entry_time_ms,json=entryTimeMs,proto3" json:"entry_time_ms,omitempty"`
 }
 
 func (m *Position) Reset()         { *m = Position{} }
 func (m *Position) String() string { return proto.CompactTextString(m) }
 func (*Position) ProtoMessage()    {}
 func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{3}
+	return fileDescriptor_9a497e70afa7e7d6, []int{4}
 }
 func (m *Position) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,6 +613,13 @@ func (m *Position) GetLastUpdatedBlockNumber() int64 {
 	return 0
 }
 
+func (m *Position) GetEntryTimeMs() int64 {
+	if m != nil {
+		return m.EntryTimeMs
+	}
+	return 0
+}
+
 // a snapshot of the perp.amm's reserves at a given point in time
 type ReserveSnapshot struct {
 	Amm AMM `protobuf:"bytes,1,opt,name=amm,proto3" json:"amm"`
@@ -358,7 +631,7 @@ func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
 func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ReserveSnapshot) ProtoMessage()    {}
 func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{4}
+	return fileDescriptor_9a497e70afa7e7d6, []int{5}
 }
 func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,439 +674,987 @@ func (m *ReserveSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
-	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
-	proto.RegisterType((*Params)(nil), "nibiru.perp.v2.Params")
-	proto.RegisterType((*Market)(nil), "nibiru.perp.v2.Market")
-	proto.RegisterType((*AMM)(nil), "nibiru.perp.v2.AMM")
-	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
-	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
+// Order is a trigger order waiting to be executed by the EndBlocker.
+type Order struct {
+	Id            uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TraderAddress string                                            `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	Pair          github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	OrderType     OrderType                                         `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v2.OrderType" json:"order_type,omitempty"`
+	// the side of the position to open for LIMIT and STOP_MARKET orders, the
+	// side of the position to close for TAKE_PROFIT and STOP_LOSS orders
+	Side Direction `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v2.Direction" json:"side,omitempty"`
+	// the price at which the order is triggered
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	PriceSource  TriggerPriceSource                     `protobuf:"varint,7,opt,name=price_source,json=priceSource,proto3,enum=nibiru.perp.v2.TriggerPriceSource" json:"price_source,omitempty"`
+	// the margin of the position to open, escrowed in the vault until the order
+	// is executed or cancelled. Zero for TAKE_PROFIT and STOP_LOSS orders.
+	QuoteAssetAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	// the leverage of the position to open
+	Leverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	// the slippage protection of the position to open, see
+	// MsgOpenPosition.base_asset_amount_limit
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+	// the block at which the order was placed or last replaced
+	BlockHeight int64 `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// the deposit escrowed in the vault by TAKE_PROFIT and STOP_LOSS orders,
+	// refunded when the order is executed or cancelled. Zero for LIMIT and
+	// STOP_MARKET orders, whose margin is escrowed instead.
+	Deposit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,12,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"deposit"`
 }
 
-func init() { proto.RegisterFile("perp/v2/state.proto", fileDescriptor_9a497e70afa7e7d6) }
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{6}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
 
-var fileDescriptor_9a497e70afa7e7d6 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x17, 0xc5, 0x2d, 0x4b, 0x71, 0xa4, 0x91, 0xe2, 0x08, 0x74, 0x9c, 0xbf, 0x1c, 0xfc, 0x2b, 0xbb,
-	0x02, 0x5a, 0x04, 0x29, 0x42, 0xc2, 0xee, 0x2a, 0xe8, 0x4a, 0x9f, 0xa9, 0x00, 0x7d, 0x99, 0x92,
-	0x61, 0xb4, 0x28, 0x30, 0x18, 0x92, 0xd7, 0xd4, 0xd4, 0x24, 0x87, 0x9e, 0x19, 0xca, 0x4e, 0xfb,
-	0x06, 0x5d, 0x75, 0xd9, 0xbe, 0x50, 0x91, 0x65, 0x96, 0x45, 0x17, 0x69, 0x61, 0xbf, 0x48, 0x31,
-	0x43, 0x5a, 0x76, 0x80, 0x02, 0x05, 0x88, 0x00, 0x5d, 0x49, 0x9c, 0xcb, 0xfb, 0x3b, 0x97, 0xc3,
-	0xc3, 0x43, 0xa2, 0x9d, 0x18, 0x78, 0x6c, 0xad, 0x8e, 0x2c, 0x21, 0x89, 0x04, 0x33, 0xe6, 0x4c,
-	0x32, 0x63, 0x3b, 0xa2, 0x0e, 0xe5, 0x89, 0xa9, 0x6a, 0xe6, 0xea, 0xe8, 0xd9, 0x13, 0x9f, 0xf9,
-	0x4c, 0x97, 0x2c, 0xf5, 0x2f, 0x3d, 0xeb, 0x59, 0xd3, 0x65, 0x22, 0x64, 0xc2, 0x72, 0x88, 0x00,
-	0x6b, 0x75, 0xe8, 0x80, 0x24, 0x87, 0x96, 0xcb, 0x68, 0x94, 0xd5, 0xf7, 0xd2, 0x3a, 0x4e, 0x1b,
-	0xd3, 0x83, 0xdb, 0x56, 0x9f, 0x31, 0x3f, 0x00, 0x4b, 0x1f, 0x39, 0xc9, 0x99, 0xe5, 0x25, 0x9c,
-	0x48, 0xca, 0xb2, 0xd6, 0x56, 0x19, 0x6d, 0xcd, 0x08, 0x27, 0xa1, 0x68, 0xfd, 0x54, 0x41, 0x5b,
-	0x63, 0xc2, 0xcf, 0x41, 0x1a, 0x63, 0x54, 0x8a, 0x09, 0xe5, 0x8d, 0xc2, 0x41, 0xe1, 0x79, 0xa5,
-	0xf3, 0xea, 0xed, 0xfb, 0xfd, 0x8d, 0x3f, 0xde, 0xef, 0x1f, 0xfa, 0x54, 0x2e, 0x13, 0xc7, 0x74,
-	0x59, 0x68, 0x4d, 0xf4, 0xd8, 0xdd, 0x25, 0xa1, 0x91, 0x95, 0x5e, 0x82, 0x75, 0x65, 0xb9, 0x2c,
-	0x0c, 0x59, 0x64, 0x11, 0x21, 0x40, 0x9a, 0x33, 0x42, 0xb9, 0xad, 0x31, 0x46, 0x03, 0x3d, 0x84,
-	0x88, 0x38, 0x01, 0x78, 0x8d, 0xcd, 0x83, 0xc2, 0xf3, 0xb2, 0x7d, 0x7b, 0x68, 0x5c, 0xa0, 0x4f,
-	0x62, 0x4e, 0x5d, 0xc0, 0x67, 0x41, 0xe2, 0xca, 0x44, 0x0f, 0x86, 0x03, 0x1a, 0x52, 0x89, 0xf5,
-	0x94, 0x8d, 0xa2, 0x9e, 0xc0, 0xcc, 0x26, 0xf8, 0xfc, 0xde, 0x04, 0xd9, 0x96, 0xa4, 0x3f, 0x2f,
-	0x85, 0x77, 0x6e, 0xc9, 0x37, 0x31, 0x08, 0xb3, 0x07, 0xae, 0xfd, 0x4c, 0x43, 0x07, 0x77, 0xcc,
-	0x91, 0x42, 0xda, 0xea, 0xaf, 0xb1, 0x44, 0x8d, 0x90, 0xd0, 0x48, 0x42, 0x44, 0x22, 0x17, 0x70,
-	0x48, 0xb8, 0x4f, 0xa3, 0x4c, 0xad, 0x94, 0x4b, 0xed, 0xe9, 0x3d, 0xde, 0x58, 0xe3, 0x52, 0xa5,
-	0x63, 0x54, 0x0b, 0xc9, 0x15, 0x0e, 0x60, 0x05, 0x9c, 0xf8, 0xd0, 0x78, 0x90, 0x8b, 0x5e, 0x0d,
-	0xc9, 0xd5, 0x28, 0x43, 0x18, 0x3f, 0xa2, 0x56, 0x40, 0x24, 0x08, 0x89, 0xdd, 0x24, 0x4c, 0x02,
-	0x22, 0xe9, 0x0a, 0x70, 0xcc, 0x21, 0xa4, 0x49, 0x88, 0xcf, 0x38, 0x71, 0xd5, 0xc5, 0x36, 0xb6,
-	0x72, 0x09, 0xed, 0xa7, 0xe4, 0xee, 0x1a, 0x3c, 0x4b, 0xb9, 0x83, 0x0c, 0x6b, 0x7c, 0x87, 0x0c,
-	0xb8, 0x72, 0x97, 0x24, 0xf2, 0x01, 0x9f, 0x01, 0x64, 0x7b, 0xf6, 0x30, 0x97, 0x58, 0xfd, 0x96,
-	0x34, 0x00, 0x48, 0x77, 0xcb, 0x47, 0x0d, 0x70, 0x99, 0x78, 0x23, 0x24, 0x84, 0xf8, 0x2c, 0x89,
-	0xbc, 0x7b, 0x1a, 0xe5, 0x5c, 0x1a, 0xbb, 0x6b, 0xde, 0x20, 0x89, 0xbc, 0xb5, 0x90, 0x83, 0x76,
-	0x03, 0x7a, 0x91, 0x50, 0x2f, 0x75, 0xdb, 0x9d, 0x4a, 0x25, 0x97, 0xca, 0xce, 0x3d, 0xd8, 0x5a,
-	0xe3, 0x7b, 0xb4, 0x17, 0x13, 0x2e, 0x29, 0x09, 0xf0, 0x7d, 0xad, 0x54, 0x07, 0xe5, 0xd2, 0xf9,
-	0x5f, 0x06, 0x1c, 0xdd, 0xf1, 0x52, 0xad, 0x43, 0xb4, 0xab, 0xb6, 0x8b, 0x46, 0xbe, 0xe2, 0x03,
-	0x86, 0x98, 0xb9, 0x4b, 0x4c, 0xbd, 0x46, 0x55, 0xe9, 0xd8, 0x46, 0x56, 0xb4, 0x89, 0x84, 0xbe,
-	0x2a, 0x0d, 0x3d, 0xe3, 0x04, 0x3d, 0x91, 0x97, 0x24, 0xc6, 0x01, 0x63, 0xe7, 0x0e, 0x71, 0xcf,
-	0xf1, 0x25, 0x8d, 0x3c, 0x76, 0xd9, 0xa8, 0x1d, 0x14, 0x9e, 0x57, 0x8f, 0xf6, 0xcc, 0x34, 0x33,
-	0xcc, 0xdb, 0xcc, 0x30, 0x7b, 0x59, 0x66, 0x74, 0xca, 0x6a, 0xe8, 0x5f, 0xfe, 0xdc, 0x2f, 0xd8,
-	0x86, 0x02, 0x8c, 0xb2, 0xfe, 0x53, 0xdd, 0x6e, 0x0c, 0x51, 0x3d, 0xe6, 0x10, 0x13, 0xea, 0x61,
-	0x87, 0x78, 0xd8, 0x03, 0x47, 0x36, 0x1e, 0x65, 0xc8, 0x2c, 0x94, 0x54, 0x82, 0x99, 0x59, 0x82,
-	0x99, 0x5d, 0x46, 0xa3, 0x4e, 0x49, 0x21, 0xed, 0xed, 0xac, 0xb1, 0x43, 0xbc, 0x1e, 0x38, 0xb2,
-	0xf5, 0x5b, 0x09, 0x15, 0xdb, 0xe3, 0xf1, 0xc7, 0x4e, 0xa2, 0x63, 0x54, 0x53, 0x13, 0x60, 0x0e,
-	0x02, 0xf8, 0x0a, 0x74, 0x1c, 0xe5, 0x78, 0x24, 0x15, 0xc3, 0x4e, 0x11, 0xc6, 0x1c, 0x3d, 0xba,
-	0x48, 0x98, 0xbc, 0x63, 0xe6, 0x8b, 0xac, 0x9a, 0x86, 0xdc, 0x42, 0xc7, 0x08, 0x89, 0x0b, 0x2e,
-	0xb1, 0x07, 0xb1, 0x5c, 0xe6, 0x8c, 0xa5, 0x8a, 0x22, 0xf4, 0x14, 0xc0, 0xf8, 0x46, 0xdd, 0x18,
-	0x15, 0xb3, 0x61, 0x12, 0x48, 0x1a, 0x07, 0x14, 0x78, 0xce, 0x34, 0x7a, 0xac, 0x39, 0xe3, 0x35,
-	0x46, 0x4d, 0x2a, 0x99, 0x54, 0x3e, 0x67, 0x91, 0x9f, 0x33, 0x79, 0x2a, 0x9a, 0x30, 0x62, 0x91,
-	0x6f, 0x4c, 0x51, 0x35, 0xc5, 0x89, 0x25, 0xe3, 0x32, 0x67, 0xb8, 0xa4, 0x13, 0xcd, 0x15, 0xa1,
-	0xf5, 0x6b, 0x09, 0x95, 0x67, 0x4c, 0x50, 0x9d, 0x60, 0x9f, 0xa1, 0x6d, 0xc9, 0x89, 0x07, 0x1c,
-	0x13, 0xcf, 0xe3, 0x20, 0x44, 0xea, 0x2b, 0xfb, 0x51, 0xba, 0xda, 0x4e, 0x17, 0xd7, 0xa6, 0xdb,
-	0xfc, 0x38, 0xa6, 0xeb, 0xa0, 0x92, 0xa0, 0x3f, 0xe4, 0x35, 0x86, 0xee, 0x35, 0x06, 0x68, 0x2b,
-	0x7d, 0x53, 0xe5, 0x34, 0x43, 0xd6, 0xad, 0xdc, 0xca, 0x62, 0x88, 0x70, 0xc4, 0xd4, 0x86, 0x90,
-	0x20, 0xa7, 0x0d, 0x6a, 0x0a, 0x32, 0xc9, 0x18, 0xff, 0xed, 0x5b, 0xe9, 0x15, 0xda, 0x0b, 0x88,
-	0x90, 0x38, 0x89, 0x3d, 0x22, 0xc1, 0xc3, 0x4e, 0xc0, 0xdc, 0x73, 0x1c, 0x25, 0xa1, 0x03, 0x5c,
-	0xfb, 0xa7, 0x68, 0x3f, 0x55, 0x27, 0x9c, 0xa4, 0xf5, 0x8e, 0x2a, 0x4f, 0x74, 0xb5, 0x45, 0xd0,
-	0xe3, 0xec, 0x81, 0x9b, 0x47, 0x24, 0x16, 0x4b, 0x26, 0x8d, 0x2f, 0x50, 0x91, 0x84, 0xa1, 0xb6,
-	0x45, 0xf5, 0x68, 0xc7, 0xfc, 0xf0, 0xeb, 0xcc, 0x6c, 0x8f, 0xc7, 0x59, 0x5e, 0xa9, 0xb3, 0x8c,
-	0x4f, 0x51, 0x4d, 0xd2, 0x10, 0x84, 0x24, 0x61, 0x8c, 0x43, 0xa1, 0xfd, 0x52, 0xb4, 0xab, 0xeb,
-	0xb5, 0xb1, 0x78, 0xf1, 0x15, 0xaa, 0xf4, 0x28, 0x87, 0x74, 0xd4, 0x3d, 0xb4, 0xdb, 0x1b, 0xda,
-	0xfd, 0xee, 0x62, 0x38, 0x9d, 0xe0, 0x93, 0xc9, 0x7c, 0xd6, 0xef, 0x0e, 0x07, 0xc3, 0x7e, 0xaf,
-	0xbe, 0x61, 0x94, 0x51, 0x69, 0x34, 0x9d, 0xbc, 0xae, 0x17, 0x8c, 0x0a, 0x7a, 0x30, 0xff, 0x7a,
-	0x6a, 0x2f, 0xea, 0x9b, 0x2f, 0x7c, 0xb4, 0xbd, 0xb8, 0x24, 0x71, 0x97, 0x04, 0xee, 0x34, 0xd6,
-	0x84, 0x03, 0xf4, 0xff, 0xc5, 0x69, 0x7b, 0x86, 0xbb, 0xed, 0x51, 0x17, 0x4f, 0x67, 0xff, 0x0c,
-	0x9a, 0xcf, 0xa6, 0x8b, 0x7a, 0xc1, 0x78, 0x82, 0xea, 0xc7, 0x27, 0xd3, 0x45, 0x1f, 0xb7, 0xe7,
-	0xf3, 0xfe, 0x02, 0xcf, 0x4f, 0xdb, 0xb3, 0xfa, 0xa6, 0xb1, 0x83, 0x1e, 0x77, 0xda, 0xf3, 0x0f,
-	0x16, 0x8b, 0x9d, 0xd7, 0x6f, 0xaf, 0x9b, 0x85, 0x77, 0xd7, 0xcd, 0xc2, 0x5f, 0xd7, 0xcd, 0xc2,
-	0xcf, 0x37, 0xcd, 0x8d, 0x77, 0x37, 0xcd, 0x8d, 0xdf, 0x6f, 0x9a, 0x1b, 0xdf, 0xbe, 0xfc, 0x37,
-	0xd3, 0xeb, 0x8f, 0x5a, 0x7d, 0xbb, 0xac, 0xd5, 0x91, 0xb3, 0xa5, 0x5f, 0x19, 0x5f, 0xfe, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0x64, 0x18, 0xc0, 0xec, 0x0a, 0x00, 0x00,
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Order) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Order) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *Order) GetSide() Direction {
+	if m != nil {
+		return m.Side
+	}
+	return Direction_DIRECTION_UNSPECIFIED
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Order) GetPriceSource() TriggerPriceSource {
+	if m != nil {
+		return m.PriceSource
 	}
-	return dAtA[:n], nil
+	return TriggerPriceSource_MARK_PRICE
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Order) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+// CrossMarginAccount is the opt-in account of a trader whose collateral is
+// shared by all of the trader's positions quoted in the same denom. The
+// positions of the account are liquidated together, when the account margin
+// ratio falls below its maintenance margin ratio.
+type CrossMarginAccount struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// collateral shared by the positions of the trader, held in the vault
+	Collateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=collateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collateral"`
+}
+
+func (m *CrossMarginAccount) Reset()         { *m = CrossMarginAccount{} }
+func (m *CrossMarginAccount) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginAccount) ProtoMessage()    {}
+func (*CrossMarginAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{7}
+}
+func (m *CrossMarginAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err2 != nil {
-		return 0, err2
+}
+func (m *CrossMarginAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginAccount.Merge(m, src)
+}
+func (m *CrossMarginAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossMarginAccount proto.InternalMessageInfo
+
+func (m *CrossMarginAccount) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i -= n2
-	i = encodeVarintState(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x62
-	if len(m.FundingRateEpochId) > 0 {
-		i -= len(m.FundingRateEpochId)
-		copy(dAtA[i:], m.FundingRateEpochId)
-		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateEpochId)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *CrossMarginAccount) GetCollateral() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Collateral
 	}
-	{
-		size := m.PartialLiquidationRatio.Size()
-		i -= size
-		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return nil
+}
+
+// A withdrawal of insurance fund shares waiting for its cooldown to end. The
+// shares are escrowed by the insurance fund until then.
+type InsuranceFundWithdrawal struct {
+	StakerAddress string                                   `protobuf:"bytes,1,opt,name=staker_address,json=stakerAddress,proto3" json:"staker_address,omitempty"`
+	Shares        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares"`
+	// the time at which the shares are redeemed for quote tokens
+	UnlockTime time.Time `protobuf:"bytes,3,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
+}
+
+func (m *InsuranceFundWithdrawal) Reset()         { *m = InsuranceFundWithdrawal{} }
+func (m *InsuranceFundWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundWithdrawal) ProtoMessage()    {}
+func (*InsuranceFundWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{8}
+}
+func (m *InsuranceFundWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.LiquidationFeeRatio.Size()
-		i -= size
-		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *InsuranceFundWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundWithdrawal.Merge(m, src)
+}
+func (m *InsuranceFundWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundWithdrawal proto.InternalMessageInfo
+
+func (m *InsuranceFundWithdrawal) GetStakerAddress() string {
+	if m != nil {
+		return m.StakerAddress
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.EcosystemFundFeeRatio.Size()
-		i -= size
-		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ExchangeFeeRatio.Size()
-		i -= size
-		if _, err := m.ExchangeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LatestCumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MaxLeverage.Size()
-		i -= size
-		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaintenanceMarginRatio.Size()
-		i -= size
-		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *InsuranceFundWithdrawal) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PriceFluctuationLimitRatio.Size()
-		i -= size
-		if _, err := m.PriceFluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *InsuranceFundWithdrawal) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return time.Time{}
+}
+
+// The quote volume traded by a trader on a day, across all markets.
+type TraderVolume struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the number of days since the unix epoch
+	Day    uint64                                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
+}
+
+func (m *TraderVolume) Reset()         { *m = TraderVolume{} }
+func (m *TraderVolume) String() string { return proto.CompactTextString(m) }
+func (*TraderVolume) ProtoMessage()    {}
+func (*TraderVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{9}
+}
+func (m *TraderVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *TraderVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderVolume.Merge(m, src)
+}
+func (m *TraderVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderVolume proto.InternalMessageInfo
+
+func (m *TraderVolume) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AMM) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraderVolume) GetDay() uint64 {
+	if m != nil {
+		return m.Day
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AMM) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A referral code, to which traders bind to share their exchange fees with
+// its referrer.
+type ReferralCode struct {
+	Code            string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ReferrerAddress string `protobuf:"bytes,2,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
 }
 
-func (m *AMM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalShort.Size()
-		i -= size
-		if _, err := m.TotalShort.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.TotalLong.Size()
-		i -= size
-		if _, err := m.TotalLong.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.PriceMultiplier.Size()
-		i -= size
-		if _, err := m.PriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ReferralCode) Reset()         { *m = ReferralCode{} }
+func (m *ReferralCode) String() string { return proto.CompactTextString(m) }
+func (*ReferralCode) ProtoMessage()    {}
+func (*ReferralCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{10}
+}
+func (m *ReferralCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SqrtDepth.Size()
-		i -= size
-		if _, err := m.SqrtDepth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *ReferralCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralCode.Merge(m, src)
+}
+func (m *ReferralCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralCode proto.InternalMessageInfo
+
+func (m *ReferralCode) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.QuoteReserve.Size()
-		i -= size
-		if _, err := m.QuoteReserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ReferralCode) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseReserve.Size()
-		i -= size
-		if _, err := m.BaseReserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// The referral code a trader is bound to.
+type TraderReferral struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (m *TraderReferral) Reset()         { *m = TraderReferral{} }
+func (m *TraderReferral) String() string { return proto.CompactTextString(m) }
+func (*TraderReferral) ProtoMessage()    {}
+func (*TraderReferral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{11}
+}
+func (m *TraderReferral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderReferral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderReferral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *TraderReferral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderReferral.Merge(m, src)
+}
+func (m *TraderReferral) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderReferral) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderReferral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderReferral proto.InternalMessageInfo
+
+func (m *TraderReferral) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Position) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraderReferral) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The exchange fees earned by a referrer and not claimed yet.
+type ReferralEarnings struct {
+	ReferrerAddress string                                   `protobuf:"bytes,1,opt,name=referrer_address,json=referrerAddress,proto3" json:"referrer_address,omitempty"`
+	Earnings        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *ReferralEarnings) Reset()         { *m = ReferralEarnings{} }
+func (m *ReferralEarnings) String() string { return proto.CompactTextString(m) }
+func (*ReferralEarnings) ProtoMessage()    {}
+func (*ReferralEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{12}
+}
+func (m *ReferralEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralEarnings.Merge(m, src)
+}
+func (m *ReferralEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralEarnings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralEarnings proto.InternalMessageInfo
+
+func (m *ReferralEarnings) GetReferrerAddress() string {
+	if m != nil {
+		return m.ReferrerAddress
+	}
+	return ""
+}
+
+func (m *ReferralEarnings) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
+	}
+	return nil
+}
+
+// The funding rate of a market over a funding epoch.
+type FundingRateRecord struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the number of the funding epoch
+	Epoch     uint64                                 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	MarkTwap  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_twap,json=markTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_twap"`
+	IndexTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=index_twap,json=indexTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_twap"`
+	// the premium fraction paid by longs to shorts per unit of base asset
+	PremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=premium_fraction,json=premiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fraction"`
+	// premium_fraction / index_twap
+	FundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate"`
+	// true if the funding rate was clamped by the max funding rate of the
+	// market
+	Clamped   bool      `protobuf:"varint,7,opt,name=clamped,proto3" json:"clamped,omitempty"`
+	Timestamp time.Time `protobuf:"bytes,8,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *FundingRateRecord) Reset()         { *m = FundingRateRecord{} }
+func (m *FundingRateRecord) String() string { return proto.CompactTextString(m) }
+func (*FundingRateRecord) ProtoMessage()    {}
+func (*FundingRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{13}
+}
+func (m *FundingRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRateRecord.Merge(m, src)
+}
+func (m *FundingRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRateRecord proto.InternalMessageInfo
+
+func (m *FundingRateRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *FundingRateRecord) GetClamped() bool {
+	if m != nil {
+		return m.Clamped
+	}
+	return false
+}
+
+func (m *FundingRateRecord) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+// CollateralAsset is an asset other than a quote denom accepted as collateral
+// by the cross-margin accounts. It backs the positions quoted in the quote
+// denom of its oracle pair, valued at its oracle price less its haircut.
+type CollateralAsset struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the oracle pair pricing the asset in the quote denom it backs, e.g.
+	// unibi:unusd for unibi
+	OraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=oracle_pair,json=oraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"oracle_pair"`
+	// the portion of the oracle value of the asset not counted as margin
+	Haircut github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=haircut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"haircut"`
+	// the x/spot pool the asset is sold through for the quote denom when its
+	// cross-margin account is liquidated
+	SpotPoolId uint64 `protobuf:"varint,4,opt,name=spot_pool_id,json=spotPoolId,proto3" json:"spot_pool_id,omitempty"`
+}
+
+func (m *CollateralAsset) Reset()         { *m = CollateralAsset{} }
+func (m *CollateralAsset) String() string { return proto.CompactTextString(m) }
+func (*CollateralAsset) ProtoMessage()    {}
+func (*CollateralAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{14}
+}
+func (m *CollateralAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralAsset.Merge(m, src)
+}
+func (m *CollateralAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralAsset proto.InternalMessageInfo
+
+func (m *CollateralAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *CollateralAsset) GetSpotPoolId() uint64 {
+	if m != nil {
+		return m.SpotPoolId
+	}
+	return 0
+}
+
+// The key of the position a bounded walk over the positions checked last, which
+// the next walk resumes after.
+type PositionCursor struct {
+	Pair          github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	TraderAddress string                                            `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+}
+
+func (m *PositionCursor) Reset()         { *m = PositionCursor{} }
+func (m *PositionCursor) String() string { return proto.CompactTextString(m) }
+func (*PositionCursor) ProtoMessage()    {}
+func (*PositionCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{15}
+}
+func (m *PositionCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionCursor.Merge(m, src)
+}
+func (m *PositionCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionCursor proto.InternalMessageInfo
+
+func (m *PositionCursor) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+// A loss the insurance and ecosystem funds couldn't cover, socialized by the
+// EndBlocker over the profitable positions of the markets quoted in its denom.
+type PendingLoss struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the part of the loss not socialized yet
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// the position the socialization checked last, unset before the first block
+	Cursor *PositionCursor `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (m *PendingLoss) Reset()         { *m = PendingLoss{} }
+func (m *PendingLoss) String() string { return proto.CompactTextString(m) }
+func (*PendingLoss) ProtoMessage()    {}
+func (*PendingLoss) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{16}
+}
+func (m *PendingLoss) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingLoss) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingLoss.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingLoss) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingLoss.Merge(m, src)
+}
+func (m *PendingLoss) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingLoss) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingLoss.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingLoss proto.InternalMessageInfo
+
+func (m *PendingLoss) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingLoss) GetCursor() *PositionCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
+	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
+	proto.RegisterEnum("nibiru.perp.v2.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterEnum("nibiru.perp.v2.TriggerPriceSource", TriggerPriceSource_name, TriggerPriceSource_value)
+	proto.RegisterType((*Params)(nil), "nibiru.perp.v2.Params")
+	proto.RegisterType((*FeeTier)(nil), "nibiru.perp.v2.FeeTier")
+	proto.RegisterType((*Market)(nil), "nibiru.perp.v2.Market")
+	proto.RegisterType((*AMM)(nil), "nibiru.perp.v2.AMM")
+	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
+	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
+	proto.RegisterType((*Order)(nil), "nibiru.perp.v2.Order")
+	proto.RegisterType((*CrossMarginAccount)(nil), "nibiru.perp.v2.CrossMarginAccount")
+	proto.RegisterType((*InsuranceFundWithdrawal)(nil), "nibiru.perp.v2.InsuranceFundWithdrawal")
+	proto.RegisterType((*TraderVolume)(nil), "nibiru.perp.v2.TraderVolume")
+	proto.RegisterType((*ReferralCode)(nil), "nibiru.perp.v2.ReferralCode")
+	proto.RegisterType((*TraderReferral)(nil), "nibiru.perp.v2.TraderReferral")
+	proto.RegisterType((*ReferralEarnings)(nil), "nibiru.perp.v2.ReferralEarnings")
+	proto.RegisterType((*FundingRateRecord)(nil), "nibiru.perp.v2.FundingRateRecord")
+	proto.RegisterType((*CollateralAsset)(nil), "nibiru.perp.v2.CollateralAsset")
+	proto.RegisterType((*PositionCursor)(nil), "nibiru.perp.v2.PositionCursor")
+	proto.RegisterType((*PendingLoss)(nil), "nibiru.perp.v2.PendingLoss")
+}
+
+func init() { proto.RegisterFile("perp/v2/state.proto", fileDescriptor_9a497e70afa7e7d6) }
+
+var fileDescriptor_9a497e70afa7e7d6 = []byte{
+	// 2468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x99, 0x4d, 0x6f, 0xe3, 0xc6,
+	0xf9, 0xc0, 0x57, 0xb2, 0xd6, 0x96, 0x1e, 0xc9, 0xb6, 0x32, 0xde, 0x5d, 0xd3, 0xfe, 0x27, 0xb6,
+	0xff, 0x02, 0x5a, 0x6c, 0xb7, 0x88, 0xd4, 0x75, 0x8b, 0xa2, 0x49, 0x2e, 0x91, 0x25, 0x39, 0x51,
+	0xd7, 0xb2, 0x14, 0x4a, 0x9b, 0x4d, 0x82, 0xa2, 0xc4, 0x88, 0x1c, 0x4b, 0xac, 0x49, 0x0e, 0x77,
+	0x66, 0x68, 0x7b, 0xd3, 0x7b, 0xd1, 0x5b, 0x73, 0xec, 0xb1, 0x40, 0x81, 0xa2, 0xe8, 0xa9, 0x9f,
+	0xa2, 0xc8, 0x31, 0xc7, 0xa2, 0x87, 0xa4, 0x48, 0xae, 0x05, 0x0a, 0xf4, 0x13, 0x14, 0xf3, 0x42,
+	0x5a, 0x76, 0xb6, 0xe9, 0x86, 0x71, 0xd0, 0x93, 0xc5, 0x79, 0xf9, 0x3d, 0x0f, 0x87, 0xcf, 0xeb,
+	0x18, 0x36, 0x62, 0xc2, 0xe2, 0xd6, 0xd9, 0x7e, 0x8b, 0x0b, 0x2c, 0x48, 0x33, 0x66, 0x54, 0x50,
+	0xb4, 0x16, 0xf9, 0x53, 0x9f, 0x25, 0x4d, 0x39, 0xd7, 0x3c, 0xdb, 0xdf, 0xbe, 0x33, 0xa3, 0x33,
+	0xaa, 0xa6, 0x5a, 0xf2, 0x97, 0x5e, 0xb5, 0xbd, 0xe3, 0x52, 0x1e, 0x52, 0xde, 0x9a, 0x62, 0x4e,
+	0x5a, 0x67, 0x0f, 0xa7, 0x44, 0xe0, 0x87, 0x2d, 0x97, 0xfa, 0x91, 0x99, 0xdf, 0xd2, 0xf3, 0x8e,
+	0xde, 0xa8, 0x1f, 0xd2, 0xad, 0x33, 0x4a, 0x67, 0x01, 0x69, 0xa9, 0xa7, 0x69, 0x72, 0xd2, 0xf2,
+	0x12, 0x86, 0x85, 0x4f, 0xd3, 0xad, 0xbb, 0xd7, 0xe7, 0x85, 0x1f, 0x12, 0x2e, 0x70, 0x18, 0xeb,
+	0x05, 0x8d, 0x4f, 0x56, 0x60, 0x79, 0x84, 0x19, 0x0e, 0x39, 0x7a, 0x1d, 0xb6, 0x02, 0xff, 0x69,
+	0xe2, 0x7b, 0x0a, 0xe0, 0xf0, 0x73, 0x42, 0x62, 0x87, 0x44, 0x78, 0x1a, 0x10, 0xcf, 0x2a, 0xec,
+	0x15, 0xee, 0x97, 0xed, 0xcd, 0x85, 0x05, 0x63, 0x39, 0xdf, 0xd3, 0xd3, 0xe8, 0x0d, 0xd8, 0x0e,
+	0xf1, 0x85, 0xb3, 0x30, 0xcd, 0x9d, 0x98, 0x30, 0x67, 0x1a, 0x50, 0xf7, 0xd4, 0x2a, 0xee, 0x15,
+	0xee, 0x97, 0xec, 0xcd, 0x10, 0x5f, 0x1c, 0x2d, 0x2c, 0x18, 0x11, 0x76, 0x20, 0xa7, 0xd1, 0x0c,
+	0x2c, 0x3f, 0xe2, 0x09, 0xc3, 0x91, 0x4b, 0x9c, 0x93, 0x24, 0xf2, 0x9c, 0x13, 0x42, 0x1c, 0xf5,
+	0x1e, 0xd6, 0xd2, 0x5e, 0xe1, 0x7e, 0xe5, 0xa0, 0xf9, 0xf1, 0xa7, 0xbb, 0xb7, 0xfe, 0xf6, 0xe9,
+	0xee, 0x77, 0x67, 0xbe, 0x98, 0x27, 0xd3, 0xa6, 0x4b, 0x43, 0x73, 0x0e, 0xe6, 0xcf, 0xab, 0xdc,
+	0x3b, 0x6d, 0x89, 0x67, 0x31, 0xe1, 0xcd, 0x2e, 0x71, 0xed, 0xbb, 0x19, 0xef, 0x30, 0x89, 0xbc,
+	0x43, 0x42, 0x6c, 0x09, 0x43, 0x31, 0x34, 0xae, 0x09, 0x3a, 0xf7, 0xc5, 0xdc, 0x63, 0xf8, 0x1c,
+	0x07, 0x8e, 0x4b, 0x69, 0xe0, 0xd1, 0xf3, 0xc8, 0x2a, 0xed, 0x15, 0xee, 0x57, 0xf7, 0xb7, 0x9a,
+	0xfa, 0xe8, 0x9a, 0xe9, 0xd1, 0x35, 0xbb, 0xe6, 0x68, 0x0f, 0xca, 0x52, 0x9b, 0xdf, 0x7e, 0xb6,
+	0x5b, 0xb0, 0x77, 0xaf, 0xc8, 0x79, 0x92, 0xc1, 0x3a, 0x86, 0x85, 0x5e, 0x87, 0x8a, 0x7c, 0x17,
+	0xe1, 0x13, 0xc6, 0xad, 0xdb, 0x7b, 0x4b, 0xf7, 0xab, 0xfb, 0x9b, 0xcd, 0xab, 0x46, 0xd1, 0x3c,
+	0x24, 0x64, 0xe2, 0x13, 0x76, 0x50, 0x92, 0x58, 0xbb, 0x7c, 0xa2, 0x1f, 0x39, 0xfa, 0x19, 0x20,
+	0x46, 0x4e, 0x08, 0x63, 0x38, 0x58, 0x38, 0x90, 0xe5, 0x5c, 0x07, 0x52, 0x4f, 0x49, 0xd9, 0x59,
+	0x9c, 0xc0, 0x66, 0x46, 0xf7, 0x7c, 0xee, 0xd2, 0x24, 0x12, 0x46, 0xc4, 0x4a, 0xbe, 0x33, 0x4f,
+	0x71, 0x5d, 0x43, 0xd3, 0x72, 0xde, 0x84, 0x97, 0x79, 0x84, 0x63, 0x3e, 0xa7, 0xc2, 0x61, 0x44,
+	0x90, 0x48, 0x19, 0x57, 0x40, 0xe9, 0xe9, 0x14, 0xbb, 0xa7, 0xdc, 0x2a, 0x2b, 0xdb, 0xd8, 0x4e,
+	0xd7, 0xd8, 0xe9, 0x92, 0xa3, 0x74, 0x05, 0x3a, 0x80, 0x1d, 0x69, 0x5b, 0xe9, 0x0a, 0xe9, 0x07,
+	0x49, 0x44, 0xbc, 0x05, 0xfb, 0xaa, 0x68, 0x46, 0x88, 0x2f, 0xc6, 0xe9, 0xa2, 0x91, 0x5a, 0x93,
+	0x99, 0xd8, 0x9b, 0xf0, 0x8a, 0x64, 0x50, 0xe6, 0x11, 0xc6, 0x1d, 0x72, 0x41, 0xdc, 0x44, 0x5c,
+	0x41, 0x80, 0x42, 0x6c, 0x85, 0xf8, 0x62, 0xa8, 0xd6, 0xf4, 0xcc, 0x92, 0x8c, 0x30, 0x85, 0xbb,
+	0x6e, 0x40, 0xb9, 0x1f, 0xcd, 0x34, 0xc5, 0xf1, 0x48, 0x4c, 0xb9, 0x2f, 0xac, 0xea, 0xd7, 0x3e,
+	0xad, 0x7e, 0x24, 0xec, 0x0d, 0x03, 0x53, 0xd2, 0xba, 0x1a, 0x85, 0xba, 0xb0, 0x2b, 0xb5, 0x54,
+	0x0f, 0xca, 0x85, 0xb8, 0x8b, 0xa3, 0xab, 0xaf, 0x5a, 0x53, 0x7a, 0xfe, 0x5f, 0x88, 0x2f, 0x46,
+	0xe9, 0xaa, 0xb1, 0x5e, 0x94, 0x6a, 0xda, 0xf8, 0x75, 0x11, 0x56, 0x8c, 0x4d, 0xa1, 0x01, 0x40,
+	0xe8, 0x47, 0xce, 0x19, 0x0d, 0x92, 0x90, 0x58, 0x85, 0xaf, 0xad, 0xaa, 0xfc, 0xb0, 0x95, 0xd0,
+	0x8f, 0xde, 0x55, 0x00, 0xf4, 0x2e, 0xac, 0x0b, 0x7c, 0x4a, 0xd8, 0x82, 0x3d, 0x16, 0x73, 0x31,
+	0x57, 0x15, 0x26, 0x33, 0xc6, 0x77, 0x61, 0x3d, 0xbc, 0xc6, 0xcd, 0xe7, 0xf8, 0xab, 0xe1, 0x22,
+	0xb7, 0xf1, 0xaf, 0x75, 0x58, 0x1e, 0x60, 0x76, 0x4a, 0x04, 0x1a, 0x40, 0x29, 0xc6, 0x3e, 0x33,
+	0x67, 0xf0, 0x9a, 0xe1, 0x3e, 0x5c, 0xe0, 0x1e, 0x2b, 0xb7, 0xec, 0xcc, 0xb1, 0x1f, 0xb5, 0xb4,
+	0x8b, 0xb6, 0x2e, 0x5a, 0x2e, 0x0d, 0x43, 0x1a, 0xb5, 0x30, 0xe7, 0x44, 0x34, 0x47, 0xd8, 0x67,
+	0xb6, 0xc2, 0x20, 0x0b, 0x56, 0xd2, 0xd0, 0x58, 0x54, 0xa1, 0x31, 0x7d, 0x44, 0x4f, 0xe1, 0x95,
+	0x98, 0xf9, 0x32, 0xc0, 0x04, 0x89, 0x2b, 0x12, 0x1d, 0x4c, 0x03, 0x3f, 0xf4, 0xc5, 0x37, 0x7a,
+	0xb3, 0x6d, 0x05, 0x3d, 0xbc, 0x64, 0x1e, 0x49, 0xa4, 0x3e, 0xbe, 0x39, 0x58, 0x21, 0xf6, 0x23,
+	0x41, 0x22, 0x15, 0xd9, 0x42, 0xcc, 0x66, 0x7e, 0x64, 0xa4, 0x95, 0x72, 0x49, 0xbb, 0xb7, 0xc0,
+	0x1b, 0x28, 0x9c, 0x96, 0xf4, 0x0e, 0xd4, 0x54, 0x9c, 0x27, 0x67, 0x84, 0xe1, 0x19, 0xb1, 0x6e,
+	0xe7, 0xa2, 0x57, 0x65, 0x26, 0x30, 0x08, 0xf4, 0x4b, 0x68, 0x04, 0x58, 0x10, 0x2e, 0x1c, 0x37,
+	0x09, 0x93, 0x00, 0x0b, 0xff, 0x8c, 0x38, 0x31, 0x23, 0xa1, 0x9f, 0x84, 0xce, 0x09, 0xc3, 0xae,
+	0x7c, 0xd9, 0x9c, 0x61, 0x6f, 0x57, 0x93, 0x3b, 0x19, 0x78, 0xa4, 0xb9, 0x87, 0x06, 0x2b, 0x63,
+	0x2c, 0xb9, 0x70, 0xe7, 0x38, 0x9a, 0x91, 0x05, 0xdb, 0xcb, 0x17, 0x00, 0xeb, 0x29, 0x29, 0x33,
+	0xeb, 0x19, 0x58, 0xc4, 0xa5, 0xfc, 0x19, 0x17, 0x24, 0xbc, 0x9e, 0xd8, 0xca, 0xf9, 0x82, 0x6c,
+	0xc6, 0xbb, 0x92, 0xd8, 0xa6, 0x70, 0x77, 0x31, 0x75, 0x5f, 0x4a, 0xa9, 0xe4, 0x92, 0xb2, 0xb1,
+	0x00, 0xcb, 0x64, 0xfc, 0x02, 0xb6, 0x62, 0xcc, 0x84, 0x8f, 0x83, 0xc5, 0x34, 0x6f, 0xe4, 0x40,
+	0x2e, 0x39, 0x9b, 0x06, 0xb8, 0x50, 0x15, 0x68, 0x59, 0x0f, 0xe1, 0xae, 0x3c, 0x2e, 0x19, 0x6c,
+	0x19, 0x16, 0xc4, 0x21, 0x31, 0x75, 0xe7, 0x8e, 0xef, 0xe9, 0x60, 0x6b, 0x23, 0x33, 0x69, 0x63,
+	0x41, 0x7a, 0x72, 0xaa, 0xef, 0xa1, 0xc7, 0x70, 0x47, 0x9c, 0xe3, 0x38, 0xcb, 0x2c, 0xce, 0xb9,
+	0x1f, 0x79, 0xf4, 0xdc, 0xaa, 0xbd, 0x78, 0x36, 0x47, 0x12, 0x90, 0xe6, 0x9d, 0x27, 0x6a, 0x3b,
+	0xea, 0x43, 0x3d, 0x66, 0x24, 0xc6, 0xbe, 0xe7, 0x4c, 0xb1, 0xe7, 0x78, 0x64, 0x2a, 0xac, 0x55,
+	0x83, 0x34, 0x95, 0x98, 0x2c, 0xdb, 0x9a, 0xa6, 0x6c, 0x6b, 0x76, 0xa8, 0x1f, 0x99, 0x4c, 0xbe,
+	0x66, 0x36, 0x1e, 0x60, 0xaf, 0x4b, 0xa6, 0x42, 0x86, 0x0c, 0x4e, 0x84, 0x90, 0x21, 0x63, 0x4d,
+	0x87, 0x0c, 0xf3, 0x88, 0xde, 0x87, 0xba, 0xfe, 0x19, 0x92, 0x48, 0x38, 0xca, 0xd1, 0xad, 0xf5,
+	0x5c, 0x27, 0xba, 0x7e, 0xc9, 0x19, 0x49, 0x0c, 0x0a, 0x60, 0x9b, 0x91, 0x98, 0xcc, 0x1c, 0xcf,
+	0x3f, 0x23, 0x6c, 0x46, 0x64, 0x7c, 0x10, 0x73, 0x46, 0xf8, 0x9c, 0x06, 0x9e, 0x55, 0xcf, 0x25,
+	0xc4, 0x52, 0xc4, 0x6e, 0x06, 0x9c, 0xa4, 0x3c, 0xe4, 0xc2, 0x3d, 0x2d, 0x6d, 0x9a, 0x78, 0x33,
+	0x22, 0x54, 0xde, 0x52, 0xdf, 0xce, 0x7a, 0x29, 0x5f, 0x96, 0x54, 0xb4, 0x03, 0x05, 0x1b, 0x11,
+	0xa6, 0xbe, 0x35, 0xfa, 0x00, 0x5e, 0x52, 0xb9, 0x3c, 0x26, 0x91, 0x23, 0x83, 0x14, 0x23, 0x5c,
+	0x58, 0x28, 0xdf, 0x71, 0xc9, 0x7c, 0x1f, 0x93, 0xa8, 0x6f, 0x30, 0xe8, 0xe7, 0xb0, 0x21, 0xd9,
+	0x82, 0x61, 0x99, 0xe2, 0x23, 0x2a, 0x2d, 0x04, 0x07, 0xd6, 0x46, 0x2e, 0xba, 0x54, 0x73, 0xa2,
+	0x48, 0xc7, 0x06, 0x84, 0xfa, 0x50, 0x96, 0xfc, 0xa9, 0x8f, 0xb9, 0x75, 0x27, 0x17, 0x74, 0x25,
+	0xc4, 0x17, 0x07, 0x3e, 0xe6, 0xe8, 0x3d, 0xa8, 0x4b, 0xd4, 0xa2, 0x9f, 0x58, 0x77, 0x73, 0x21,
+	0xd7, 0x42, 0x7c, 0x71, 0x78, 0xe9, 0x51, 0x32, 0x9a, 0xa4, 0xd4, 0xf4, 0x7c, 0x35, 0xfe, 0x5e,
+	0xbe, 0x68, 0x62, 0x60, 0xe9, 0x21, 0x2b, 0x19, 0xef, 0x43, 0x3d, 0x95, 0xe1, 0xe1, 0x30, 0x26,
+	0x11, 0x61, 0xd6, 0x66, 0xbe, 0x6f, 0x68, 0x38, 0x5d, 0x83, 0x69, 0xfc, 0xa5, 0x04, 0x4b, 0xed,
+	0xc1, 0xe0, 0xa6, 0x33, 0xfe, 0x3b, 0x50, 0x93, 0x9e, 0xee, 0x30, 0xc2, 0x09, 0x3b, 0x23, 0x39,
+	0x0b, 0x9f, 0xaa, 0x64, 0xd8, 0x1a, 0x81, 0xc6, 0xb0, 0xfa, 0x34, 0xa1, 0xe2, 0x92, 0x99, 0xaf,
+	0x34, 0xa8, 0x29, 0x48, 0x0a, 0x1d, 0x00, 0xf0, 0xa7, 0x4c, 0xc8, 0xfa, 0x54, 0xcc, 0x73, 0xa6,
+	0xff, 0x8a, 0x24, 0x74, 0x25, 0x40, 0x7e, 0x28, 0x5d, 0xce, 0x84, 0x49, 0x20, 0xfc, 0x38, 0xf0,
+	0x09, 0xcb, 0x99, 0xf5, 0xd7, 0x15, 0x67, 0x90, 0x61, 0xa4, 0xa6, 0x82, 0x0a, 0x99, 0x4f, 0x68,
+	0x34, 0xcb, 0x99, 0xe1, 0x2b, 0x8a, 0x70, 0x44, 0xa3, 0x19, 0x1a, 0x42, 0x55, 0xe3, 0xf8, 0x9c,
+	0x32, 0x91, 0x33, 0x89, 0x6b, 0x8d, 0xc6, 0x92, 0xd0, 0xf8, 0xf3, 0x32, 0x94, 0xd3, 0x2a, 0x1b,
+	0x7d, 0x07, 0xd6, 0x4c, 0x54, 0xc0, 0x9e, 0xc7, 0x08, 0xe7, 0xda, 0xae, 0xec, 0x55, 0x3d, 0xda,
+	0xd6, 0x83, 0x99, 0xd1, 0x15, 0x6f, 0xc6, 0xe8, 0x0e, 0xa0, 0xc4, 0xfd, 0x0f, 0xf3, 0x1a, 0x86,
+	0xda, 0x8b, 0x0e, 0x61, 0x59, 0x57, 0x84, 0x39, 0x8d, 0xc1, 0xec, 0x96, 0xd6, 0xaa, 0x62, 0x6e,
+	0x16, 0x15, 0xf3, 0x99, 0x41, 0x4d, 0x42, 0xb2, 0x80, 0xf8, 0x3f, 0xad, 0xfe, 0x5e, 0x83, 0xad,
+	0x00, 0x73, 0xe1, 0x24, 0xb1, 0x87, 0x65, 0x3b, 0xa8, 0x5a, 0x2c, 0x27, 0x4a, 0xc2, 0x29, 0x61,
+	0xca, 0x7e, 0x96, 0xec, 0x7b, 0x72, 0xc1, 0x63, 0x3d, 0xaf, 0xda, 0xab, 0x63, 0x35, 0x2b, 0xa3,
+	0x01, 0x23, 0x38, 0xf0, 0x3f, 0x94, 0xdd, 0x59, 0x14, 0xe4, 0x2c, 0xe7, 0xaa, 0x29, 0x63, 0x14,
+	0x05, 0x12, 0x99, 0x86, 0x44, 0x59, 0x36, 0xe4, 0xac, 0xdd, 0xaa, 0x86, 0x31, 0xc2, 0xbe, 0x87,
+	0x1e, 0xa9, 0xeb, 0x07, 0xae, 0x79, 0xf9, 0x6a, 0x34, 0x79, 0x1f, 0xc1, 0x15, 0xac, 0x01, 0xab,
+	0x24, 0x12, 0xec, 0x99, 0x23, 0xef, 0x90, 0x9c, 0x90, 0xab, 0x62, 0x6c, 0xc9, 0xae, 0xaa, 0xc1,
+	0x89, 0x1f, 0x92, 0x01, 0x6f, 0x60, 0x58, 0x37, 0x71, 0x28, 0xed, 0xc4, 0xd1, 0xf7, 0x61, 0x09,
+	0x87, 0xa1, 0xf2, 0x96, 0xea, 0xfe, 0xc6, 0xf5, 0xcb, 0x8f, 0xf6, 0x60, 0x60, 0xca, 0x25, 0xb9,
+	0x0a, 0xfd, 0x3f, 0xd4, 0xb2, 0x1b, 0x2a, 0x29, 0xa2, 0xa8, 0x45, 0x64, 0x63, 0x03, 0xde, 0xf8,
+	0x68, 0x19, 0x6e, 0xab, 0xae, 0x19, 0xad, 0x41, 0xd1, 0xd7, 0x37, 0x53, 0x25, 0xbb, 0xe8, 0x7b,
+	0xcf, 0x71, 0xd1, 0xe2, 0x57, 0xb9, 0xe8, 0xd2, 0xcd, 0xb8, 0xe8, 0x4f, 0x00, 0xf4, 0x85, 0x80,
+	0x3c, 0x33, 0xe5, 0x62, 0x6b, 0xfb, 0x5b, 0xd7, 0x5f, 0x53, 0x29, 0x3c, 0x79, 0x16, 0x13, 0xbb,
+	0x42, 0xd3, 0x9f, 0xe8, 0x55, 0xe9, 0xdc, 0x9e, 0x6e, 0xa2, 0x9e, 0xb3, 0xa7, 0xeb, 0x33, 0xa2,
+	0xec, 0xd4, 0x56, 0xcb, 0xa4, 0xff, 0x09, 0xe6, 0xcf, 0x66, 0x84, 0x99, 0x12, 0x31, 0x9f, 0x57,
+	0xd4, 0x0c, 0x44, 0xd7, 0x87, 0x3d, 0xa8, 0xe9, 0xf0, 0xce, 0x69, 0xc2, 0x5c, 0xa2, 0xac, 0x7e,
+	0x6d, 0xbf, 0x71, 0x5d, 0x97, 0xc9, 0xc2, 0x9e, 0xb1, 0x5a, 0x69, 0x57, 0xe3, 0xcb, 0x07, 0xd9,
+	0x47, 0xe9, 0x4c, 0xa6, 0x8e, 0xc7, 0xc1, 0xa1, 0xbc, 0x00, 0xb2, 0xca, 0xb9, 0x8a, 0xbe, 0xba,
+	0x22, 0xb5, 0x25, 0xa8, 0xad, 0x38, 0xe8, 0xa7, 0x50, 0xce, 0x3a, 0xce, 0x7c, 0x5e, 0x91, 0xed,
+	0x47, 0x04, 0x36, 0x55, 0x1a, 0x5f, 0x54, 0x54, 0xb7, 0xe7, 0x16, 0xe4, 0x52, 0xf7, 0x8e, 0xc4,
+	0x2d, 0x68, 0xab, 0xfa, 0x72, 0x69, 0xc8, 0x3a, 0x9a, 0xcc, 0x89, 0x3f, 0x9b, 0x8b, 0xd4, 0x57,
+	0xd4, 0xd8, 0xdb, 0x6a, 0x08, 0xbd, 0x0d, 0x2b, 0xe9, 0x1d, 0x52, 0x2d, 0x97, 0xe4, 0x74, 0x7b,
+	0xe3, 0x8f, 0x05, 0x40, 0x1d, 0x46, 0x39, 0xd7, 0xad, 0x7a, 0xdb, 0x55, 0xf7, 0x6f, 0x2f, 0x9a,
+	0xb2, 0x4e, 0x01, 0x5c, 0x1a, 0xc8, 0x58, 0xc9, 0x70, 0x60, 0x15, 0xf7, 0x96, 0xbe, 0xba, 0xb9,
+	0xf9, 0x81, 0xd4, 0xf2, 0x4f, 0x9f, 0xed, 0xde, 0x7f, 0x01, 0x2d, 0xe5, 0x06, 0x6e, 0x2f, 0xe0,
+	0x1b, 0xff, 0x28, 0xc0, 0x66, 0xff, 0xf9, 0x97, 0xa6, 0x52, 0x5f, 0xae, 0xaf, 0x97, 0xae, 0xe9,
+	0xab, 0x47, 0x53, 0x7d, 0x5d, 0x58, 0xe6, 0x73, 0xcc, 0x08, 0xff, 0x36, 0x74, 0x35, 0x68, 0xd4,
+	0x83, 0x6a, 0x12, 0xa9, 0x0f, 0x28, 0x63, 0x8f, 0x8a, 0x15, 0xd5, 0xfd, 0xed, 0x2f, 0x75, 0x91,
+	0x93, 0x34, 0x30, 0xe9, 0x36, 0xf2, 0x23, 0xd9, 0x46, 0x82, 0xde, 0x28, 0xa7, 0x1a, 0xbf, 0x29,
+	0x40, 0x4d, 0xb7, 0x00, 0xe6, 0x06, 0xed, 0x05, 0xbf, 0x49, 0x1d, 0x96, 0x3c, 0xfc, 0xcc, 0x5c,
+	0x9c, 0xcb, 0x9f, 0x32, 0x8b, 0x9b, 0x5b, 0xbc, 0x7c, 0xb5, 0x80, 0xd9, 0xdd, 0x18, 0x40, 0xcd,
+	0x36, 0x17, 0xb5, 0x1d, 0xea, 0x11, 0x84, 0xa0, 0xe4, 0x52, 0xcf, 0xdc, 0x0d, 0xda, 0xea, 0x37,
+	0xfa, 0x1e, 0x98, 0xfb, 0xe2, 0x2f, 0x85, 0xd2, 0xf5, 0x74, 0xdc, 0x28, 0xda, 0x78, 0x04, 0x6b,
+	0xfa, 0xfd, 0x52, 0xe8, 0x8b, 0xbe, 0x61, 0x2a, 0xb7, 0x78, 0x29, 0xb7, 0xf1, 0x87, 0x02, 0xd4,
+	0x53, 0x4e, 0x0f, 0xb3, 0xc8, 0x8f, 0x66, 0xfc, 0xb9, 0xca, 0x14, 0x9e, 0xab, 0x0c, 0x9a, 0x41,
+	0x99, 0x98, 0x6d, 0xdf, 0x86, 0x6d, 0x64, 0xf0, 0xc6, 0xef, 0x4a, 0xf0, 0xd2, 0x42, 0xc7, 0x64,
+	0x13, 0x97, 0x32, 0xef, 0xa6, 0x1b, 0x8e, 0x3b, 0x70, 0x5b, 0xf7, 0xce, 0xda, 0x0a, 0xf4, 0x83,
+	0x4c, 0xe9, 0x21, 0x66, 0xa7, 0x8e, 0xbc, 0xab, 0xc8, 0x69, 0x0a, 0x65, 0x09, 0x98, 0x9c, 0xe3,
+	0x58, 0x56, 0xe0, 0x7e, 0xe4, 0x91, 0x0b, 0x4d, 0xcb, 0xd9, 0x2b, 0x28, 0x82, 0xc2, 0xa9, 0x5e,
+	0xe1, 0x5a, 0xe9, 0x96, 0xbb, 0x57, 0xb8, 0x5a, 0xaa, 0x2d, 0x14, 0x47, 0xaa, 0x15, 0x5d, 0xfe,
+	0x46, 0xc5, 0x91, 0x6a, 0x41, 0x2d, 0x58, 0x71, 0x03, 0xd9, 0x34, 0x7a, 0x2a, 0xeb, 0x95, 0xed,
+	0xf4, 0x11, 0x1d, 0x40, 0x25, 0xab, 0x38, 0xac, 0xf2, 0xd7, 0x70, 0xfd, 0xcb, 0x6d, 0x8d, 0x7f,
+	0x16, 0x60, 0xbd, 0x93, 0xc5, 0x3d, 0x95, 0x1f, 0xe4, 0x17, 0xf5, 0x48, 0x44, 0x43, 0x63, 0xbf,
+	0xfa, 0x01, 0x7d, 0x00, 0x55, 0xca, 0xb0, 0x1b, 0x10, 0xe7, 0x66, 0x3a, 0x07, 0xd0, 0x34, 0xf9,
+	0x5b, 0xe6, 0x98, 0x39, 0xf6, 0x99, 0x9b, 0x88, 0x9c, 0xb6, 0x92, 0x6e, 0x47, 0x7b, 0x50, 0xe3,
+	0x31, 0x15, 0x4e, 0x4c, 0x69, 0x20, 0x6f, 0xe2, 0x4a, 0xca, 0x28, 0x41, 0x8e, 0x8d, 0x28, 0x0d,
+	0xfa, 0x5e, 0xe3, 0x57, 0x05, 0x58, 0x4b, 0xdb, 0xa5, 0x4e, 0xc2, 0x38, 0x65, 0x37, 0xed, 0x11,
+	0x2f, 0x56, 0xe0, 0x35, 0x7e, 0x5f, 0x80, 0xea, 0x88, 0xa8, 0x0f, 0x7d, 0x44, 0x39, 0xff, 0x0f,
+	0xc7, 0x7e, 0x08, 0xcb, 0xa6, 0x4c, 0x29, 0xe6, 0xca, 0xbe, 0x66, 0x37, 0xfa, 0x31, 0x2c, 0xbb,
+	0xea, 0x6d, 0x4d, 0x92, 0xd8, 0xb9, 0x5e, 0x3b, 0x5d, 0x3d, 0x13, 0xdb, 0xac, 0x7e, 0xf0, 0x06,
+	0x54, 0xb2, 0x0a, 0x0f, 0x6d, 0xc1, 0xdd, 0x6e, 0xdf, 0xee, 0x75, 0x26, 0xfd, 0xe1, 0xb1, 0xf3,
+	0xf8, 0x78, 0x3c, 0xea, 0x75, 0xfa, 0x87, 0xfd, 0x5e, 0xb7, 0x7e, 0x0b, 0x95, 0xa1, 0x74, 0x34,
+	0x3c, 0x7e, 0xab, 0x5e, 0x40, 0x15, 0xb8, 0x3d, 0x7e, 0x7b, 0x68, 0x4f, 0xea, 0xc5, 0x07, 0x33,
+	0x58, 0x93, 0x1e, 0xd7, 0xc1, 0x81, 0x3b, 0x8c, 0x15, 0x61, 0x0f, 0x5e, 0x9e, 0x3c, 0x69, 0x8f,
+	0x9c, 0x4e, 0xfb, 0xa8, 0xe3, 0x0c, 0x47, 0xcf, 0x07, 0x8d, 0x47, 0xc3, 0x49, 0xbd, 0x80, 0xee,
+	0x40, 0xfd, 0x9d, 0xc7, 0xc3, 0x49, 0xcf, 0x69, 0x8f, 0xc7, 0xbd, 0x89, 0x33, 0x7e, 0xd2, 0x1e,
+	0xd5, 0x8b, 0x68, 0x03, 0xd6, 0x0f, 0xda, 0xe3, 0x2b, 0x83, 0x4b, 0x0f, 0x5c, 0xa8, 0x64, 0xb5,
+	0x2b, 0xda, 0x86, 0x7b, 0x43, 0xbb, 0xdb, 0xb3, 0x9d, 0xc9, 0xfb, 0xa3, 0xde, 0x35, 0x7a, 0x05,
+	0x6e, 0x1f, 0xf5, 0x07, 0x7d, 0x89, 0x5f, 0x87, 0xea, 0x78, 0x32, 0x1c, 0x39, 0x83, 0xb6, 0xfd,
+	0xa8, 0x37, 0xa9, 0x17, 0xe5, 0xc0, 0xa4, 0xfd, 0xa8, 0xe7, 0x8c, 0xec, 0xe1, 0x61, 0x7f, 0x52,
+	0x5f, 0x42, 0xab, 0x50, 0x51, 0x2b, 0x8e, 0x86, 0xe3, 0x71, 0xbd, 0xf4, 0xe0, 0x47, 0x80, 0xbe,
+	0x5c, 0x60, 0xa2, 0x35, 0x00, 0x49, 0x70, 0x46, 0x76, 0xbf, 0xd3, 0xab, 0xdf, 0x92, 0xcf, 0xfd,
+	0xe3, 0x6e, 0xef, 0x3d, 0x47, 0xbe, 0x67, 0xbd, 0x70, 0xf0, 0xd6, 0xc7, 0x9f, 0xef, 0x14, 0x3e,
+	0xf9, 0x7c, 0xa7, 0xf0, 0xf7, 0xcf, 0x77, 0x0a, 0x1f, 0x7d, 0xb1, 0x73, 0xeb, 0x93, 0x2f, 0x76,
+	0x6e, 0xfd, 0xf5, 0x8b, 0x9d, 0x5b, 0x1f, 0xbc, 0xfa, 0xdf, 0x0c, 0x4c, 0xfd, 0xaf, 0x5e, 0x7d,
+	0xca, 0xd6, 0xd9, 0xfe, 0x74, 0x59, 0xf9, 0xf4, 0x0f, 0xff, 0x3d, 0x00, 0x3b, 0x75, 0x45, 0x21,
+	0xc3, 0x1f, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.LastUpdatedBlockNumber != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.LastUpdatedBlockNumber))
+	if m.MaxPositionsScannedPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxPositionsScannedPerBlock))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x60
 	}
 	{
-		size := m.LatestCumulativePremiumFraction.Size()
+		size := m.ClosingOrderDeposit.Size()
 		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ClosingOrderDeposit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x32
+	dAtA[i] = 0x5a
+	if m.MaxOrdersExecutedPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxOrdersExecutedPerBlock))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxSnapshotsPrunedPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxSnapshotsPrunedPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SnapshotRetentionLookbacks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SnapshotRetentionLookbacks))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
-		size := m.OpenNotional.Size()
+		size := m.ReferralDiscountRatio.Size()
 		i -= size
-		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ReferralDiscountRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x3a
 	{
-		size := m.Margin.Size()
+		size := m.ReferralFeeRatio.Size()
 		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ReferralFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Size_.Size()
-		i -= size
-		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0x32
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
 	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.InsuranceFundWithdrawalCooldown, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.InsuranceFundWithdrawalCooldown):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintState(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x22
 	{
-		size := m.Pair.Size()
+		size := m.InsuranceFundFeeRatio.Size()
 		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InsuranceFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+	dAtA[i] = 0x1a
+	if m.MaxLiquidationsPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxLiquidationsPerBlock))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.LiquidationSweepEnabled {
+		i--
+		if m.LiquidationSweepEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReserveSnapshot) Marshal() (dAtA []byte, err error) {
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -843,190 +1664,4081 @@ func (m *ReserveSnapshot) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReserveSnapshot) MarshalTo(dAtA []byte) (int, error) {
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TimestampMs != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
-		i--
-		dAtA[i] = 0x10
-	}
 	{
-		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.MakerFeeRatio.Size()
+		i -= size
+		if _, err := m.MakerFeeRatio.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TakerFeeRatio.Size()
 		i -= size
+		if _, err := m.TakerFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
 		i = encodeVarintState(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.Enabled {
-		n += 2
+	{
+		size := m.FundingDampener.Size()
+		i -= size
+		if _, err := m.FundingDampener.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = m.PriceFluctuationLimitRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaxLeverage.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.ExchangeFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.EcosystemFundFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LiquidationFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PartialLiquidationRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.FundingRateEpochId)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.FundingInterestRate.Size()
+		i -= size
+		if _, err := m.FundingInterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
-	n += 1 + l + sovState(uint64(l))
-	l = m.PrepaidBadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
-}
-
-func (m *AMM) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.MaxFundingRate.Size()
+		i -= size
+		if _, err := m.MaxFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.BaseReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.QuoteReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.SqrtDepth.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PriceMultiplier.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.TotalLong.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.TotalShort.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
-}
-
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxBias.Size()
+		i -= size
+		if _, err := m.MaxBias.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MaxTraderNotional.Size()
+		i -= size
+		if _, err := m.MaxTraderNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Size_.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.OpenNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.LastUpdatedBlockNumber != 0 {
-		n += 1 + sovState(uint64(m.LastUpdatedBlockNumber))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ReserveSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.RepegBudgetPerEpoch.Size()
+		i -= size
+		if _, err := m.RepegBudgetPerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.Amm.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.TimestampMs != 0 {
-		n += 1 + sovState(uint64(m.TimestampMs))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.RepegDivergenceThreshold.Size()
+		i -= size
+		if _, err := m.RepegDivergenceThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.SettlementPrice.Size()
+		i -= size
+		if _, err := m.SettlementPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size, err := m.PrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintState(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	if len(m.FundingRateEpochId) > 0 {
+		i -= len(m.FundingRateEpochId)
+		copy(dAtA[i:], m.FundingRateEpochId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateEpochId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.PartialLiquidationRatio.Size()
+		i -= size
+		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidationFeeRatio.Size()
+		i -= size
+		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.EcosystemFundFeeRatio.Size()
+		i -= size
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ExchangeFeeRatio.Size()
+		i -= size
+		if _, err := m.ExchangeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceFluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.PriceFluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AMM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AMM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AMM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShort.Size()
+		i -= size
+		if _, err := m.TotalShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalLong.Size()
+		i -= size
+		if _, err := m.TotalLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceMultiplier.Size()
+		i -= size
+		if _, err := m.PriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SqrtDepth.Size()
+		i -= size
+		if _, err := m.SqrtDepth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.QuoteReserve.Size()
+		i -= size
+		if _, err := m.QuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseReserve.Size()
+		i -= size
+		if _, err := m.BaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EntryTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EntryTimeMs))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.FeesPaid.Size()
+		i -= size
+		if _, err := m.FeesPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.FundingPaid.Size()
+		i -= size
+		if _, err := m.FundingPaid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.LastUpdatedBlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LastUpdatedBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenNotional.Size()
+		i -= size
+		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.PriceSource != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Collateral) > 0 {
+		for iNdEx := len(m.Collateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintState(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakerAddress) > 0 {
+		i -= len(m.StakerAddress)
+		copy(dAtA[i:], m.StakerAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.StakerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderReferral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderReferral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderReferral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReferrerAddress) > 0 {
+		i -= len(m.ReferrerAddress)
+		copy(dAtA[i:], m.ReferrerAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReferrerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintState(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.Clamped {
+		i--
+		if m.Clamped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PremiumFraction.Size()
+		i -= size
+		if _, err := m.PremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IndexTwap.Size()
+		i -= size
+		if _, err := m.IndexTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MarkTwap.Size()
+		i -= size
+		if _, err := m.MarkTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpotPoolId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SpotPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Haircut.Size()
+		i -= size
+		if _, err := m.Haircut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OraclePair.Size()
+		i -= size
+		if _, err := m.OraclePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingLoss) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingLoss) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingLoss) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cursor != nil {
+		{
+			size, err := m.Cursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LiquidationSweepEnabled {
+		n += 2
+	}
+	if m.MaxLiquidationsPerBlock != 0 {
+		n += 1 + sovState(uint64(m.MaxLiquidationsPerBlock))
+	}
+	l = m.InsuranceFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.InsuranceFundWithdrawalCooldown)
+	n += 1 + l + sovState(uint64(l))
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.ReferralFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ReferralDiscountRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.SnapshotRetentionLookbacks != 0 {
+		n += 1 + sovState(uint64(m.SnapshotRetentionLookbacks))
+	}
+	if m.MaxSnapshotsPrunedPerBlock != 0 {
+		n += 1 + sovState(uint64(m.MaxSnapshotsPrunedPerBlock))
+	}
+	if m.MaxOrdersExecutedPerBlock != 0 {
+		n += 1 + sovState(uint64(m.MaxOrdersExecutedPerBlock))
+	}
+	l = m.ClosingOrderDeposit.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.MaxPositionsScannedPerBlock != 0 {
+		n += 1 + sovState(uint64(m.MaxPositionsScannedPerBlock))
+	}
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TakerFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MakerFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	l = m.PriceFluctuationLimitRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangeFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PartialLiquidationRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.FundingRateEpochId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	l = m.PrepaidBadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Settled {
+		n += 2
+	}
+	l = m.SettlementPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RepegDivergenceThreshold.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.RepegBudgetPerEpoch.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxOpenInterest.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxTraderNotional.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxBias.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxFundingRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.FundingInterestRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.FundingDampener.Size()
+	n += 2 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *AMM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SqrtDepth.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PriceMultiplier.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalLong.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalShort.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.OpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.LastUpdatedBlockNumber != 0 {
+		n += 1 + sovState(uint64(m.LastUpdatedBlockNumber))
+	}
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPaid.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeesPaid.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.EntryTimeMs != 0 {
+		n += 1 + sovState(uint64(m.EntryTimeMs))
+	}
+	return n
+}
+
+func (m *ReserveSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amm.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovState(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovState(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.PriceSource != 0 {
+		n += 1 + sovState(uint64(m.PriceSource))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *CrossMarginAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Collateral) > 0 {
+		for _, e := range m.Collateral {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InsuranceFundWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovState(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *ReferralCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *TraderReferral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferralEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReferrerAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Epoch != 0 {
+		n += 1 + sovState(uint64(m.Epoch))
+	}
+	l = m.MarkTwap.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.IndexTwap.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Clamped {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *CollateralAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.OraclePair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Haircut.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.SpotPoolId != 0 {
+		n += 1 + sovState(uint64(m.SpotPoolId))
+	}
+	return n
+}
+
+func (m *PositionCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingLoss) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Cursor != nil {
+		l = m.Cursor.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationSweepEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidationSweepEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLiquidationsPerBlock", wireType)
+			}
+			m.MaxLiquidationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLiquidationsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundWithdrawalCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.InsuranceFundWithdrawalCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralDiscountRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralDiscountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetentionLookbacks", wireType)
+			}
+			m.SnapshotRetentionLookbacks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetentionLookbacks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotsPrunedPerBlock", wireType)
+			}
+			m.MaxSnapshotsPrunedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotsPrunedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrdersExecutedPerBlock", wireType)
+			}
+			m.MaxOrdersExecutedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOrdersExecutedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingOrderDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClosingOrderDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionsScannedPerBlock", wireType)
+			}
+			m.MaxPositionsScannedPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPositionsScannedPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceFluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Settled = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SettlementPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepegDivergenceThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepegDivergenceThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepegBudgetPerEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepegBudgetPerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTraderNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTraderNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBias.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingInterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingDampener", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingDampener.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+			}
+			m.LastUpdatedBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesPaid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryTimeMs", wireType)
+			}
+			m.EntryTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if iNdEx >= l {
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= TriggerPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1048,7 +5760,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *CrossMarginAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,15 +5783,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossMarginAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossMarginAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1107,15 +5819,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1125,15 +5835,79 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = append(m.Collateral, types.Coin{})
+			if err := m.Collateral[len(m.Collateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceFluctuationLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1161,15 +5935,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceFluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1179,31 +5951,31 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1213,29 +5985,78 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1263,13 +6084,30 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,13 +6135,63 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1331,13 +6219,11 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1365,13 +6251,61 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderReferral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderReferral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderReferral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,13 +6333,11 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1433,13 +6365,63 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralEarnings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralEarnings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferrerAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1449,28 +6431,27 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ReferrerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1497,7 +6478,8 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1522,7 +6504,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AMM) Unmarshal(dAtA []byte) error {
+func (m *FundingRateRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1545,10 +6527,10 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingRateRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1586,10 +6568,10 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1599,29 +6581,14 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1649,13 +6616,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1683,13 +6650,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,13 +6684,13 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1751,15 +6718,35 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Clamped = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1769,23 +6756,22 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1810,7 +6796,7 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *CollateralAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,15 +6819,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollateralAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollateralAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1869,11 +6855,11 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1901,13 +6887,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Haircut", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1935,15 +6921,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Haircut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPoolId", wireType)
 			}
-			var stringLen uint64
+			m.SpotPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1953,29 +6939,64 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpotPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2003,13 +7024,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2037,29 +7058,8 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
-			}
-			m.LastUpdatedBlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2081,7 +7081,7 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+func (m *PendingLoss) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,17 +7104,17 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingLoss: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingLoss: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2124,30 +7124,63 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			m.TimestampMs = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2157,11 +7190,28 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cursor == nil {
+				m.Cursor = &PositionCursor{}
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -12,19 +12,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -461,6 +465,9 @@ type MsgOpenPosition struct {
 	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int            `protobuf:"bytes,4,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
 	Leverage             github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,5,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
 	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int            `protobuf:"bytes,6,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+	// If true, the position may only be reduced or closed: the message fails if
+	// it would open a new position, increase the existing one or flip its side.
+	ReduceOnly bool `protobuf:"varint,7,opt,name=reduce_only,json=reduceOnly,proto3" json:"reduce_only,omitempty"`
 }
 
 func (m *MsgOpenPosition) Reset()         { *m = MsgOpenPosition{} }
@@ -510,6 +517,13 @@ func (m *MsgOpenPosition) GetSide() Direction {
 	return Direction_DIRECTION_UNSPECIFIED
 }
 
+func (m *MsgOpenPosition) GetReduceOnly() bool {
+	if m != nil {
+		return m.ReduceOnly
+	}
+	return false
+}
+
 type MsgOpenPositionResponse struct {
 	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	// The amount of quote assets exchanged.
@@ -667,6 +681,123 @@ func (m *MsgClosePositionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClosePositionResponse proto.InternalMessageInfo
 
+type MsgPartialClose struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// The amount of base assets to close. The position is closed entirely if it
+	// is greater than or equal to the position size.
+	SizeAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=size_amount,json=sizeAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"size_amount"`
+	// The minimum amount of quote assets received when reducing a long, or the
+	// maximum amount paid when reducing a short. Zero disables the check.
+	QuoteAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=quote_asset_amount_limit,json=quoteAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount_limit"`
+}
+
+func (m *MsgPartialClose) Reset()         { *m = MsgPartialClose{} }
+func (m *MsgPartialClose) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialClose) ProtoMessage()    {}
+func (*MsgPartialClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{10}
+}
+func (m *MsgPartialClose) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialClose.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialClose.Merge(m, src)
+}
+func (m *MsgPartialClose) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialClose proto.InternalMessageInfo
+
+func (m *MsgPartialClose) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type MsgPartialCloseResponse struct {
+	// The position after the partial close, with a zero size if it was closed
+	// entirely.
+	Position *Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// The amount of quote assets exchanged.
+	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
+	// The amount of base assets exchanged.
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// The funding payment applied on this position change, measured in quote
+	// units.
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// The amount of PnL realized on this position changed, measured in quote
+	// units.
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// The unrealized PnL in the position after the position change, measured in
+	// quote units.
+	UnrealizedPnlAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=unrealized_pnl_after,json=unrealizedPnlAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl_after"`
+	// The amount of margin the trader receives from the vault. Only positive
+	// when the position is closed entirely, a partial close keeps the realized
+	// PnL in the margin of the position.
+	MarginToTrader github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=margin_to_trader,json=marginToTrader,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_to_trader"`
+	// The position's notional value after the position change, measured in quote
+	// units.
+	PositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=position_notional,json=positionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_notional"`
+}
+
+func (m *MsgPartialCloseResponse) Reset()         { *m = MsgPartialCloseResponse{} }
+func (m *MsgPartialCloseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPartialCloseResponse) ProtoMessage()    {}
+func (*MsgPartialCloseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{11}
+}
+func (m *MsgPartialCloseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartialCloseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartialCloseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPartialCloseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartialCloseResponse.Merge(m, src)
+}
+func (m *MsgPartialCloseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartialCloseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartialCloseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPartialCloseResponse proto.InternalMessageInfo
+
+func (m *MsgPartialCloseResponse) GetPosition() *Position {
+	if m != nil {
+		return m.Position
+	}
+	return nil
+}
+
 type MsgDonateToEcosystemFund struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// donation to the EF
@@ -677,7 +808,7 @@ func (m *MsgDonateToEcosystemFund) Reset()         { *m = MsgDonateToEcosystemFu
 func (m *MsgDonateToEcosystemFund) String() string { return proto.CompactTextString(m) }
 func (*MsgDonateToEcosystemFund) ProtoMessage()    {}
 func (*MsgDonateToEcosystemFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0993e7ada6b2d291, []int{10}
+	return fileDescriptor_0993e7ada6b2d291, []int{12}
 }
 func (m *MsgDonateToEcosystemFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,7 +858,7 @@ func (m *MsgDonateToEcosystemFundResponse) Reset()         { *m = MsgDonateToEco
 func (m *MsgDonateToEcosystemFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDonateToEcosystemFundResponse) ProtoMessage()    {}
 func (*MsgDonateToEcosystemFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0993e7ada6b2d291, []int{11}
+	return fileDescriptor_0993e7ada6b2d291, []int{13}
 }
 func (m *MsgDonateToEcosystemFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,1364 +887,7967 @@ func (m *MsgDonateToEcosystemFundResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDonateToEcosystemFundResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v2.MsgRemoveMargin")
-	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v2.MsgRemoveMarginResponse")
-	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v2.MsgAddMargin")
-	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v2.MsgAddMarginResponse")
-	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v2.MsgMultiLiquidate")
-	proto.RegisterType((*MsgMultiLiquidate_Liquidation)(nil), "nibiru.perp.v2.MsgMultiLiquidate.Liquidation")
-	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse")
-	proto.RegisterType((*MsgMultiLiquidateResponse_LiquidationResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse.LiquidationResponse")
-	proto.RegisterType((*MsgOpenPosition)(nil), "nibiru.perp.v2.MsgOpenPosition")
-	proto.RegisterType((*MsgOpenPositionResponse)(nil), "nibiru.perp.v2.MsgOpenPositionResponse")
-	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v2.MsgClosePosition")
-	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v2.MsgClosePositionResponse")
-	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFund")
-	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFundResponse")
+// MsgSettlePosition: Msg to settle a position of a settled market at the
+// settlement price of the market.
+type MsgSettlePosition struct {
+	Sender string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
 }
 
-func init() { proto.RegisterFile("perp/v2/tx.proto", fileDescriptor_0993e7ada6b2d291) }
-
-var fileDescriptor_0993e7ada6b2d291 = []byte{
-	// 1205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xcf, 0xda, 0xae, 0x9b, 0x4e, 0x12, 0x27, 0x9d, 0xa6, 0xc9, 0xc6, 0xca, 0xdf, 0xf1, 0x7f,
-	0x05, 0x25, 0x20, 0x65, 0xb7, 0x31, 0x48, 0x08, 0x24, 0x40, 0x79, 0x69, 0x10, 0x28, 0x4e, 0x53,
-	0x13, 0x05, 0x04, 0x48, 0xcb, 0xc4, 0x3b, 0xde, 0xac, 0xd8, 0x9d, 0x71, 0x77, 0x66, 0xad, 0x24,
-	0x97, 0x4a, 0x45, 0xe2, 0x8c, 0xc4, 0x67, 0xe8, 0x15, 0x09, 0x71, 0xe1, 0x23, 0xf4, 0x04, 0x95,
-	0xb8, 0x20, 0x0e, 0x11, 0x4a, 0x7a, 0xe0, 0x5c, 0xf1, 0x01, 0xd0, 0xcc, 0xbe, 0xf8, 0xa5, 0x9b,
-	0xd8, 0x35, 0x69, 0x24, 0x4e, 0xf1, 0xcc, 0x3c, 0xf3, 0x7b, 0x9e, 0xdf, 0xf3, 0x36, 0xcf, 0x06,
-	0x4c, 0x35, 0xb1, 0xdf, 0x34, 0x5a, 0x15, 0x83, 0x1f, 0xe8, 0x4d, 0x9f, 0x72, 0x0a, 0x0b, 0xc4,
-	0xd9, 0x73, 0xfc, 0x40, 0x17, 0x07, 0x7a, 0xab, 0x52, 0x9c, 0xb7, 0x29, 0xb5, 0x5d, 0x6c, 0xa0,
-	0xa6, 0x63, 0x20, 0x42, 0x28, 0x47, 0xdc, 0xa1, 0x84, 0x85, 0xd2, 0xc5, 0x52, 0x9d, 0x32, 0x8f,
-	0x32, 0x63, 0x0f, 0x31, 0x6c, 0xb4, 0x96, 0xf7, 0x30, 0x47, 0xcb, 0x46, 0x9d, 0x3a, 0x24, 0x3a,
-	0x9f, 0xb6, 0xa9, 0x4d, 0xe5, 0x4f, 0x43, 0xfc, 0x8a, 0x76, 0x6f, 0xc4, 0x5a, 0x19, 0x47, 0x1c,
-	0x87, 0x9b, 0xda, 0x8f, 0x0a, 0x98, 0xac, 0x32, 0xbb, 0x86, 0x3d, 0xda, 0xc2, 0x55, 0xe4, 0xdb,
-	0x0e, 0x81, 0x33, 0x20, 0xcf, 0x30, 0xb1, 0xb0, 0xaf, 0x2a, 0x65, 0x65, 0xf1, 0x5a, 0x2d, 0x5a,
-	0xc1, 0x2a, 0xc8, 0x35, 0x91, 0xe3, 0xab, 0x19, 0xb1, 0xbb, 0xfa, 0xce, 0xe3, 0xe3, 0x85, 0x91,
-	0x3f, 0x8e, 0x17, 0x96, 0x6d, 0x87, 0xef, 0x07, 0x7b, 0x7a, 0x9d, 0x7a, 0xc6, 0x96, 0x64, 0xb1,
-	0xb6, 0x8f, 0x1c, 0x62, 0x84, 0x8c, 0x8c, 0x03, 0xa3, 0x4e, 0x3d, 0x8f, 0x12, 0x03, 0x31, 0x86,
-	0xb9, 0xbe, 0x8d, 0x1c, 0xbf, 0x26, 0x61, 0xe0, 0xdb, 0x20, 0xef, 0x49, 0x85, 0x6a, 0xb6, 0xac,
-	0x2c, 0x8e, 0x55, 0xe6, 0xf4, 0x90, 0x96, 0x2e, 0x68, 0xe9, 0x11, 0x2d, 0x7d, 0x8d, 0x3a, 0x64,
-	0x35, 0x27, 0x74, 0xd5, 0x22, 0x71, 0xed, 0x2f, 0x05, 0xcc, 0xf6, 0xd8, 0x5c, 0xc3, 0xac, 0x49,
-	0x09, 0xc3, 0xf0, 0x7d, 0x00, 0x42, 0x29, 0x93, 0x06, 0x5c, 0xda, 0x3f, 0x00, 0xf0, 0xb5, 0xf0,
-	0xca, 0xdd, 0x80, 0xc3, 0x4f, 0xc1, 0x64, 0x23, 0x20, 0x96, 0x43, 0x6c, 0xb3, 0x89, 0x0e, 0x3d,
-	0x4c, 0x78, 0x44, 0x57, 0x8f, 0xe8, 0xde, 0xea, 0xa0, 0x1b, 0x85, 0x21, 0xfc, 0xb3, 0xc4, 0xac,
-	0xaf, 0x0d, 0x7e, 0xd8, 0xc4, 0x4c, 0x5f, 0xc7, 0xf5, 0x5a, 0x21, 0x82, 0xd9, 0x0e, 0x51, 0xe0,
-	0x5b, 0x60, 0xb4, 0x49, 0x99, 0x23, 0xc2, 0x18, 0xf1, 0x55, 0xf5, 0xee, 0xa0, 0xeb, 0xdb, 0xd1,
-	0x79, 0x2d, 0x91, 0xd4, 0x7e, 0x50, 0xc0, 0x78, 0x95, 0xd9, 0x2b, 0x96, 0xf5, 0x1f, 0x89, 0xcd,
-	0x23, 0x05, 0x4c, 0x77, 0x1a, 0x9c, 0x04, 0x26, 0xc5, 0xb1, 0xca, 0x85, 0x3b, 0x36, 0x33, 0xb0,
-	0x63, 0xff, 0x56, 0xc0, 0xf5, 0x2a, 0xb3, 0xab, 0x81, 0xcb, 0x9d, 0x4d, 0xe7, 0x7e, 0xe0, 0x58,
-	0x88, 0xe3, 0x33, 0xbd, 0x7b, 0x0f, 0x8c, 0xbb, 0x91, 0x90, 0x28, 0x43, 0x35, 0x53, 0xce, 0x2e,
-	0x8e, 0x55, 0x96, 0x7a, 0xf5, 0x3c, 0x07, 0xa8, 0x6f, 0xb6, 0x6f, 0xd5, 0xba, 0x20, 0x8a, 0x1c,
-	0x8c, 0x75, 0x1c, 0x26, 0xf1, 0x53, 0x2e, 0x26, 0x7e, 0x33, 0x20, 0xcf, 0x7d, 0x24, 0x88, 0x64,
-	0x42, 0x22, 0xe1, 0x4a, 0xfb, 0x35, 0x03, 0xe6, 0x9e, 0xb3, 0x32, 0x89, 0x11, 0xea, 0xa1, 0xa9,
-	0x48, 0x9a, 0xef, 0xf5, 0xa5, 0x19, 0x03, 0x74, 0xd1, 0x8d, 0xf6, 0x7a, 0x68, 0xff, 0xa2, 0x80,
-	0x1b, 0x29, 0x52, 0x50, 0x05, 0x57, 0x59, 0x50, 0xaf, 0x63, 0xc6, 0xa4, 0x0b, 0x46, 0x6b, 0xf1,
-	0x12, 0x4e, 0x83, 0x2b, 0xd8, 0xf7, 0x69, 0xcc, 0x24, 0x5c, 0xc0, 0x0d, 0x50, 0x88, 0x71, 0xa9,
-	0x6f, 0x36, 0x30, 0x1e, 0x34, 0x51, 0x27, 0xda, 0xd7, 0x36, 0x30, 0x86, 0x1f, 0x80, 0x31, 0x41,
-	0xcb, 0xc4, 0x0d, 0x09, 0x92, 0x1b, 0xb0, 0x61, 0x88, 0x3b, 0x77, 0x1a, 0x1b, 0x18, 0x6b, 0x3f,
-	0x67, 0x65, 0x03, 0xbd, 0xdb, 0xc4, 0x24, 0x4e, 0xb3, 0xcb, 0x2a, 0xd2, 0x25, 0x90, 0x63, 0x8e,
-	0x15, 0x32, 0x2f, 0x54, 0xe6, 0x7a, 0xc3, 0xb4, 0xee, 0xf8, 0xb8, 0x2e, 0x9d, 0x2c, 0xc5, 0xe0,
-	0x97, 0x00, 0xde, 0x0f, 0x28, 0xc7, 0xa6, 0x04, 0x32, 0x91, 0x47, 0x03, 0xc2, 0x25, 0xe3, 0x17,
-	0x2b, 0xc2, 0x8f, 0x08, 0xaf, 0x4d, 0x49, 0xa4, 0x15, 0x01, 0xb4, 0x22, 0x71, 0xe0, 0xc7, 0x60,
-	0xd4, 0xc5, 0x2d, 0xec, 0x23, 0x1b, 0xab, 0x57, 0x86, 0x2a, 0xec, 0xe4, 0x3e, 0xc4, 0x60, 0x56,
-	0x78, 0xbe, 0xcb, 0x50, 0xd3, 0x75, 0x3c, 0x87, 0xab, 0xf9, 0xa1, 0xcc, 0x9d, 0x16, 0x70, 0x1d,
-	0xd6, 0x6e, 0x0a, 0x2c, 0xed, 0xe9, 0x15, 0xf9, 0x8e, 0x74, 0x86, 0x2e, 0xc9, 0xc7, 0xce, 0xae,
-	0xa2, 0x0c, 0xda, 0x55, 0xe0, 0x3e, 0x50, 0xf1, 0x41, 0x7d, 0x1f, 0x11, 0x1b, 0x5b, 0x26, 0xa1,
-	0x62, 0x0f, 0xb9, 0x66, 0x0b, 0xb9, 0x01, 0x1e, 0xf2, 0x19, 0x99, 0x49, 0xf0, 0xb6, 0x22, 0xb8,
-	0x5d, 0x81, 0x06, 0x1b, 0x60, 0xb6, 0xad, 0x29, 0xd6, 0x6f, 0x32, 0xe7, 0x28, 0x4c, 0x87, 0x17,
-	0x57, 0x74, 0x33, 0x81, 0x8b, 0x79, 0x7d, 0xe2, 0x1c, 0xa5, 0xb6, 0xed, 0xdc, 0x85, 0xb4, 0xed,
-	0x7b, 0x60, 0xdc, 0xc7, 0xc8, 0x75, 0x8e, 0x84, 0xfd, 0xc4, 0x1d, 0x32, 0x67, 0xc6, 0x62, 0x8c,
-	0x6d, 0xe2, 0xc2, 0xaf, 0xc0, 0x74, 0x40, 0x3a, 0x41, 0x4d, 0xd4, 0xe0, 0xd8, 0x1f, 0x22, 0x67,
-	0x04, 0x34, 0x6c, 0x63, 0x6d, 0x13, 0x77, 0x45, 0x20, 0xc1, 0x5d, 0x30, 0x19, 0x4d, 0x17, 0x9c,
-	0x9a, 0x2d, 0x14, 0xb8, 0x5c, 0xbd, 0x3a, 0x14, 0xf8, 0x44, 0x08, 0xb3, 0x43, 0x77, 0x05, 0x08,
-	0xfc, 0x02, 0x5c, 0x4f, 0x62, 0x18, 0xa7, 0x8d, 0x3a, 0x3a, 0x14, 0xf2, 0x54, 0x0c, 0x14, 0xe7,
-	0x8b, 0x76, 0x08, 0xa6, 0xaa, 0xcc, 0x5e, 0x73, 0x29, 0xc3, 0x97, 0xdc, 0xa1, 0xb4, 0x67, 0x59,
-	0xa0, 0xf6, 0xea, 0x4e, 0x4a, 0xec, 0xbc, 0x62, 0x51, 0x2e, 0xab, 0x58, 0x32, 0x2f, 0xb9, 0x58,
-	0xb2, 0x2f, 0xa5, 0x58, 0x72, 0xff, 0xbe, 0x58, 0x3e, 0x03, 0x53, 0xed, 0x54, 0x8e, 0x66, 0x85,
-	0xe1, 0x72, 0xb9, 0x10, 0xe7, 0xf2, 0x4e, 0x38, 0x63, 0x3c, 0x54, 0x64, 0xd0, 0xd7, 0x29, 0x41,
-	0x1c, 0xef, 0xd0, 0x3b, 0x75, 0xca, 0x0e, 0x19, 0xc7, 0xde, 0x46, 0x40, 0xac, 0x33, 0x13, 0x6f,
-	0x0b, 0x8c, 0x5a, 0xe2, 0x42, 0x7b, 0x8a, 0x3b, 0xe7, 0x11, 0x9e, 0x15, 0x16, 0x3e, 0x3b, 0x5e,
-	0x98, 0x3c, 0x44, 0x9e, 0xfb, 0xae, 0x16, 0x5f, 0xd4, 0x6a, 0x09, 0x86, 0xa6, 0x81, 0xf2, 0x59,
-	0x36, 0xc4, 0x09, 0x58, 0xf9, 0x29, 0x0f, 0xb2, 0x55, 0x66, 0xc3, 0x07, 0x60, 0xbc, 0xeb, 0xfb,
-	0x67, 0x21, 0x65, 0xe0, 0xe9, 0x14, 0x28, 0xbe, 0xd6, 0x47, 0x20, 0xd6, 0xa0, 0xbd, 0xfa, 0xf0,
-	0xb7, 0xa7, 0xdf, 0x67, 0x16, 0xb4, 0xff, 0xc5, 0xa5, 0x12, 0x7f, 0x82, 0xf9, 0x52, 0xda, 0x0c,
-	0x3d, 0x07, 0x19, 0xb8, 0xd6, 0x9e, 0xf0, 0xe7, 0x53, 0xc0, 0x93, 0xd3, 0xe2, 0x2b, 0xe7, 0x9d,
-	0x26, 0x7a, 0x35, 0xa9, 0x77, 0x5e, 0x2b, 0xf6, 0xea, 0x45, 0x96, 0x15, 0x2b, 0xfd, 0x56, 0x01,
-	0x85, 0x9e, 0xf1, 0xf7, 0xff, 0x7d, 0x27, 0xbd, 0xe2, 0xeb, 0x03, 0x0f, 0x83, 0xda, 0x2d, 0x69,
-	0x44, 0x59, 0x2b, 0xf5, 0x1a, 0xe1, 0x09, 0x79, 0x37, 0xd1, 0xfa, 0x00, 0x8c, 0x77, 0x4d, 0x4f,
-	0x69, 0xee, 0xef, 0x14, 0x48, 0x75, 0x7f, 0xda, 0x23, 0x7e, 0xb6, 0xfb, 0x69, 0x13, 0x93, 0xa4,
-	0x11, 0xc0, 0x6f, 0x14, 0x30, 0xd1, 0xdd, 0x1e, 0xcb, 0x29, 0x1a, 0xba, 0x24, 0x8a, 0x8b, 0xfd,
-	0x24, 0xfa, 0xbb, 0xa1, 0x2e, 0xc4, 0xdb, 0x56, 0x3c, 0x52, 0xc0, 0xcd, 0xf4, 0x9a, 0x49, 0xd3,
-	0x95, 0x2a, 0x59, 0xbc, 0x3d, 0xa8, 0x64, 0x62, 0xdd, 0x6d, 0x69, 0xdd, 0x1b, 0xda, 0x62, 0xaf,
-	0x75, 0xb2, 0x92, 0xb0, 0x68, 0x0e, 0x38, 0xbe, 0x68, 0x8a, 0xae, 0xb4, 0xfa, 0xe1, 0xe3, 0x93,
-	0x92, 0xf2, 0xe4, 0xa4, 0xa4, 0xfc, 0x79, 0x52, 0x52, 0xbe, 0x3b, 0x2d, 0x8d, 0x3c, 0x39, 0x2d,
-	0x8d, 0xfc, 0x7e, 0x5a, 0x1a, 0xf9, 0x7c, 0xa9, 0xdf, 0x33, 0x21, 0xb1, 0x65, 0xe3, 0x30, 0x5a,
-	0x95, 0xbd, 0xbc, 0xfc, 0x0f, 0xc4, 0x9b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x42, 0x20, 0x0f,
-	0xd4, 0x0e, 0x11, 0x00, 0x00,
+func (m *MsgSettlePosition) Reset()         { *m = MsgSettlePosition{} }
+func (m *MsgSettlePosition) String() string { return proto.CompactTextString(m) }
+func (*MsgSettlePosition) ProtoMessage()    {}
+func (*MsgSettlePosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{14}
+}
+func (m *MsgSettlePosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettlePosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettlePosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettlePosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettlePosition.Merge(m, src)
+}
+func (m *MsgSettlePosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettlePosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettlePosition.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSettlePosition proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
-	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
-	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
-	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
-	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
+func (m *MsgSettlePosition) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgSettlePositionResponse struct {
+	// tokens transferred back to the trader
+	SettledCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=settled_coins,json=settledCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"settled_coins"`
+	// the amount of bad debt realized by settling the position
+	BadDebt github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=bad_debt,json=badDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bad_debt"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSettlePositionResponse) Reset()         { *m = MsgSettlePositionResponse{} }
+func (m *MsgSettlePositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettlePositionResponse) ProtoMessage()    {}
+func (*MsgSettlePositionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{15}
 }
-
-func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
-	out := new(MsgRemoveMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSettlePositionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettlePositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettlePositionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSettlePositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettlePositionResponse.Merge(m, src)
+}
+func (m *MsgSettlePositionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettlePositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettlePositionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
-	out := new(MsgAddMarginResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddMargin", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSettlePositionResponse proto.InternalMessageInfo
+
+func (m *MsgSettlePositionResponse) GetSettledCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SettledCoins
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
-	out := new(MsgMultiLiquidateResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MultiLiquidate", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgPlaceOrder: Msg to place a trigger order. The margin of LIMIT and
+// STOP_MARKET orders is escrowed in the vault until the order is executed or
+// cancelled.
+type MsgPlaceOrder struct {
+	Sender               string                                            `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pair                 github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	OrderType            OrderType                                         `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v2.OrderType" json:"order_type,omitempty"`
+	Side                 Direction                                         `protobuf:"varint,4,opt,name=side,proto3,enum=nibiru.perp.v2.Direction" json:"side,omitempty"`
+	TriggerPrice         github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,5,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	PriceSource          TriggerPriceSource                                `protobuf:"varint,6,opt,name=price_source,json=priceSource,proto3,enum=nibiru.perp.v2.TriggerPriceSource" json:"price_source,omitempty"`
+	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int            `protobuf:"bytes,7,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	Leverage             github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,8,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int            `protobuf:"bytes,9,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+}
+
+func (m *MsgPlaceOrder) Reset()         { *m = MsgPlaceOrder{} }
+func (m *MsgPlaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrder) ProtoMessage()    {}
+func (*MsgPlaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{16}
+}
+func (m *MsgPlaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPlaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrder.Merge(m, src)
+}
+func (m *MsgPlaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrder.DiscardUnknown(m)
 }
 
-func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
-	out := new(MsgOpenPositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/OpenPosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPlaceOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
-	out := new(MsgClosePositionResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClosePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceOrder) GetOrderType() OrderType {
+	if m != nil {
+		return m.OrderType
 	}
-	return out, nil
+	return OrderType_ORDER_TYPE_UNSPECIFIED
 }
 
-func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
-	out := new(MsgDonateToEcosystemFundResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DonateToEcosystemFund", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceOrder) GetSide() Direction {
+	if m != nil {
+		return m.Side
 	}
-	return out, nil
+	return Direction_DIRECTION_UNSPECIFIED
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
-	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
-	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
-	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
-	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
-	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
+func (m *MsgPlaceOrder) GetPriceSource() TriggerPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return TriggerPriceSource_MARK_PRICE
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgPlaceOrderResponse struct {
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+func (m *MsgPlaceOrderResponse) Reset()         { *m = MsgPlaceOrderResponse{} }
+func (m *MsgPlaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{17}
 }
-func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+func (m *MsgPlaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+func (m *MsgPlaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) OpenPosition(ctx context.Context, req *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+func (m *MsgPlaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceOrderResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+func (m *MsgPlaceOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+func (m *MsgPlaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceOrderResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgPlaceOrderResponse proto.InternalMessageInfo
 
-func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveMargin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/RemoveMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
+func (m *MsgPlaceOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddMargin)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddMargin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/AddMargin",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelOrder: Msg to cancel a trigger order and get its escrowed margin
+// back.
+type MsgCancelOrder struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiLiquidate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiLiquidate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/MultiLiquidate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+func (m *MsgCancelOrder) Reset()         { *m = MsgCancelOrder{} }
+func (m *MsgCancelOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrder) ProtoMessage()    {}
+func (*MsgCancelOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{18}
+}
+func (m *MsgCancelOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrder.Merge(m, src)
+}
+func (m *MsgCancelOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrder.DiscardUnknown(m)
 }
 
-func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgOpenPosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).OpenPosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/OpenPosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
+var xxx_messageInfo_MsgCancelOrder proto.InternalMessageInfo
+
+func (m *MsgCancelOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClosePosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClosePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/ClosePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+func (m *MsgCancelOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDonateToEcosystemFund)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.perp.v2.Msg/DonateToEcosystemFund",
+type MsgCancelOrderResponse struct {
+}
+
+func (m *MsgCancelOrderResponse) Reset()         { *m = MsgCancelOrderResponse{} }
+func (m *MsgCancelOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOrderResponse) ProtoMessage()    {}
+func (*MsgCancelOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{19}
+}
+func (m *MsgCancelOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
+}
+func (m *MsgCancelOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
+
+// MsgReplaceOrder: Msg to change the trigger price and the size of a trigger
+// order. The difference in margin is escrowed or refunded.
+type MsgReplaceOrder struct {
+	Sender               string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	OrderId              uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	TriggerPrice         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	QuoteAssetAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=quote_asset_amount,json=quoteAssetAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"quote_asset_amount"`
+	Leverage             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_asset_amount_limit"`
+}
+
+func (m *MsgReplaceOrder) Reset()         { *m = MsgReplaceOrder{} }
+func (m *MsgReplaceOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrder) ProtoMessage()    {}
+func (*MsgReplaceOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{20}
+}
+func (m *MsgReplaceOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReplaceOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrder.Merge(m, src)
+}
+func (m *MsgReplaceOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrder.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.perp.v2.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RemoveMargin",
-			Handler:    _Msg_RemoveMargin_Handler,
-		},
-		{
-			MethodName: "AddMargin",
-			Handler:    _Msg_AddMargin_Handler,
-		},
-		{
-			MethodName: "MultiLiquidate",
-			Handler:    _Msg_MultiLiquidate_Handler,
-		},
-		{
-			MethodName: "OpenPosition",
-			Handler:    _Msg_OpenPosition_Handler,
-		},
-		{
-			MethodName: "ClosePosition",
-			Handler:    _Msg_ClosePosition_Handler,
-		},
-		{
-			MethodName: "DonateToEcosystemFund",
-			Handler:    _Msg_DonateToEcosystemFund_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "perp/v2/tx.proto",
+var xxx_messageInfo_MsgReplaceOrder proto.InternalMessageInfo
+
+func (m *MsgReplaceOrder) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReplaceOrder) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgReplaceOrderResponse struct {
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
 }
 
-func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReplaceOrderResponse) Reset()         { *m = MsgReplaceOrderResponse{} }
+func (m *MsgReplaceOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReplaceOrderResponse) ProtoMessage()    {}
+func (*MsgReplaceOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{21}
+}
+func (m *MsgReplaceOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReplaceOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReplaceOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgReplaceOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReplaceOrderResponse.Merge(m, src)
+}
+func (m *MsgReplaceOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReplaceOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReplaceOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReplaceOrderResponse proto.InternalMessageInfo
+
+func (m *MsgReplaceOrderResponse) GetOrder() Order {
+	if m != nil {
+		return m.Order
 	}
-	return dAtA[:n], nil
+	return Order{}
 }
 
-func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgEnableCrossMargin: Msg to open a cross-margin account. From then on, the
+// positions of the trader are margined and liquidated together.
+type MsgEnableCrossMargin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgEnableCrossMargin) Reset()         { *m = MsgEnableCrossMargin{} }
+func (m *MsgEnableCrossMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableCrossMargin) ProtoMessage()    {}
+func (*MsgEnableCrossMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{22}
+}
+func (m *MsgEnableCrossMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCrossMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCrossMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgEnableCrossMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCrossMargin.Merge(m, src)
+}
+func (m *MsgEnableCrossMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCrossMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCrossMargin.DiscardUnknown(m)
 }
 
-func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEnableCrossMargin proto.InternalMessageInfo
+
+func (m *MsgEnableCrossMargin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgEnableCrossMarginResponse struct {
 }
 
-func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgEnableCrossMarginResponse) Reset()         { *m = MsgEnableCrossMarginResponse{} }
+func (m *MsgEnableCrossMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableCrossMarginResponse) ProtoMessage()    {}
+func (*MsgEnableCrossMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{23}
+}
+func (m *MsgEnableCrossMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableCrossMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableCrossMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgEnableCrossMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableCrossMarginResponse.Merge(m, src)
+}
+func (m *MsgEnableCrossMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableCrossMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableCrossMarginResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableCrossMarginResponse proto.InternalMessageInfo
+
+// MsgDisableCrossMargin: Msg to close the cross-margin account of the trader
+// and get its collateral back. Every position of the trader must be healthy on
+// its own.
+type MsgDisableCrossMargin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgDisableCrossMargin) Reset()         { *m = MsgDisableCrossMargin{} }
+func (m *MsgDisableCrossMargin) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableCrossMargin) ProtoMessage()    {}
+func (*MsgDisableCrossMargin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{24}
+}
+func (m *MsgDisableCrossMargin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableCrossMargin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableCrossMargin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDisableCrossMargin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableCrossMargin.Merge(m, src)
+}
+func (m *MsgDisableCrossMargin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableCrossMargin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableCrossMargin.DiscardUnknown(m)
 }
 
-func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisableCrossMargin proto.InternalMessageInfo
+
+func (m *MsgDisableCrossMargin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDisableCrossMarginResponse struct {
+	// collateral transferred back to the trader
+	RefundedCollateral github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded_collateral,json=refundedCollateral,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_collateral"`
 }
 
-func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgDisableCrossMarginResponse) Reset()         { *m = MsgDisableCrossMarginResponse{} }
+func (m *MsgDisableCrossMarginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableCrossMarginResponse) ProtoMessage()    {}
+func (*MsgDisableCrossMarginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{25}
+}
+func (m *MsgDisableCrossMarginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableCrossMarginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableCrossMarginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgDisableCrossMarginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableCrossMarginResponse.Merge(m, src)
+}
+func (m *MsgDisableCrossMarginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableCrossMarginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableCrossMarginResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDisableCrossMarginResponse proto.InternalMessageInfo
+
+func (m *MsgDisableCrossMarginResponse) GetRefundedCollateral() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCollateral
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddCrossMarginCollateral: Msg to deposit collateral into the
+// cross-margin account of the trader.
+type MsgAddCrossMarginCollateral struct {
+	Sender     string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgAddCrossMarginCollateral) Reset()         { *m = MsgAddCrossMarginCollateral{} }
+func (m *MsgAddCrossMarginCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCrossMarginCollateral) ProtoMessage()    {}
+func (*MsgAddCrossMarginCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{26}
+}
+func (m *MsgAddCrossMarginCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCrossMarginCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCrossMarginCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgAddCrossMarginCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCrossMarginCollateral.Merge(m, src)
+}
+func (m *MsgAddCrossMarginCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCrossMarginCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCrossMarginCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCrossMarginCollateral proto.InternalMessageInfo
+
+func (m *MsgAddCrossMarginCollateral) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgMultiLiquidate_Liquidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddCrossMarginCollateral) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgMultiLiquidate_Liquidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAddCrossMarginCollateralResponse struct {
+	Account CrossMarginAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
 }
 
-func (m *MsgMultiLiquidate_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgAddCrossMarginCollateralResponse) Reset()         { *m = MsgAddCrossMarginCollateralResponse{} }
+func (m *MsgAddCrossMarginCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCrossMarginCollateralResponse) ProtoMessage()    {}
+func (*MsgAddCrossMarginCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{27}
+}
+func (m *MsgAddCrossMarginCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCrossMarginCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCrossMarginCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgAddCrossMarginCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCrossMarginCollateralResponse.Merge(m, src)
+}
+func (m *MsgAddCrossMarginCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCrossMarginCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCrossMarginCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAddCrossMarginCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgAddCrossMarginCollateralResponse) GetAccount() CrossMarginAccount {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return CrossMarginAccount{}
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveCrossMarginCollateral: Msg to withdraw collateral from the
+// cross-margin account of the trader. Fails if the account would fall below its
+// maintenance margin.
+type MsgRemoveCrossMarginCollateral struct {
+	Sender     string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Collateral types.Coin `protobuf:"bytes,2,opt,name=collateral,proto3" json:"collateral"`
 }
 
-func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MsgRemoveCrossMarginCollateral) Reset()         { *m = MsgRemoveCrossMarginCollateral{} }
+func (m *MsgRemoveCrossMarginCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCrossMarginCollateral) ProtoMessage()    {}
+func (*MsgRemoveCrossMarginCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{28}
+}
+func (m *MsgRemoveCrossMarginCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCrossMarginCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCrossMarginCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveCrossMarginCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCrossMarginCollateral.Merge(m, src)
+}
+func (m *MsgRemoveCrossMarginCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCrossMarginCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCrossMarginCollateral.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveCrossMarginCollateral proto.InternalMessageInfo
+
+func (m *MsgRemoveCrossMarginCollateral) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveCrossMarginCollateral) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PerpEfFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.LiquidatorFee.MarshalToSizedBuffer(dAtA[:i])
+type MsgRemoveCrossMarginCollateralResponse struct {
+	Account CrossMarginAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *MsgRemoveCrossMarginCollateralResponse) Reset() {
+	*m = MsgRemoveCrossMarginCollateralResponse{}
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCrossMarginCollateralResponse) ProtoMessage()    {}
+func (*MsgRemoveCrossMarginCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{29}
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCrossMarginCollateralResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCrossMarginCollateralResponse.Merge(m, src)
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveCrossMarginCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCrossMarginCollateralResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveCrossMarginCollateralResponse proto.InternalMessageInfo
+
+func (m *MsgRemoveCrossMarginCollateralResponse) GetAccount() CrossMarginAccount {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return CrossMarginAccount{}
 }
 
-func (m *MsgOpenPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDepositInsuranceFund: Msg to stake quote tokens in the insurance fund in
+// exchange for shares of the fund.
+type MsgDepositInsuranceFund struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgOpenPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.BaseAssetAmountLimit.Size()
-		i -= size
-		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.Leverage.Size()
-		i -= size
-		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgDepositInsuranceFund) Reset()         { *m = MsgDepositInsuranceFund{} }
+func (m *MsgDepositInsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositInsuranceFund) ProtoMessage()    {}
+func (*MsgDepositInsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{30}
+}
+func (m *MsgDepositInsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositInsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositInsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.QuoteAssetAmount.Size()
-		i -= size
-		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgDepositInsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositInsuranceFund.Merge(m, src)
+}
+func (m *MsgDepositInsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositInsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositInsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositInsuranceFund proto.InternalMessageInfo
+
+func (m *MsgDepositInsuranceFund) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Side != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *MsgDepositInsuranceFund) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return types.Coin{}
+}
+
+type MsgDepositInsuranceFundResponse struct {
+	// the insurance fund shares minted to the sender
+	Shares types.Coin `protobuf:"bytes,1,opt,name=shares,proto3" json:"shares"`
+}
+
+func (m *MsgDepositInsuranceFundResponse) Reset()         { *m = MsgDepositInsuranceFundResponse{} }
+func (m *MsgDepositInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositInsuranceFundResponse) ProtoMessage()    {}
+func (*MsgDepositInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{31}
+}
+func (m *MsgDepositInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDepositInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositInsuranceFundResponse.Merge(m, src)
+}
+func (m *MsgDepositInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositInsuranceFundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgOpenPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDepositInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *MsgDepositInsuranceFundResponse) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawInsuranceFund: Msg to request the withdrawal of insurance fund
+// shares. The shares are redeemed for quote tokens once the withdrawal cooldown
+// is over.
+type MsgWithdrawInsuranceFund struct {
+	Sender string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Shares types.Coin `protobuf:"bytes,2,opt,name=shares,proto3" json:"shares"`
 }
 
-func (m *MsgOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MarginToVault.Size()
-		i -= size
-		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawInsuranceFund) Reset()         { *m = MsgWithdrawInsuranceFund{} }
+func (m *MsgWithdrawInsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawInsuranceFund) ProtoMessage()    {}
+func (*MsgWithdrawInsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{32}
+}
+func (m *MsgWithdrawInsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawInsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawInsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Position != nil {
-		{
-			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawInsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawInsuranceFund.Merge(m, src)
+}
+func (m *MsgWithdrawInsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawInsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawInsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawInsuranceFund proto.InternalMessageInfo
+
+func (m *MsgWithdrawInsuranceFund) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawInsuranceFund) GetShares() types.Coin {
+	if m != nil {
+		return m.Shares
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawInsuranceFundResponse struct {
+	UnlockTime time.Time `protobuf:"bytes,1,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time"`
 }
 
-func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawInsuranceFundResponse) Reset()         { *m = MsgWithdrawInsuranceFundResponse{} }
+func (m *MsgWithdrawInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawInsuranceFundResponse) ProtoMessage()    {}
+func (*MsgWithdrawInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{33}
+}
+func (m *MsgWithdrawInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawInsuranceFundResponse.Merge(m, src)
+}
+func (m *MsgWithdrawInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawInsuranceFundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawInsuranceFundResponse) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRegisterReferralCode: Msg to register a referral code owned by the
+// sender.
+type MsgRegisterReferralCode struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MarginToTrader.Size()
-		i -= size
-		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgRegisterReferralCode) Reset()         { *m = MsgRegisterReferralCode{} }
+func (m *MsgRegisterReferralCode) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReferralCode) ProtoMessage()    {}
+func (*MsgRegisterReferralCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{34}
+}
+func (m *MsgRegisterReferralCode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReferralCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReferralCode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.ExchangedNotionalValue.Size()
-		i -= size
-		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgRegisterReferralCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReferralCode.Merge(m, src)
+}
+func (m *MsgRegisterReferralCode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReferralCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReferralCode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterReferralCode proto.InternalMessageInfo
+
+func (m *MsgRegisterReferralCode) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterReferralCode) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterReferralCodeResponse struct {
 }
 
-func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterReferralCodeResponse) Reset()         { *m = MsgRegisterReferralCodeResponse{} }
+func (m *MsgRegisterReferralCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterReferralCodeResponse) ProtoMessage()    {}
+func (*MsgRegisterReferralCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{35}
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterReferralCodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRegisterReferralCodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterReferralCodeResponse.Merge(m, src)
 }
-
-func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRegisterReferralCodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterReferralCodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterReferralCodeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRegisterReferralCodeResponse proto.InternalMessageInfo
+
+// MsgSetReferrer: Msg to bind the sender to a referral code. A trader can only
+// be bound once.
+type MsgSetReferrer struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetReferrer) Reset()         { *m = MsgSetReferrer{} }
+func (m *MsgSetReferrer) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrer) ProtoMessage()    {}
+func (*MsgSetReferrer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{36}
 }
-func (m *MsgRemoveMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetReferrer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSetReferrer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrer.Merge(m, src)
+}
+func (m *MsgSetReferrer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrer.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.MarginOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgSetReferrer proto.InternalMessageInfo
+
+func (m *MsgSetReferrer) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return n
+	return ""
 }
 
-func (m *MsgAddMargin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetReferrer) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgAddMarginResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type MsgSetReferrerResponse struct {
 }
 
-func (m *MsgMultiLiquidate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Liquidations) > 0 {
-		for _, e := range m.Liquidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetReferrerResponse) Reset()         { *m = MsgSetReferrerResponse{} }
+func (m *MsgSetReferrerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReferrerResponse) ProtoMessage()    {}
+func (*MsgSetReferrerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{37}
+}
+func (m *MsgSetReferrerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReferrerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReferrerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetReferrerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReferrerResponse.Merge(m, src)
+}
+func (m *MsgSetReferrerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReferrerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReferrerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidate_Liquidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+var xxx_messageInfo_MsgSetReferrerResponse proto.InternalMessageInfo
+
+// MsgClaimReferralEarnings: Msg to pay out the referral earnings of the
+// sender.
+type MsgClaimReferralEarnings struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (m *MsgMultiLiquidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidations) > 0 {
-		for _, e := range m.Liquidations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgClaimReferralEarnings) Reset()         { *m = MsgClaimReferralEarnings{} }
+func (m *MsgClaimReferralEarnings) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReferralEarnings) ProtoMessage()    {}
+func (*MsgClaimReferralEarnings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{38}
+}
+func (m *MsgClaimReferralEarnings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReferralEarnings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReferralEarnings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgClaimReferralEarnings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReferralEarnings.Merge(m, src)
+}
+func (m *MsgClaimReferralEarnings) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReferralEarnings) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReferralEarnings.DiscardUnknown(m)
 }
 
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Success {
-		n += 2
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgClaimReferralEarnings proto.InternalMessageInfo
+
+func (m *MsgClaimReferralEarnings) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = m.LiquidatorFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PerpEfFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgOpenPosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovTx(uint64(m.Side))
-	}
-	l = m.QuoteAssetAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Leverage.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.BaseAssetAmountLimit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+type MsgClaimReferralEarningsResponse struct {
+	Earnings github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=earnings,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earnings"`
 }
 
-func (m *MsgOpenPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Position != nil {
-		l = m.Position.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToVault.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgClaimReferralEarningsResponse) Reset()         { *m = MsgClaimReferralEarningsResponse{} }
+func (m *MsgClaimReferralEarningsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReferralEarningsResponse) ProtoMessage()    {}
+func (*MsgClaimReferralEarningsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0993e7ada6b2d291, []int{39}
+}
+func (m *MsgClaimReferralEarningsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimReferralEarningsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimReferralEarningsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimReferralEarningsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReferralEarningsResponse.Merge(m, src)
+}
+func (m *MsgClaimReferralEarningsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimReferralEarningsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReferralEarningsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClosePosition) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgClaimReferralEarningsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimReferralEarningsResponse) GetEarnings() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earnings
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgRemoveMargin)(nil), "nibiru.perp.v2.MsgRemoveMargin")
+	proto.RegisterType((*MsgRemoveMarginResponse)(nil), "nibiru.perp.v2.MsgRemoveMarginResponse")
+	proto.RegisterType((*MsgAddMargin)(nil), "nibiru.perp.v2.MsgAddMargin")
+	proto.RegisterType((*MsgAddMarginResponse)(nil), "nibiru.perp.v2.MsgAddMarginResponse")
+	proto.RegisterType((*MsgMultiLiquidate)(nil), "nibiru.perp.v2.MsgMultiLiquidate")
+	proto.RegisterType((*MsgMultiLiquidate_Liquidation)(nil), "nibiru.perp.v2.MsgMultiLiquidate.Liquidation")
+	proto.RegisterType((*MsgMultiLiquidateResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse")
+	proto.RegisterType((*MsgMultiLiquidateResponse_LiquidationResponse)(nil), "nibiru.perp.v2.MsgMultiLiquidateResponse.LiquidationResponse")
+	proto.RegisterType((*MsgOpenPosition)(nil), "nibiru.perp.v2.MsgOpenPosition")
+	proto.RegisterType((*MsgOpenPositionResponse)(nil), "nibiru.perp.v2.MsgOpenPositionResponse")
+	proto.RegisterType((*MsgClosePosition)(nil), "nibiru.perp.v2.MsgClosePosition")
+	proto.RegisterType((*MsgClosePositionResponse)(nil), "nibiru.perp.v2.MsgClosePositionResponse")
+	proto.RegisterType((*MsgPartialClose)(nil), "nibiru.perp.v2.MsgPartialClose")
+	proto.RegisterType((*MsgPartialCloseResponse)(nil), "nibiru.perp.v2.MsgPartialCloseResponse")
+	proto.RegisterType((*MsgDonateToEcosystemFund)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFund")
+	proto.RegisterType((*MsgDonateToEcosystemFundResponse)(nil), "nibiru.perp.v2.MsgDonateToEcosystemFundResponse")
+	proto.RegisterType((*MsgSettlePosition)(nil), "nibiru.perp.v2.MsgSettlePosition")
+	proto.RegisterType((*MsgSettlePositionResponse)(nil), "nibiru.perp.v2.MsgSettlePositionResponse")
+	proto.RegisterType((*MsgPlaceOrder)(nil), "nibiru.perp.v2.MsgPlaceOrder")
+	proto.RegisterType((*MsgPlaceOrderResponse)(nil), "nibiru.perp.v2.MsgPlaceOrderResponse")
+	proto.RegisterType((*MsgCancelOrder)(nil), "nibiru.perp.v2.MsgCancelOrder")
+	proto.RegisterType((*MsgCancelOrderResponse)(nil), "nibiru.perp.v2.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgReplaceOrder)(nil), "nibiru.perp.v2.MsgReplaceOrder")
+	proto.RegisterType((*MsgReplaceOrderResponse)(nil), "nibiru.perp.v2.MsgReplaceOrderResponse")
+	proto.RegisterType((*MsgEnableCrossMargin)(nil), "nibiru.perp.v2.MsgEnableCrossMargin")
+	proto.RegisterType((*MsgEnableCrossMarginResponse)(nil), "nibiru.perp.v2.MsgEnableCrossMarginResponse")
+	proto.RegisterType((*MsgDisableCrossMargin)(nil), "nibiru.perp.v2.MsgDisableCrossMargin")
+	proto.RegisterType((*MsgDisableCrossMarginResponse)(nil), "nibiru.perp.v2.MsgDisableCrossMarginResponse")
+	proto.RegisterType((*MsgAddCrossMarginCollateral)(nil), "nibiru.perp.v2.MsgAddCrossMarginCollateral")
+	proto.RegisterType((*MsgAddCrossMarginCollateralResponse)(nil), "nibiru.perp.v2.MsgAddCrossMarginCollateralResponse")
+	proto.RegisterType((*MsgRemoveCrossMarginCollateral)(nil), "nibiru.perp.v2.MsgRemoveCrossMarginCollateral")
+	proto.RegisterType((*MsgRemoveCrossMarginCollateralResponse)(nil), "nibiru.perp.v2.MsgRemoveCrossMarginCollateralResponse")
+	proto.RegisterType((*MsgDepositInsuranceFund)(nil), "nibiru.perp.v2.MsgDepositInsuranceFund")
+	proto.RegisterType((*MsgDepositInsuranceFundResponse)(nil), "nibiru.perp.v2.MsgDepositInsuranceFundResponse")
+	proto.RegisterType((*MsgWithdrawInsuranceFund)(nil), "nibiru.perp.v2.MsgWithdrawInsuranceFund")
+	proto.RegisterType((*MsgWithdrawInsuranceFundResponse)(nil), "nibiru.perp.v2.MsgWithdrawInsuranceFundResponse")
+	proto.RegisterType((*MsgRegisterReferralCode)(nil), "nibiru.perp.v2.MsgRegisterReferralCode")
+	proto.RegisterType((*MsgRegisterReferralCodeResponse)(nil), "nibiru.perp.v2.MsgRegisterReferralCodeResponse")
+	proto.RegisterType((*MsgSetReferrer)(nil), "nibiru.perp.v2.MsgSetReferrer")
+	proto.RegisterType((*MsgSetReferrerResponse)(nil), "nibiru.perp.v2.MsgSetReferrerResponse")
+	proto.RegisterType((*MsgClaimReferralEarnings)(nil), "nibiru.perp.v2.MsgClaimReferralEarnings")
+	proto.RegisterType((*MsgClaimReferralEarningsResponse)(nil), "nibiru.perp.v2.MsgClaimReferralEarningsResponse")
+}
+
+func init() { proto.RegisterFile("perp/v2/tx.proto", fileDescriptor_0993e7ada6b2d291) }
+
+var fileDescriptor_0993e7ada6b2d291 = []byte{
+	// 2313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x41, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0x8f, 0x1d, 0x7b, 0xf2, 0xc6, 0x76, 0x92, 0x8e, 0x1d, 0x4f, 0x3a, 0xc9, 0x8c, 0x53,
+	0x49, 0x6c, 0x87, 0xac, 0x7b, 0x9c, 0x09, 0xda, 0x00, 0x02, 0x56, 0x89, 0xed, 0xa0, 0xa0, 0x38,
+	0xf1, 0x8e, 0xad, 0x2c, 0x5a, 0x90, 0x9a, 0x9a, 0xee, 0xf2, 0xb8, 0x49, 0x4f, 0xd7, 0x6c, 0x57,
+	0x8f, 0x13, 0x07, 0xa4, 0x48, 0x8b, 0x04, 0x07, 0x38, 0xac, 0x04, 0x07, 0x2e, 0x48, 0x1c, 0xd8,
+	0xcb, 0x1e, 0x90, 0x96, 0x3b, 0xf7, 0x3d, 0xc1, 0x4a, 0x5c, 0x16, 0x0e, 0x59, 0x94, 0xac, 0x10,
+	0x07, 0x4e, 0x2b, 0x7e, 0x00, 0xaa, 0xea, 0xee, 0x9a, 0x9e, 0x99, 0x9e, 0x99, 0xf6, 0x60, 0x5b,
+	0x42, 0xca, 0xc9, 0xee, 0xa9, 0xef, 0xbd, 0xf7, 0xbd, 0xaa, 0x57, 0xef, 0xbd, 0xaa, 0x82, 0x53,
+	0x0d, 0xe2, 0x35, 0x4a, 0xbb, 0xe5, 0x92, 0xff, 0x54, 0x6f, 0x78, 0xd4, 0xa7, 0xea, 0x94, 0x6b,
+	0x57, 0x6d, 0xaf, 0xa9, 0xf3, 0x01, 0x7d, 0xb7, 0xac, 0x5d, 0xa8, 0x51, 0x5a, 0x73, 0x48, 0x09,
+	0x37, 0xec, 0x12, 0x76, 0x5d, 0xea, 0x63, 0xdf, 0xa6, 0x2e, 0x0b, 0xd0, 0x5a, 0xc1, 0xa4, 0xac,
+	0x4e, 0x59, 0xa9, 0x8a, 0x19, 0x29, 0xed, 0xde, 0xa8, 0x12, 0x1f, 0xdf, 0x28, 0x99, 0xd4, 0x76,
+	0xc3, 0xf1, 0xe9, 0x1a, 0xad, 0x51, 0xf1, 0x6f, 0x89, 0xff, 0x17, 0xfe, 0x5a, 0x0c, 0x75, 0x8a,
+	0xaf, 0x6a, 0x73, 0xbb, 0xe4, 0xdb, 0x75, 0xc2, 0x7c, 0x5c, 0x6f, 0x84, 0x80, 0x33, 0x11, 0x2d,
+	0xe6, 0x63, 0x9f, 0x04, 0x3f, 0xa2, 0x8f, 0x15, 0x38, 0xb9, 0xce, 0x6a, 0x15, 0x52, 0xa7, 0xbb,
+	0x64, 0x1d, 0x7b, 0x35, 0xdb, 0x55, 0xcf, 0xc2, 0x18, 0x23, 0xae, 0x45, 0xbc, 0xbc, 0x32, 0xa7,
+	0x2c, 0x9e, 0xa8, 0x84, 0x5f, 0xea, 0x3a, 0x8c, 0x36, 0xb0, 0xed, 0xe5, 0x33, 0xfc, 0xd7, 0x3b,
+	0x5f, 0xff, 0xe4, 0x45, 0xf1, 0xd8, 0xdf, 0x5f, 0x14, 0x6f, 0xd4, 0x6c, 0x7f, 0xa7, 0x59, 0xd5,
+	0x4d, 0x5a, 0x2f, 0x3d, 0x10, 0x6e, 0xae, 0xec, 0x60, 0xdb, 0x2d, 0x05, 0x2e, 0x97, 0x9e, 0x96,
+	0x4c, 0x5a, 0xaf, 0x53, 0xb7, 0x84, 0x19, 0x23, 0xbe, 0xbe, 0x81, 0x6d, 0xaf, 0x22, 0xd4, 0xa8,
+	0xb7, 0x60, 0xac, 0x2e, 0x0c, 0xe6, 0x47, 0xe6, 0x94, 0xc5, 0x5c, 0xf9, 0x9c, 0x1e, 0xf8, 0xad,
+	0x73, 0xbf, 0xf5, 0xd0, 0x6f, 0x7d, 0x85, 0xda, 0xee, 0x9d, 0x51, 0x6e, 0xab, 0x12, 0xc2, 0xd1,
+	0xbf, 0x14, 0x98, 0xed, 0xe0, 0x5c, 0x21, 0xac, 0x41, 0x5d, 0x46, 0xd4, 0x6f, 0x03, 0x04, 0x28,
+	0x83, 0x36, 0xfd, 0xbc, 0x92, 0x4e, 0xf1, 0x89, 0x40, 0xe4, 0x61, 0xd3, 0x57, 0xdf, 0x81, 0x93,
+	0xdb, 0x4d, 0xd7, 0xb2, 0xdd, 0x9a, 0xd1, 0xc0, 0x7b, 0x75, 0xe2, 0xfa, 0xa1, 0xbb, 0x7a, 0xe8,
+	0xee, 0x7c, 0xcc, 0xdd, 0x70, 0x9d, 0x82, 0x3f, 0x4b, 0xcc, 0x7a, 0x5c, 0xf2, 0xf7, 0x1a, 0x84,
+	0xe9, 0xab, 0xc4, 0xac, 0x4c, 0x85, 0x6a, 0x36, 0x02, 0x2d, 0xea, 0x57, 0x21, 0xdb, 0xa0, 0xcc,
+	0xe6, 0xeb, 0x1c, 0xfa, 0x9b, 0xd7, 0xdb, 0xa3, 0x42, 0xdf, 0x08, 0xc7, 0x2b, 0x12, 0x89, 0xfe,
+	0xa0, 0xc0, 0xc4, 0x3a, 0xab, 0xdd, 0xb6, 0xac, 0xff, 0x93, 0xb5, 0xf9, 0x50, 0x81, 0xe9, 0x38,
+	0x61, 0xb9, 0x30, 0x09, 0x13, 0xab, 0x1c, 0xf8, 0xc4, 0x66, 0x52, 0x4f, 0xec, 0x7f, 0x14, 0x38,
+	0xbd, 0xce, 0x6a, 0xeb, 0x4d, 0xc7, 0xb7, 0xef, 0xdb, 0xef, 0x35, 0x6d, 0x0b, 0xfb, 0xa4, 0xe7,
+	0xec, 0xbe, 0x0d, 0x13, 0x4e, 0x08, 0xe2, 0xfb, 0x34, 0x9f, 0x99, 0x1b, 0x59, 0xcc, 0x95, 0x97,
+	0x3a, 0xed, 0x74, 0x29, 0xd4, 0xef, 0xb7, 0xa4, 0x2a, 0x6d, 0x2a, 0x34, 0x1f, 0x72, 0xb1, 0x41,
+	0xb9, 0x7e, 0xca, 0xc1, 0xac, 0xdf, 0x59, 0x18, 0xf3, 0x3d, 0xcc, 0x1d, 0xc9, 0x04, 0x8e, 0x04,
+	0x5f, 0xe8, 0x2f, 0x19, 0x38, 0xd7, 0xc5, 0x52, 0xae, 0x11, 0xee, 0x70, 0x53, 0x11, 0x6e, 0x7e,
+	0x6b, 0xa0, 0x9b, 0x91, 0x82, 0x36, 0x77, 0xc3, 0xdf, 0x3a, 0xdc, 0xfe, 0xb3, 0x02, 0x67, 0x12,
+	0x50, 0x6a, 0x1e, 0xc6, 0x59, 0xd3, 0x34, 0x09, 0x63, 0x62, 0x0a, 0xb2, 0x95, 0xe8, 0x53, 0x9d,
+	0x86, 0xe3, 0xc4, 0xf3, 0x68, 0xe4, 0x49, 0xf0, 0xa1, 0xde, 0x85, 0xa9, 0x48, 0x2f, 0xf5, 0x8c,
+	0x6d, 0x42, 0xd2, 0x06, 0xea, 0x64, 0x4b, 0xec, 0x2e, 0x21, 0xea, 0x5b, 0x90, 0xe3, 0x6e, 0x19,
+	0x64, 0x5b, 0x28, 0x19, 0x4d, 0x99, 0x30, 0xb8, 0xcc, 0xda, 0xf6, 0x5d, 0x42, 0xd0, 0xdf, 0x46,
+	0x44, 0x02, 0x7d, 0xd8, 0x20, 0x6e, 0x14, 0x66, 0x47, 0xb5, 0x49, 0x97, 0x60, 0x94, 0xd9, 0x56,
+	0xe0, 0xf9, 0x54, 0xf9, 0x5c, 0xe7, 0x32, 0xad, 0xda, 0x1e, 0x31, 0xc5, 0x24, 0x0b, 0x98, 0xfa,
+	0x03, 0x50, 0xdf, 0x6b, 0x52, 0x9f, 0x18, 0x42, 0x91, 0x81, 0xeb, 0xb4, 0xe9, 0xfa, 0xf9, 0xd1,
+	0x7d, 0x6f, 0xc2, 0x7b, 0xae, 0x5f, 0x39, 0x25, 0x34, 0xdd, 0xe6, 0x8a, 0x6e, 0x0b, 0x3d, 0xea,
+	0x77, 0x21, 0xeb, 0x90, 0x5d, 0xe2, 0xe1, 0x1a, 0xc9, 0x1f, 0x1f, 0x6a, 0x63, 0x4b, 0x79, 0x95,
+	0xc0, 0x2c, 0x9f, 0xf9, 0x36, 0xa2, 0x86, 0x63, 0xd7, 0x6d, 0x3f, 0x3f, 0x36, 0x14, 0xdd, 0x69,
+	0xae, 0x2e, 0xc6, 0xf6, 0x3e, 0xd7, 0xa5, 0x16, 0x21, 0xe7, 0x11, 0xab, 0x69, 0x12, 0x83, 0xba,
+	0xce, 0x5e, 0x7e, 0x5c, 0xc4, 0x1d, 0x04, 0x3f, 0x3d, 0x74, 0x9d, 0x3d, 0xf4, 0xc5, 0x71, 0x98,
+	0xed, 0x58, 0x5b, 0x19, 0xb0, 0xf1, 0xb4, 0xa3, 0xa4, 0x4d, 0x3b, 0xea, 0x0e, 0xe4, 0xc9, 0x53,
+	0x73, 0x07, 0xbb, 0x35, 0x62, 0x19, 0x2e, 0xe5, 0xbf, 0x61, 0xc7, 0xd8, 0xc5, 0x4e, 0x93, 0x0c,
+	0x59, 0x67, 0xce, 0x4a, 0x7d, 0x0f, 0x42, 0x75, 0x8f, 0xb8, 0x36, 0x75, 0x1b, 0x66, 0x5b, 0x96,
+	0x22, 0xfb, 0x06, 0xb3, 0x9f, 0x05, 0xf1, 0xb2, 0x7f, 0x43, 0x33, 0x52, 0x5d, 0xe4, 0xd7, 0xa6,
+	0xfd, 0x2c, 0x31, 0xaf, 0x8f, 0x1e, 0x48, 0x5e, 0x7f, 0x1b, 0x26, 0x3c, 0x82, 0x1d, 0xfb, 0x19,
+	0xe7, 0xef, 0x3a, 0x43, 0x06, 0x55, 0x2e, 0xd2, 0xb1, 0xe1, 0x3a, 0xea, 0x0f, 0x61, 0xba, 0xe9,
+	0xc6, 0x95, 0x1a, 0x78, 0xdb, 0x27, 0x5e, 0x7e, 0x6c, 0x28, 0xd5, 0x6a, 0x4b, 0xd7, 0x86, 0xeb,
+	0xdc, 0xe6, 0x9a, 0xd4, 0x47, 0x70, 0x32, 0x6c, 0x3f, 0x7c, 0x6a, 0xec, 0xe2, 0xa6, 0xe3, 0xe7,
+	0xc7, 0x87, 0x52, 0x3e, 0x19, 0xa8, 0xd9, 0xa2, 0x8f, 0xb8, 0x12, 0xf5, 0xfb, 0x70, 0x5a, 0xae,
+	0x61, 0x14, 0x36, 0xf9, 0xec, 0x50, 0x9a, 0x4f, 0x45, 0x8a, 0xa2, 0x78, 0x41, 0x7b, 0x70, 0x6a,
+	0x9d, 0xd5, 0x56, 0x1c, 0xca, 0xc8, 0x11, 0xa7, 0x30, 0xf4, 0xe5, 0x08, 0xe4, 0x3b, 0x6d, 0xcb,
+	0x2d, 0xd6, 0x6f, 0xb3, 0x28, 0x47, 0xb5, 0x59, 0x32, 0x87, 0xbc, 0x59, 0x46, 0x0e, 0x65, 0xb3,
+	0x8c, 0xfe, 0xef, 0x9b, 0xe5, 0x7b, 0x70, 0xaa, 0x15, 0xca, 0x61, 0x33, 0x31, 0x5c, 0x2c, 0x4f,
+	0x45, 0xb1, 0xbc, 0x15, 0x34, 0x21, 0x7f, 0xcc, 0x88, 0x92, 0xb9, 0x81, 0x3d, 0xdf, 0xc6, 0x8e,
+	0x58, 0xfb, 0xa3, 0x2a, 0x99, 0x0f, 0x21, 0xc7, 0x57, 0x35, 0x2a, 0x7e, 0xc3, 0x4d, 0x3e, 0x70,
+	0x15, 0x61, 0xd9, 0xab, 0x41, 0xbe, 0xbb, 0xa8, 0x86, 0xb5, 0x6a, 0xb8, 0xd2, 0x3a, 0xd3, 0x59,
+	0x5a, 0x45, 0xb1, 0x42, 0xff, 0x0c, 0x6a, 0x51, 0x7c, 0xd2, 0x5e, 0xd7, 0xa2, 0xd7, 0xb5, 0xa8,
+	0x4f, 0x2d, 0x3a, 0xb4, 0x0d, 0x7c, 0xb8, 0xd5, 0xe8, 0x7d, 0x45, 0x94, 0x84, 0x55, 0xea, 0x62,
+	0x9f, 0x6c, 0xd1, 0x35, 0x93, 0xb2, 0x3d, 0xe6, 0x93, 0xfa, 0xdd, 0xa6, 0x6b, 0xf5, 0x4c, 0x13,
+	0x0f, 0x20, 0x6b, 0x71, 0x81, 0xd6, 0x21, 0xb0, 0x4f, 0x0f, 0x3f, 0xcb, 0x39, 0x7e, 0xf9, 0xa2,
+	0x78, 0x72, 0x0f, 0xd7, 0x9d, 0x6f, 0xa0, 0x48, 0x10, 0x55, 0xa4, 0x0e, 0x84, 0x60, 0xae, 0x17,
+	0x87, 0x68, 0xd7, 0xa1, 0x67, 0xe2, 0x04, 0xb9, 0x49, 0x7c, 0xdf, 0x39, 0xf2, 0xba, 0xf9, 0x99,
+	0x02, 0xe7, 0xba, 0x8c, 0xcb, 0x7c, 0xd0, 0x80, 0x49, 0x26, 0x46, 0x2c, 0xc3, 0xa4, 0xb6, 0x3c,
+	0xc8, 0xf5, 0x99, 0x92, 0x65, 0x4e, 0xe8, 0xa3, 0xcf, 0x8b, 0x8b, 0x29, 0x96, 0x8d, 0x0b, 0xb0,
+	0xca, 0x44, 0x68, 0x41, 0x7c, 0xa9, 0xf7, 0x20, 0x5b, 0xc5, 0x96, 0x61, 0x91, 0xea, 0xb0, 0xf7,
+	0x25, 0xe3, 0x55, 0x6c, 0xad, 0x92, 0xaa, 0x8f, 0x7e, 0x79, 0x1c, 0x26, 0x79, 0xa2, 0x73, 0xb0,
+	0x49, 0x1e, 0x7a, 0x7c, 0xee, 0x8e, 0xa8, 0x36, 0x7c, 0x0d, 0x80, 0x72, 0x7b, 0x06, 0x27, 0xd5,
+	0xeb, 0x50, 0x25, 0x18, 0x6d, 0xed, 0x35, 0x48, 0xe5, 0x04, 0x8d, 0xfe, 0x95, 0x07, 0xb1, 0xd1,
+	0x74, 0x07, 0xb1, 0x4d, 0x98, 0xf4, 0x3d, 0xbb, 0x56, 0x23, 0x9e, 0xd1, 0xf0, 0x6c, 0x73, 0xd8,
+	0xf3, 0xd2, 0x44, 0xa8, 0x64, 0x83, 0xeb, 0x50, 0xd7, 0x60, 0x42, 0x28, 0x33, 0x18, 0x6d, 0x7a,
+	0x26, 0x11, 0x79, 0x64, 0xaa, 0x8c, 0x3a, 0xb9, 0x6c, 0xc5, 0x64, 0x36, 0x05, 0xb2, 0x92, 0x6b,
+	0xb4, 0x3e, 0x7a, 0x1c, 0x12, 0xc7, 0x0f, 0xe1, 0x90, 0x98, 0x3d, 0xbc, 0x43, 0xe2, 0x89, 0x83,
+	0x3b, 0x24, 0xa2, 0x32, 0xcc, 0xb4, 0x45, 0xa3, 0xdc, 0x64, 0xe7, 0x20, 0x1b, 0x84, 0x8b, 0x6d,
+	0x89, 0xb8, 0x1c, 0xad, 0x8c, 0x8b, 0xef, 0x7b, 0x16, 0x5a, 0x81, 0x29, 0xde, 0xd4, 0x62, 0xd7,
+	0x24, 0x4e, 0xff, 0x10, 0x8e, 0x2b, 0xc9, 0xb4, 0x2b, 0xc9, 0xc3, 0xd9, 0x76, 0x25, 0x32, 0xf1,
+	0x7c, 0x34, 0x12, 0xde, 0xd9, 0x36, 0x06, 0xef, 0x91, 0xde, 0x06, 0xba, 0xc3, 0x70, 0xe4, 0x00,
+	0xc2, 0xf0, 0xf5, 0x25, 0x43, 0x47, 0xfc, 0xdc, 0x87, 0xd9, 0x8e, 0xb5, 0x92, 0x11, 0x74, 0x03,
+	0x8e, 0x53, 0x2f, 0x5a, 0xb2, 0x5c, 0x79, 0x26, 0x31, 0xd7, 0x84, 0x37, 0x4e, 0x01, 0x12, 0xe9,
+	0xe2, 0x76, 0x75, 0xcd, 0xc5, 0x55, 0x87, 0xac, 0x78, 0x94, 0xb1, 0xfe, 0xd7, 0xc2, 0xa8, 0x00,
+	0x17, 0x92, 0xf0, 0x32, 0x94, 0x4a, 0x22, 0xba, 0x57, 0x6d, 0x96, 0x56, 0xe1, 0x6f, 0x15, 0xb8,
+	0x98, 0x28, 0x21, 0xbd, 0xfa, 0x09, 0x9c, 0xf1, 0x08, 0xef, 0x9f, 0x44, 0xf5, 0x71, 0x1c, 0xec,
+	0x13, 0x0f, 0x3b, 0x87, 0x51, 0x82, 0xd4, 0xc8, 0xce, 0x8a, 0x34, 0x83, 0x76, 0xe1, 0x7c, 0x70,
+	0xfd, 0x1c, 0xa3, 0xd6, 0x1a, 0xee, 0xb9, 0x4d, 0xde, 0x02, 0x88, 0x71, 0xcd, 0xa4, 0xbb, 0x05,
+	0x8c, 0x89, 0x20, 0x1b, 0x2e, 0xf7, 0xb1, 0x2b, 0x27, 0xe7, 0x0e, 0x8c, 0x63, 0xd3, 0x14, 0x7b,
+	0x22, 0x58, 0xf4, 0xae, 0x04, 0x1d, 0x93, 0xbf, 0x1d, 0x20, 0x43, 0x6b, 0x91, 0x20, 0xda, 0x83,
+	0x82, 0x7c, 0xfd, 0x38, 0x62, 0x2f, 0x1d, 0x98, 0xef, 0x6f, 0xfa, 0x40, 0x1d, 0xfd, 0x91, 0xd8,
+	0x3a, 0xab, 0x44, 0xb4, 0x88, 0xf7, 0x5c, 0xd6, 0xf4, 0x78, 0x32, 0xec, 0xdb, 0x07, 0xde, 0x82,
+	0xb1, 0x30, 0xe5, 0xa4, 0xf4, 0x2e, 0x84, 0xa3, 0x77, 0xa1, 0xd8, 0xc3, 0x96, 0x74, 0xe9, 0x16,
+	0x8c, 0xb1, 0x1d, 0xec, 0x11, 0x96, 0xf6, 0x59, 0x29, 0x84, 0xa3, 0xc7, 0xa2, 0xa1, 0x7d, 0xc7,
+	0xf6, 0x77, 0x2c, 0x0f, 0x3f, 0x49, 0xed, 0x48, 0x68, 0x2c, 0xb3, 0x3f, 0x63, 0x36, 0xcc, 0xf5,
+	0x32, 0x26, 0x3d, 0x59, 0x83, 0x5c, 0xd3, 0x75, 0xa8, 0xf9, 0xd8, 0xe0, 0x6f, 0x84, 0xa1, 0x3b,
+	0x9a, 0x1e, 0x3c, 0x20, 0xea, 0xd1, 0x03, 0xa2, 0xbe, 0x15, 0x3d, 0x20, 0xde, 0xc9, 0x72, 0x13,
+	0x1f, 0x7c, 0x5e, 0x54, 0x2a, 0x10, 0x08, 0xf2, 0x21, 0xb4, 0x16, 0xa6, 0xb6, 0x9a, 0xcd, 0x7c,
+	0x9e, 0xd6, 0xb6, 0x89, 0xe7, 0x61, 0x67, 0x85, 0x5a, 0xbd, 0x8f, 0xf3, 0x2a, 0x8c, 0x9a, 0xd4,
+	0x0a, 0xcf, 0x97, 0x15, 0xf1, 0x3f, 0xba, 0x04, 0xc5, 0x1e, 0x6a, 0x64, 0x9a, 0xfa, 0xa6, 0x28,
+	0xa8, 0x9b, 0xc4, 0x0f, 0x46, 0x89, 0xb7, 0x2f, 0x03, 0x41, 0x25, 0x8d, 0x49, 0x4b, 0xbd, 0xe5,
+	0xf0, 0xf6, 0x09, 0xdb, 0xf5, 0xc8, 0xee, 0x1a, 0xf6, 0x5c, 0xdb, 0xad, 0xb1, 0x9e, 0x19, 0xf0,
+	0x17, 0x0a, 0xcc, 0xf5, 0x12, 0x92, 0x33, 0x5c, 0x83, 0x2c, 0x09, 0x7f, 0x3b, 0x8c, 0xcc, 0x27,
+	0x95, 0x97, 0xff, 0x7d, 0x16, 0x46, 0xd6, 0x59, 0x4d, 0x7d, 0x0e, 0x13, 0x6d, 0x6f, 0xb8, 0xc5,
+	0x84, 0x47, 0x9b, 0x38, 0x40, 0x5b, 0x18, 0x00, 0x90, 0x73, 0x74, 0xf5, 0xfd, 0xbf, 0x7e, 0xf1,
+	0xab, 0x4c, 0x11, 0x5d, 0x8c, 0x3a, 0xe8, 0xe8, 0x19, 0xd9, 0x13, 0x68, 0x23, 0x38, 0x1b, 0xaa,
+	0x0c, 0x4e, 0xb4, 0x5e, 0x29, 0x2f, 0x24, 0x28, 0x97, 0xa3, 0xda, 0x95, 0x7e, 0xa3, 0xd2, 0x2e,
+	0x12, 0x76, 0x2f, 0x20, 0xad, 0xd3, 0x2e, 0xb6, 0xac, 0xc8, 0xe8, 0xcf, 0x14, 0x98, 0xea, 0x78,
+	0xc2, 0xbb, 0x34, 0xf0, 0xb5, 0x4a, 0xbb, 0x96, 0xfa, 0x41, 0x0b, 0xcd, 0x0b, 0x12, 0x73, 0xa8,
+	0xd0, 0x49, 0xa2, 0xce, 0xf1, 0x8e, 0xb4, 0xfa, 0x1c, 0x26, 0xda, 0x5e, 0x80, 0x92, 0xa6, 0x3f,
+	0x0e, 0xd0, 0x16, 0x06, 0x00, 0x06, 0x4f, 0x3f, 0x6d, 0x10, 0x57, 0x5e, 0xa6, 0xa8, 0x3f, 0x55,
+	0x60, 0xb2, 0xfd, 0x06, 0x77, 0x2e, 0xc1, 0x42, 0x1b, 0x42, 0x5b, 0x1c, 0x84, 0x18, 0x3c, 0x0d,
+	0x26, 0x87, 0xb7, 0x58, 0x3c, 0x87, 0x89, 0xb6, 0x5b, 0xbd, 0xa4, 0x69, 0x88, 0x03, 0xb4, 0x85,
+	0x01, 0x80, 0xc1, 0xd3, 0xd0, 0x08, 0xd0, 0x86, 0x60, 0xa2, 0x7e, 0xa8, 0xc0, 0x4c, 0xf2, 0xcd,
+	0x41, 0x92, 0xb3, 0x89, 0x48, 0x6d, 0x39, 0x2d, 0x52, 0x92, 0x5b, 0x16, 0xe4, 0xbe, 0x82, 0x16,
+	0x3b, 0xc9, 0x89, 0xfb, 0x04, 0xc2, 0xef, 0x5f, 0x48, 0x24, 0x68, 0xf0, 0x96, 0x45, 0xfd, 0xb9,
+	0x02, 0x53, 0x1d, 0x37, 0x07, 0x49, 0x81, 0xdb, 0x0e, 0xd1, 0xae, 0x0d, 0x84, 0x48, 0x4a, 0x0b,
+	0x82, 0xd2, 0x25, 0x54, 0xec, 0xa4, 0x14, 0x1c, 0xdb, 0x5b, 0x4b, 0xf6, 0x04, 0x20, 0x76, 0xd4,
+	0xbe, 0x98, 0xb4, 0x1e, 0x72, 0x58, 0xbb, 0xda, 0x77, 0x58, 0x1a, 0xbf, 0x2c, 0x8c, 0x5f, 0x44,
+	0xe7, 0xbb, 0x16, 0x8b, 0x63, 0x0d, 0xd1, 0xca, 0xaa, 0x3f, 0x86, 0x5c, 0xfc, 0x84, 0x54, 0x48,
+	0x0a, 0xc6, 0xd6, 0xb8, 0x36, 0xdf, 0x7f, 0x5c, 0xda, 0xbe, 0x22, 0x6c, 0x17, 0xd0, 0x85, 0xae,
+	0x50, 0x15, 0xe0, 0xd0, 0xb8, 0x48, 0x97, 0xb1, 0xe3, 0x53, 0x72, 0xba, 0x6c, 0x01, 0xb4, 0x85,
+	0x01, 0x80, 0x34, 0xe9, 0x32, 0xee, 0xfd, 0xaf, 0x15, 0x38, 0xdd, 0xdd, 0xc6, 0x27, 0x65, 0xc6,
+	0x2e, 0x94, 0xf6, 0x46, 0x1a, 0x94, 0x24, 0x74, 0x5d, 0x10, 0xba, 0x8a, 0x2e, 0x77, 0x12, 0x22,
+	0x42, 0xc4, 0x30, 0xb9, 0x4c, 0x94, 0x50, 0x7f, 0xa3, 0x80, 0x9a, 0x70, 0x1a, 0x48, 0x5a, 0xf7,
+	0x6e, 0x98, 0xb6, 0x94, 0x0a, 0x26, 0x99, 0xbd, 0x21, 0x98, 0xcd, 0xa3, 0x2b, 0x5d, 0xdb, 0xc6,
+	0x66, 0xdd, 0xd4, 0x3e, 0x56, 0x20, 0xdf, 0xb3, 0xaf, 0xbf, 0x9e, 0x5c, 0x52, 0x12, 0xc1, 0xda,
+	0xcd, 0x7d, 0x80, 0x25, 0xd9, 0x9b, 0x82, 0xec, 0x12, 0xba, 0x9e, 0x54, 0x8e, 0xe2, 0x44, 0x63,
+	0x87, 0x1e, 0xf5, 0x4f, 0x0a, 0x9c, 0xef, 0xd7, 0xa8, 0xeb, 0x3d, 0x8b, 0x70, 0x32, 0xf3, 0x37,
+	0xf7, 0x87, 0x97, 0xe4, 0xdf, 0x14, 0xe4, 0x97, 0x91, 0xde, 0xa3, 0x86, 0xf7, 0xe2, 0xff, 0x3b,
+	0x05, 0xa6, 0x13, 0xfb, 0xef, 0xa4, 0xed, 0x90, 0x04, 0xd4, 0x4a, 0x29, 0x81, 0x92, 0xaa, 0x2e,
+	0xa8, 0x2e, 0xa2, 0xf9, 0xae, 0xa0, 0x08, 0xa4, 0x0c, 0x3b, 0x12, 0x0b, 0x32, 0xe9, 0xef, 0x15,
+	0x98, 0x49, 0x6e, 0xad, 0x93, 0x32, 0x7e, 0x22, 0x52, 0x5b, 0x4e, 0x8b, 0x6c, 0x9d, 0x9b, 0x05,
+	0xcb, 0x6b, 0x68, 0xa1, 0x93, 0xe5, 0x93, 0x50, 0xac, 0x93, 0x26, 0x9f, 0xc9, 0xc4, 0x4e, 0x39,
+	0x39, 0xb1, 0x74, 0x03, 0xb5, 0x52, 0x4a, 0xe0, 0xe0, 0x99, 0xf4, 0x42, 0x29, 0xc3, 0x0b, 0xc5,
+	0x0c, 0xde, 0x26, 0xf3, 0x84, 0x1c, 0xef, 0xb0, 0x0b, 0xc9, 0xc5, 0x26, 0x1a, 0xd7, 0xe6, 0xfb,
+	0x8f, 0x0f, 0x4e, 0xc8, 0xfc, 0xda, 0xc5, 0x8b, 0xac, 0xf1, 0x65, 0x4c, 0xee, 0xc3, 0x93, 0xbb,
+	0x94, 0x04, 0xa4, 0xb6, 0x9c, 0x16, 0x39, 0x78, 0x19, 0x4d, 0x2e, 0xd6, 0x9a, 0x9f, 0xa8, 0xdd,
+	0xbe, 0xf3, 0x9d, 0x4f, 0x5e, 0x16, 0x94, 0x4f, 0x5f, 0x16, 0x94, 0x7f, 0xbc, 0x2c, 0x28, 0x1f,
+	0xbc, 0x2a, 0x1c, 0xfb, 0xf4, 0x55, 0xe1, 0xd8, 0x67, 0xaf, 0x0a, 0xc7, 0xde, 0x5d, 0x1a, 0x74,
+	0xed, 0x2c, 0x54, 0x8b, 0x26, 0xbe, 0xb4, 0x5b, 0xae, 0x8e, 0x89, 0x53, 0xd6, 0xcd, 0xff, 0x0e,
+	0x00, 0x43, 0x74, 0x3f, 0xf4, 0x2c, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error)
+	AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error)
+	MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error)
+	OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error)
+	ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error)
+	PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error)
+	DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error)
+	SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgSettlePositionResponse, error)
+	PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error)
+	CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error)
+	EnableCrossMargin(ctx context.Context, in *MsgEnableCrossMargin, opts ...grpc.CallOption) (*MsgEnableCrossMarginResponse, error)
+	DisableCrossMargin(ctx context.Context, in *MsgDisableCrossMargin, opts ...grpc.CallOption) (*MsgDisableCrossMarginResponse, error)
+	AddCrossMarginCollateral(ctx context.Context, in *MsgAddCrossMarginCollateral, opts ...grpc.CallOption) (*MsgAddCrossMarginCollateralResponse, error)
+	RemoveCrossMarginCollateral(ctx context.Context, in *MsgRemoveCrossMarginCollateral, opts ...grpc.CallOption) (*MsgRemoveCrossMarginCollateralResponse, error)
+	DepositInsuranceFund(ctx context.Context, in *MsgDepositInsuranceFund, opts ...grpc.CallOption) (*MsgDepositInsuranceFundResponse, error)
+	WithdrawInsuranceFund(ctx context.Context, in *MsgWithdrawInsuranceFund, opts ...grpc.CallOption) (*MsgWithdrawInsuranceFundResponse, error)
+	RegisterReferralCode(ctx context.Context, in *MsgRegisterReferralCode, opts ...grpc.CallOption) (*MsgRegisterReferralCodeResponse, error)
+	SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error)
+	ClaimReferralEarnings(ctx context.Context, in *MsgClaimReferralEarnings, opts ...grpc.CallOption) (*MsgClaimReferralEarningsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RemoveMargin(ctx context.Context, in *MsgRemoveMargin, opts ...grpc.CallOption) (*MsgRemoveMarginResponse, error) {
+	out := new(MsgRemoveMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgClosePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddMargin(ctx context.Context, in *MsgAddMargin, opts ...grpc.CallOption) (*MsgAddMarginResponse, error) {
+	out := new(MsgAddMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ExchangedNotionalValue.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MarginToTrader.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgDonateToEcosystemFund) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) MultiLiquidate(ctx context.Context, in *MsgMultiLiquidate, opts ...grpc.CallOption) (*MsgMultiLiquidateResponse, error) {
+	out := new(MsgMultiLiquidateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/MultiLiquidate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) OpenPosition(ctx context.Context, in *MsgOpenPosition, opts ...grpc.CallOption) (*MsgOpenPositionResponse, error) {
+	out := new(MsgOpenPositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/OpenPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClosePosition(ctx context.Context, in *MsgClosePosition, opts ...grpc.CallOption) (*MsgClosePositionResponse, error) {
+	out := new(MsgClosePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClosePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PartialClose(ctx context.Context, in *MsgPartialClose, opts ...grpc.CallOption) (*MsgPartialCloseResponse, error) {
+	out := new(MsgPartialCloseResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/PartialClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DonateToEcosystemFund(ctx context.Context, in *MsgDonateToEcosystemFund, opts ...grpc.CallOption) (*MsgDonateToEcosystemFundResponse, error) {
+	out := new(MsgDonateToEcosystemFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DonateToEcosystemFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettlePosition(ctx context.Context, in *MsgSettlePosition, opts ...grpc.CallOption) (*MsgSettlePositionResponse, error) {
+	out := new(MsgSettlePositionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SettlePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PlaceOrder(ctx context.Context, in *MsgPlaceOrder, opts ...grpc.CallOption) (*MsgPlaceOrderResponse, error) {
+	out := new(MsgPlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrder, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error) {
+	out := new(MsgCancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplaceOrder(ctx context.Context, in *MsgReplaceOrder, opts ...grpc.CallOption) (*MsgReplaceOrderResponse, error) {
+	out := new(MsgReplaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableCrossMargin(ctx context.Context, in *MsgEnableCrossMargin, opts ...grpc.CallOption) (*MsgEnableCrossMarginResponse, error) {
+	out := new(MsgEnableCrossMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/EnableCrossMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableCrossMargin(ctx context.Context, in *MsgDisableCrossMargin, opts ...grpc.CallOption) (*MsgDisableCrossMarginResponse, error) {
+	out := new(MsgDisableCrossMarginResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DisableCrossMargin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddCrossMarginCollateral(ctx context.Context, in *MsgAddCrossMarginCollateral, opts ...grpc.CallOption) (*MsgAddCrossMarginCollateralResponse, error) {
+	out := new(MsgAddCrossMarginCollateralResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/AddCrossMarginCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveCrossMarginCollateral(ctx context.Context, in *MsgRemoveCrossMarginCollateral, opts ...grpc.CallOption) (*MsgRemoveCrossMarginCollateralResponse, error) {
+	out := new(MsgRemoveCrossMarginCollateralResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RemoveCrossMarginCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositInsuranceFund(ctx context.Context, in *MsgDepositInsuranceFund, opts ...grpc.CallOption) (*MsgDepositInsuranceFundResponse, error) {
+	out := new(MsgDepositInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/DepositInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawInsuranceFund(ctx context.Context, in *MsgWithdrawInsuranceFund, opts ...grpc.CallOption) (*MsgWithdrawInsuranceFundResponse, error) {
+	out := new(MsgWithdrawInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/WithdrawInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterReferralCode(ctx context.Context, in *MsgRegisterReferralCode, opts ...grpc.CallOption) (*MsgRegisterReferralCodeResponse, error) {
+	out := new(MsgRegisterReferralCodeResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/RegisterReferralCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReferrer(ctx context.Context, in *MsgSetReferrer, opts ...grpc.CallOption) (*MsgSetReferrerResponse, error) {
+	out := new(MsgSetReferrerResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/SetReferrer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimReferralEarnings(ctx context.Context, in *MsgClaimReferralEarnings, opts ...grpc.CallOption) (*MsgClaimReferralEarningsResponse, error) {
+	out := new(MsgClaimReferralEarningsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v2.Msg/ClaimReferralEarnings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	RemoveMargin(context.Context, *MsgRemoveMargin) (*MsgRemoveMarginResponse, error)
+	AddMargin(context.Context, *MsgAddMargin) (*MsgAddMarginResponse, error)
+	MultiLiquidate(context.Context, *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error)
+	OpenPosition(context.Context, *MsgOpenPosition) (*MsgOpenPositionResponse, error)
+	ClosePosition(context.Context, *MsgClosePosition) (*MsgClosePositionResponse, error)
+	PartialClose(context.Context, *MsgPartialClose) (*MsgPartialCloseResponse, error)
+	DonateToEcosystemFund(context.Context, *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error)
+	SettlePosition(context.Context, *MsgSettlePosition) (*MsgSettlePositionResponse, error)
+	PlaceOrder(context.Context, *MsgPlaceOrder) (*MsgPlaceOrderResponse, error)
+	CancelOrder(context.Context, *MsgCancelOrder) (*MsgCancelOrderResponse, error)
+	ReplaceOrder(context.Context, *MsgReplaceOrder) (*MsgReplaceOrderResponse, error)
+	EnableCrossMargin(context.Context, *MsgEnableCrossMargin) (*MsgEnableCrossMarginResponse, error)
+	DisableCrossMargin(context.Context, *MsgDisableCrossMargin) (*MsgDisableCrossMarginResponse, error)
+	AddCrossMarginCollateral(context.Context, *MsgAddCrossMarginCollateral) (*MsgAddCrossMarginCollateralResponse, error)
+	RemoveCrossMarginCollateral(context.Context, *MsgRemoveCrossMarginCollateral) (*MsgRemoveCrossMarginCollateralResponse, error)
+	DepositInsuranceFund(context.Context, *MsgDepositInsuranceFund) (*MsgDepositInsuranceFundResponse, error)
+	WithdrawInsuranceFund(context.Context, *MsgWithdrawInsuranceFund) (*MsgWithdrawInsuranceFundResponse, error)
+	RegisterReferralCode(context.Context, *MsgRegisterReferralCode) (*MsgRegisterReferralCodeResponse, error)
+	SetReferrer(context.Context, *MsgSetReferrer) (*MsgSetReferrerResponse, error)
+	ClaimReferralEarnings(context.Context, *MsgClaimReferralEarnings) (*MsgClaimReferralEarningsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RemoveMargin(ctx context.Context, req *MsgRemoveMargin) (*MsgRemoveMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMargin not implemented")
+}
+func (*UnimplementedMsgServer) AddMargin(ctx context.Context, req *MsgAddMargin) (*MsgAddMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMargin not implemented")
+}
+func (*UnimplementedMsgServer) MultiLiquidate(ctx context.Context, req *MsgMultiLiquidate) (*MsgMultiLiquidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiLiquidate not implemented")
+}
+func (*UnimplementedMsgServer) OpenPosition(ctx context.Context, req *MsgOpenPosition) (*MsgOpenPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenPosition not implemented")
+}
+func (*UnimplementedMsgServer) ClosePosition(ctx context.Context, req *MsgClosePosition) (*MsgClosePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePosition not implemented")
+}
+func (*UnimplementedMsgServer) PartialClose(ctx context.Context, req *MsgPartialClose) (*MsgPartialCloseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartialClose not implemented")
+}
+func (*UnimplementedMsgServer) DonateToEcosystemFund(ctx context.Context, req *MsgDonateToEcosystemFund) (*MsgDonateToEcosystemFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DonateToEcosystemFund not implemented")
+}
+func (*UnimplementedMsgServer) SettlePosition(ctx context.Context, req *MsgSettlePosition) (*MsgSettlePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettlePosition not implemented")
+}
+func (*UnimplementedMsgServer) PlaceOrder(ctx context.Context, req *MsgPlaceOrder) (*MsgPlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrder) (*MsgCancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceOrder(ctx context.Context, req *MsgReplaceOrder) (*MsgReplaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (*UnimplementedMsgServer) EnableCrossMargin(ctx context.Context, req *MsgEnableCrossMargin) (*MsgEnableCrossMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableCrossMargin not implemented")
+}
+func (*UnimplementedMsgServer) DisableCrossMargin(ctx context.Context, req *MsgDisableCrossMargin) (*MsgDisableCrossMarginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCrossMargin not implemented")
+}
+func (*UnimplementedMsgServer) AddCrossMarginCollateral(ctx context.Context, req *MsgAddCrossMarginCollateral) (*MsgAddCrossMarginCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCrossMarginCollateral not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCrossMarginCollateral(ctx context.Context, req *MsgRemoveCrossMarginCollateral) (*MsgRemoveCrossMarginCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCrossMarginCollateral not implemented")
+}
+func (*UnimplementedMsgServer) DepositInsuranceFund(ctx context.Context, req *MsgDepositInsuranceFund) (*MsgDepositInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositInsuranceFund not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawInsuranceFund(ctx context.Context, req *MsgWithdrawInsuranceFund) (*MsgWithdrawInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawInsuranceFund not implemented")
+}
+func (*UnimplementedMsgServer) RegisterReferralCode(ctx context.Context, req *MsgRegisterReferralCode) (*MsgRegisterReferralCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterReferralCode not implemented")
+}
+func (*UnimplementedMsgServer) SetReferrer(ctx context.Context, req *MsgSetReferrer) (*MsgSetReferrerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReferrer not implemented")
+}
+func (*UnimplementedMsgServer) ClaimReferralEarnings(ctx context.Context, req *MsgClaimReferralEarnings) (*MsgClaimReferralEarningsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReferralEarnings not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RemoveMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/RemoveMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveMargin(ctx, req.(*MsgRemoveMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AddMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddMargin(ctx, req.(*MsgAddMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiLiquidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiLiquidate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiLiquidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/MultiLiquidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiLiquidate(ctx, req.(*MsgMultiLiquidate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/OpenPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenPosition(ctx, req.(*MsgOpenPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClosePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClosePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClosePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ClosePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClosePosition(ctx, req.(*MsgClosePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PartialClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartialClose)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartialClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/PartialClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartialClose(ctx, req.(*MsgPartialClose))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DonateToEcosystemFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDonateToEcosystemFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/DonateToEcosystemFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DonateToEcosystemFund(ctx, req.(*MsgDonateToEcosystemFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettlePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettlePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettlePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/SettlePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettlePosition(ctx, req.(*MsgSettlePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceOrder(ctx, req.(*MsgPlaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOrder(ctx, req.(*MsgCancelOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceOrder(ctx, req.(*MsgReplaceOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableCrossMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableCrossMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableCrossMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/EnableCrossMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableCrossMargin(ctx, req.(*MsgEnableCrossMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableCrossMargin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableCrossMargin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableCrossMargin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/DisableCrossMargin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableCrossMargin(ctx, req.(*MsgDisableCrossMargin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCrossMarginCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCrossMarginCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCrossMarginCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/AddCrossMarginCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCrossMarginCollateral(ctx, req.(*MsgAddCrossMarginCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveCrossMarginCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCrossMarginCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCrossMarginCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/RemoveCrossMarginCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCrossMarginCollateral(ctx, req.(*MsgRemoveCrossMarginCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositInsuranceFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/DepositInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositInsuranceFund(ctx, req.(*MsgDepositInsuranceFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawInsuranceFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/WithdrawInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawInsuranceFund(ctx, req.(*MsgWithdrawInsuranceFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterReferralCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterReferralCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterReferralCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/RegisterReferralCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterReferralCode(ctx, req.(*MsgRegisterReferralCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReferrer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReferrer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReferrer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/SetReferrer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReferrer(ctx, req.(*MsgSetReferrer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReferralEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReferralEarnings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReferralEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v2.Msg/ClaimReferralEarnings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReferralEarnings(ctx, req.(*MsgClaimReferralEarnings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.perp.v2.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RemoveMargin",
+			Handler:    _Msg_RemoveMargin_Handler,
+		},
+		{
+			MethodName: "AddMargin",
+			Handler:    _Msg_AddMargin_Handler,
+		},
+		{
+			MethodName: "MultiLiquidate",
+			Handler:    _Msg_MultiLiquidate_Handler,
+		},
+		{
+			MethodName: "OpenPosition",
+			Handler:    _Msg_OpenPosition_Handler,
+		},
+		{
+			MethodName: "ClosePosition",
+			Handler:    _Msg_ClosePosition_Handler,
+		},
+		{
+			MethodName: "PartialClose",
+			Handler:    _Msg_PartialClose_Handler,
+		},
+		{
+			MethodName: "DonateToEcosystemFund",
+			Handler:    _Msg_DonateToEcosystemFund_Handler,
+		},
+		{
+			MethodName: "SettlePosition",
+			Handler:    _Msg_SettlePosition_Handler,
+		},
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Msg_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Msg_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Msg_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "EnableCrossMargin",
+			Handler:    _Msg_EnableCrossMargin_Handler,
+		},
+		{
+			MethodName: "DisableCrossMargin",
+			Handler:    _Msg_DisableCrossMargin_Handler,
+		},
+		{
+			MethodName: "AddCrossMarginCollateral",
+			Handler:    _Msg_AddCrossMarginCollateral_Handler,
+		},
+		{
+			MethodName: "RemoveCrossMarginCollateral",
+			Handler:    _Msg_RemoveCrossMarginCollateral_Handler,
+		},
+		{
+			MethodName: "DepositInsuranceFund",
+			Handler:    _Msg_DepositInsuranceFund_Handler,
+		},
+		{
+			MethodName: "WithdrawInsuranceFund",
+			Handler:    _Msg_WithdrawInsuranceFund_Handler,
+		},
+		{
+			MethodName: "RegisterReferralCode",
+			Handler:    _Msg_RegisterReferralCode_Handler,
+		},
+		{
+			MethodName: "SetReferrer",
+			Handler:    _Msg_SetReferrer_Handler,
+		},
+		{
+			MethodName: "ClaimReferralEarnings",
+			Handler:    _Msg_ClaimReferralEarnings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "perp/v2/tx.proto",
+}
+
+func (m *MsgRemoveMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MarginOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidate_Liquidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidate_Liquidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidate_Liquidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for iNdEx := len(m.Liquidations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PerpEfFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LiquidatorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReduceOnly {
+		i--
+		if m.ReduceOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MarginToVault.Size()
+		i -= size
+		if _, err := m.MarginToVault.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClosePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClosePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClosePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarginToTrader.Size()
+		i -= size
+		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialClose) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialClose) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialClose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SizeAmount.Size()
+		i -= size
+		if _, err := m.SizeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartialCloseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartialCloseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartialCloseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MarginToTrader.Size()
+		i -= size
+		if _, err := m.MarginToTrader.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Position != nil {
+		{
+			size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Donation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDonateToEcosystemFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettlePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettlePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettlePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettlePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettlePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettlePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BadDebt.Size()
+		i -= size
+		if _, err := m.BadDebt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SettledCoins) > 0 {
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.PriceSource != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Side != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteAssetAmount.Size()
+		i -= size
+		if _, err := m.QuoteAssetAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableCrossMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableCrossMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCrossMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableCrossMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableCrossMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableCrossMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableCrossMargin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableCrossMargin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableCrossMargin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableCrossMarginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableCrossMarginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableCrossMarginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedCollateral) > 0 {
+		for iNdEx := len(m.RefundedCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCrossMarginCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCrossMarginCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCrossMarginCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCrossMarginCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCrossMarginCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCrossMarginCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCrossMarginCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCrossMarginCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCrossMarginCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCrossMarginCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCrossMarginCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCrossMarginCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Shares.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTx(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReferralCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReferralCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReferralCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterReferralCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterReferralCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterReferralCodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReferrerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReferrerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReferrerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReferralEarnings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReferralEarnings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReferralEarnings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReferralEarningsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReferralEarningsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReferralEarningsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for iNdEx := len(m.Earnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRemoveMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarginOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Liquidations) > 0 {
+		for _, e := range m.Liquidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidate_Liquidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidations) > 0 {
+		for _, e := range m.Liquidations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidatorFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PerpEfFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgOpenPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ReduceOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgOpenPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToVault.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClosePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClosePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToTrader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPartialClose) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.SizeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPartialCloseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != nil {
+		l = m.Position.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MarginToTrader.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDonateToEcosystemFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Donation.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettlePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSettlePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.BadDebt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovTx(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PriceSource != 0 {
+		n += 1 + sovTx(uint64(m.PriceSource))
+	}
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *MsgCancelOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.QuoteAssetAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReplaceOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgEnableCrossMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableCrossMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableCrossMargin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableCrossMarginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RefundedCollateral) > 0 {
+		for _, e := range m.RefundedCollateral {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddCrossMarginCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddCrossMarginCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveCrossMarginCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveCrossMarginCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Account.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterReferralCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterReferralCodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReferrer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetReferrerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimReferralEarnings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimReferralEarningsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Earnings) > 0 {
+		for _, e := range m.Earnings {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_Liquidation{})
+			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Liquidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Liquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidateResponse_LiquidationResponse{})
+			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerpEfFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerpEfFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReduceOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReduceOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialClose) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialClose: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialClose: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SizeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartialCloseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartialCloseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Position == nil {
+				m.Position = &Position{}
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettlePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettlePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettlePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettlePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettlePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettlePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= TriggerPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Donation.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgDonateToEcosystemFundResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,17 +8870,17 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMargin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var stringLen uint64
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2156,27 +8890,64 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2204,15 +8975,13 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2222,25 +8991,61 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2262,7 +9067,7 @@ func (m *MsgRemoveMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2272,30 +9077,115 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2305,28 +9195,29 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2354,15 +9245,15 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2372,25 +9263,23 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2415,7 +9304,7 @@ func (m *MsgRemoveMarginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2438,81 +9327,15 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMargin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMargin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2539,7 +9362,7 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2564,7 +9387,7 @@ func (m *MsgAddMargin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableCrossMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2587,15 +9410,15 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableCrossMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableCrossMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2623,46 +9446,58 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableCrossMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableCrossMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableCrossMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2684,7 +9519,7 @@ func (m *MsgAddMarginResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableCrossMargin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,10 +9542,10 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableCrossMargin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableCrossMargin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2740,14 +9575,64 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDisableCrossMarginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDisableCrossMarginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDisableCrossMarginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCollateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2774,8 +9659,8 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidate_Liquidation{})
-			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RefundedCollateral = append(m.RefundedCollateral, types.Coin{})
+			if err := m.RefundedCollateral[len(m.RefundedCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2800,7 +9685,7 @@ func (m *MsgMultiLiquidate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCrossMarginCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,15 +9708,15 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Liquidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCrossMarginCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Liquidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCrossMarginCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2859,15 +9744,13 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2877,23 +9760,24 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2916,7 +9800,7 @@ func (m *MsgMultiLiquidate_Liquidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCrossMarginCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2939,15 +9823,15 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCrossMarginCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiLiquidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCrossMarginCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2974,8 +9858,7 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidations = append(m.Liquidations, &MsgMultiLiquidateResponse_LiquidationResponse{})
-			if err := m.Liquidations[len(m.Liquidations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3000,7 +9883,7 @@ func (m *MsgMultiLiquidateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCrossMarginCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3023,35 +9906,15 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCrossMarginCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCrossMarginCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3079,44 +9942,11 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PerpEfFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3143,7 +9973,7 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PerpEfFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3168,7 +9998,7 @@ func (m *MsgMultiLiquidateResponse_LiquidationResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveCrossMarginCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3191,49 +10021,17 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveCrossMarginCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveCrossMarginCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3243,82 +10041,78 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Direction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssetAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositInsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteAssetAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositInsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3346,15 +10140,13 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3364,23 +10156,22 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3405,7 +10196,7 @@ func (m *MsgOpenPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3428,15 +10219,15 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOpenPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositInsuranceFundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOpenPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3463,84 +10254,63 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Position == nil {
-				m.Position = &Position{}
-			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawInsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawInsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3568,15 +10338,13 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3586,99 +10354,80 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToVault", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToVault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3688,23 +10437,22 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3729,7 +10477,7 @@ func (m *MsgOpenPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterReferralCode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3752,10 +10500,10 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterReferralCode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterReferralCode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3792,7 +10540,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3820,9 +10568,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3845,7 +10591,7 @@ func (m *MsgClosePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterReferralCodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,83 +10614,65 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterReferralCodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClosePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterReferralCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReferrer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3972,13 +10700,11 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4006,44 +10732,58 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Code = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarginToTrader", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReferrerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarginToTrader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReferrerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4065,7 +10805,7 @@ func (m *MsgClosePositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimReferralEarnings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4088,10 +10828,10 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimReferralEarnings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimReferralEarnings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4126,39 +10866,6 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Donation", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Donation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4180,7 +10887,7 @@ func (m *MsgDonateToEcosystemFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimReferralEarningsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4203,12 +10910,46 @@ func (m *MsgDonateToEcosystemFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimReferralEarningsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDonateToEcosystemFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimReferralEarningsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earnings = append(m.Earnings, types.Coin{})
+			if err := m.Earnings[len(m.Earnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])